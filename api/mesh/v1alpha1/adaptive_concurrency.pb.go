@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/adaptive_concurrency.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdaptiveConcurrency defines the configuration of adaptive concurrency
+// limiting of HTTP services. Instead of a fixed limit of concurrent requests,
+// the limit is continuously recalculated based on the observed latency of
+// the service.
+type AdaptiveConcurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	// The concurrency of requests is limited by the inbound listeners of the
+	// matched services.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the adaptive concurrency limiting.
+	Conf *AdaptiveConcurrency_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *AdaptiveConcurrency) Reset() {
+	*x = AdaptiveConcurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdaptiveConcurrency) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency) GetConf() *AdaptiveConcurrency_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Conf defines the adaptive concurrency limiting.
+type AdaptiveConcurrency_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Configuration of the gradient controller.
+	GradientController *AdaptiveConcurrency_Conf_GradientController `protobuf:"bytes,1,opt,name=gradient_controller,json=gradientController,proto3" json:"gradient_controller,omitempty"`
+}
+
+func (x *AdaptiveConcurrency_Conf) Reset() {
+	*x = AdaptiveConcurrency_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency_Conf) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency_Conf.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AdaptiveConcurrency_Conf) GetGradientController() *AdaptiveConcurrency_Conf_GradientController {
+	if x != nil {
+		return x.GradientController
+	}
+	return nil
+}
+
+// GradientController defines the settings of the gradient controller
+// which calculates the concurrency limit.
+type AdaptiveConcurrency_Conf_GradientController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentile, in [0.0 - 100.0] range, of the sampled latencies
+	// which is used to summarize them. The default value is 50.
+	SampleAggregatePercentile *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=sample_aggregate_percentile,json=sampleAggregatePercentile,proto3" json:"sample_aggregate_percentile,omitempty"`
+	// Configuration of the concurrency limit calculation.
+	ConcurrencyLimit *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit `protobuf:"bytes,2,opt,name=concurrency_limit,json=concurrencyLimit,proto3" json:"concurrency_limit,omitempty"`
+	// Configuration of the minimum round-trip time calculation.
+	MinRtt *AdaptiveConcurrency_Conf_GradientController_MinRtt `protobuf:"bytes,3,opt,name=min_rtt,json=minRtt,proto3" json:"min_rtt,omitempty"`
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) Reset() {
+	*x = AdaptiveConcurrency_Conf_GradientController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency_Conf_GradientController) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency_Conf_GradientController.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency_Conf_GradientController) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) GetSampleAggregatePercentile() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.SampleAggregatePercentile
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) GetConcurrencyLimit() *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit {
+	if x != nil {
+		return x.ConcurrencyLimit
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController) GetMinRtt() *AdaptiveConcurrency_Conf_GradientController_MinRtt {
+	if x != nil {
+		return x.MinRtt
+	}
+	return nil
+}
+
+// ConcurrencyLimit defines how the concurrency limit is calculated.
+type AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The allowed upper-bound on the calculated concurrency limit. The
+	// default value is 1000.
+	Max *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=max,proto3" json:"max,omitempty"`
+	// The period of time samples are taken to recalculate the
+	// concurrency limit. The default value is 100ms.
+	UpdateInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=update_interval,json=updateInterval,proto3" json:"update_interval,omitempty"`
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) Reset() {
+	*x = AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) GetMax() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit) GetUpdateInterval() *durationpb.Duration {
+	if x != nil {
+		return x.UpdateInterval
+	}
+	return nil
+}
+
+// MinRtt defines how the minimum round-trip time of requests is
+// calculated.
+type AdaptiveConcurrency_Conf_GradientController_MinRtt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time interval between recalculating the minimum round-trip
+	// time. The default value is 60s.
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The number of requests to aggregate when calculating the minimum
+	// round-trip time. The default value is 50.
+	RequestCount *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Randomized time delta, as a percentage of the interval, that is
+	// added to the interval. The value has to be in [0.0 - 100.0] range.
+	// The default value is 15.
+	Jitter *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// The concurrency limit set while measuring the minimum round-trip
+	// time. The default value is 3.
+	MinConcurrency *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	// Amount, as a percentage of the minimum round-trip time, added to
+	// it to account for natural variance in the latency. The value has to
+	// be in [0.0 - 100.0] range. The default value is 25.
+	Buffer *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=buffer,proto3" json:"buffer,omitempty"`
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) Reset() {
+	*x = AdaptiveConcurrency_Conf_GradientController_MinRtt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency_Conf_GradientController_MinRtt) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency_Conf_GradientController_MinRtt.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency_Conf_GradientController_MinRtt) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) GetRequestCount() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.RequestCount
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) GetJitter() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) GetMinConcurrency() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MinConcurrency
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency_Conf_GradientController_MinRtt) GetBuffer() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Buffer
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_adaptive_concurrency_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_adaptive_concurrency_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xcc, 0x0a, 0x0a, 0x13, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x1a, 0x8e, 0x07, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x70, 0x0a, 0x13, 0x67, 0x72, 0x61,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x12, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x1a, 0x93, 0x06, 0x0a, 0x12,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x5c, 0x0a, 0x1b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x12, 0x7d, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x5f, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x46, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x52, 0x74, 0x74, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x52, 0x74, 0x74,
+	0x1a, 0x86, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x42, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0xb5, 0x02, 0x0a, 0x06, 0x4d, 0x69,
+	0x6e, 0x52, 0x74, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x3a, 0x9a, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x15, 0x12, 0x13, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x18, 0x3a, 0x16,
+	0x0a, 0x14, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1a, 0x3a, 0x18, 0x12, 0x16,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x5d,
+	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
+	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x2f, 0x50, 0x01,
+	0xa2, 0x01, 0x13, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0xf2, 0x01, 0x14, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescData = file_mesh_v1alpha1_adaptive_concurrency_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_adaptive_concurrency_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_adaptive_concurrency_proto_goTypes = []interface{}{
+	(*AdaptiveConcurrency)(nil),                                          // 0: kuma.mesh.v1alpha1.AdaptiveConcurrency
+	(*AdaptiveConcurrency_Conf)(nil),                                     // 1: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf
+	(*AdaptiveConcurrency_Conf_GradientController)(nil),                  // 2: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController
+	(*AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit)(nil), // 3: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.ConcurrencyLimit
+	(*AdaptiveConcurrency_Conf_GradientController_MinRtt)(nil),           // 4: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt
+	(*Selector)(nil),               // 5: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil), // 7: google.protobuf.DoubleValue
+	(*wrapperspb.UInt32Value)(nil), // 8: google.protobuf.UInt32Value
+	(*durationpb.Duration)(nil),    // 9: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_adaptive_concurrency_proto_depIdxs = []int32{
+	5,  // 0: kuma.mesh.v1alpha1.AdaptiveConcurrency.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	5,  // 1: kuma.mesh.v1alpha1.AdaptiveConcurrency.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1,  // 2: kuma.mesh.v1alpha1.AdaptiveConcurrency.conf:type_name -> kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf
+	6,  // 3: kuma.mesh.v1alpha1.AdaptiveConcurrency.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.gradient_controller:type_name -> kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController
+	7,  // 5: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.sample_aggregate_percentile:type_name -> google.protobuf.DoubleValue
+	3,  // 6: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.concurrency_limit:type_name -> kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.ConcurrencyLimit
+	4,  // 7: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.min_rtt:type_name -> kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt
+	8,  // 8: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.ConcurrencyLimit.max:type_name -> google.protobuf.UInt32Value
+	9,  // 9: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.ConcurrencyLimit.update_interval:type_name -> google.protobuf.Duration
+	9,  // 10: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt.interval:type_name -> google.protobuf.Duration
+	8,  // 11: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt.request_count:type_name -> google.protobuf.UInt32Value
+	7,  // 12: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt.jitter:type_name -> google.protobuf.DoubleValue
+	8,  // 13: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt.min_concurrency:type_name -> google.protobuf.UInt32Value
+	7,  // 14: kuma.mesh.v1alpha1.AdaptiveConcurrency.Conf.GradientController.MinRtt.buffer:type_name -> google.protobuf.DoubleValue
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_adaptive_concurrency_proto_init() }
+func file_mesh_v1alpha1_adaptive_concurrency_proto_init() {
+	if File_mesh_v1alpha1_adaptive_concurrency_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency_Conf_GradientController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency_Conf_GradientController_MinRtt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_adaptive_concurrency_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_adaptive_concurrency_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_adaptive_concurrency_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_adaptive_concurrency_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_adaptive_concurrency_proto = out.File
+	file_mesh_v1alpha1_adaptive_concurrency_proto_rawDesc = nil
+	file_mesh_v1alpha1_adaptive_concurrency_proto_goTypes = nil
+	file_mesh_v1alpha1_adaptive_concurrency_proto_depIdxs = nil
+}
@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/compression.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Algorithm defines a compression algorithm.
+type Compression_Conf_Algorithm int32
+
+const (
+	Compression_Conf_gzip   Compression_Conf_Algorithm = 0
+	Compression_Conf_brotli Compression_Conf_Algorithm = 1
+)
+
+// Enum value maps for Compression_Conf_Algorithm.
+var (
+	Compression_Conf_Algorithm_name = map[int32]string{
+		0: "gzip",
+		1: "brotli",
+	}
+	Compression_Conf_Algorithm_value = map[string]int32{
+		"gzip":   0,
+		"brotli": 1,
+	}
+)
+
+func (x Compression_Conf_Algorithm) Enum() *Compression_Conf_Algorithm {
+	p := new(Compression_Conf_Algorithm)
+	*p = x
+	return p
+}
+
+func (x Compression_Conf_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compression_Conf_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_compression_proto_enumTypes[0].Descriptor()
+}
+
+func (Compression_Conf_Algorithm) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_compression_proto_enumTypes[0]
+}
+
+func (x Compression_Conf_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compression_Conf_Algorithm.Descriptor instead.
+func (Compression_Conf_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// Compression defines the configuration of HTTP response compression.
+type Compression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	// For MeshGateway listeners the selectors are matched against the listener
+	// tags.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	// Responses of the matched services are compressed by their inbound
+	// listeners.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the compression.
+	Conf *Compression_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Compression) Reset() {
+	*x = Compression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_compression_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compression) ProtoMessage() {}
+
+func (x *Compression) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_compression_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compression.ProtoReflect.Descriptor instead.
+func (*Compression) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Compression) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *Compression) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *Compression) GetConf() *Compression_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *Compression) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Conf defines how the responses are compressed.
+type Compression_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum response length, in bytes, which will trigger compression. The
+	// default value is 30.
+	MinContentLength *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=min_content_length,json=minContentLength,proto3" json:"min_content_length,omitempty"`
+	// List of mime types which yield compression, e.g. application/json. If
+	// not specified, the Envoy defaults are used ("application/javascript",
+	// "application/json", "application/xhtml+xml", "image/svg+xml",
+	// "text/css", "text/html", "text/plain", "text/xml").
+	ContentTypes []string `protobuf:"bytes,2,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// List of compression algorithms in the order of preference. The first
+	// algorithm accepted by the client is used. The default value is gzip.
+	Algorithms []Compression_Conf_Algorithm `protobuf:"varint,3,rep,packed,name=algorithms,proto3,enum=kuma.mesh.v1alpha1.Compression_Conf_Algorithm" json:"algorithms,omitempty"`
+	// If true, disables compression when the response contains an etag
+	// header.
+	DisableOnEtagHeader bool `protobuf:"varint,4,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+}
+
+func (x *Compression_Conf) Reset() {
+	*x = Compression_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_compression_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compression_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compression_Conf) ProtoMessage() {}
+
+func (x *Compression_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_compression_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compression_Conf.ProtoReflect.Descriptor instead.
+func (*Compression_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Compression_Conf) GetMinContentLength() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MinContentLength
+	}
+	return nil
+}
+
+func (x *Compression_Conf) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *Compression_Conf) GetAlgorithms() []Compression_Conf_Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *Compression_Conf) GetDisableOnEtagHeader() bool {
+	if x != nil {
+		return x.DisableOnEtagHeader
+	}
+	return false
+}
+
+var File_mesh_v1alpha1_compression_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_compression_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x05, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x9f, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66,
+	0x12, 0x4a, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x73, 0x12, 0x33, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x65, 0x74, 0x61, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x45, 0x74, 0x61, 0x67,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x08, 0x0a, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x62, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x10, 0x01, 0x3a, 0x61, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0d, 0x12, 0x0b, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22,
+	0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x3a, 0x0d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x4c, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68,
+	0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1e, 0x50, 0x01, 0xa2, 0x01,
+	0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xf2, 0x01, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_compression_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_compression_proto_rawDescData = file_mesh_v1alpha1_compression_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_compression_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_compression_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_compression_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_compression_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_compression_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_compression_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_compression_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_compression_proto_goTypes = []interface{}{
+	(Compression_Conf_Algorithm)(0), // 0: kuma.mesh.v1alpha1.Compression.Conf.Algorithm
+	(*Compression)(nil),             // 1: kuma.mesh.v1alpha1.Compression
+	(*Compression_Conf)(nil),        // 2: kuma.mesh.v1alpha1.Compression.Conf
+	(*Selector)(nil),                // 3: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+	(*wrapperspb.UInt32Value)(nil),  // 5: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_compression_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.Compression.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	3, // 1: kuma.mesh.v1alpha1.Compression.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	2, // 2: kuma.mesh.v1alpha1.Compression.conf:type_name -> kuma.mesh.v1alpha1.Compression.Conf
+	4, // 3: kuma.mesh.v1alpha1.Compression.expires_at:type_name -> google.protobuf.Timestamp
+	5, // 4: kuma.mesh.v1alpha1.Compression.Conf.min_content_length:type_name -> google.protobuf.UInt32Value
+	0, // 5: kuma.mesh.v1alpha1.Compression.Conf.algorithms:type_name -> kuma.mesh.v1alpha1.Compression.Conf.Algorithm
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_compression_proto_init() }
+func file_mesh_v1alpha1_compression_proto_init() {
+	if File_mesh_v1alpha1_compression_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_compression_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_compression_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compression_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_compression_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_compression_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_compression_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_compression_proto_enumTypes,
+		MessageInfos:      file_mesh_v1alpha1_compression_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_compression_proto = out.File
+	file_mesh_v1alpha1_compression_proto_rawDesc = nil
+	file_mesh_v1alpha1_compression_proto_goTypes = nil
+	file_mesh_v1alpha1_compression_proto_depIdxs = nil
+}
@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/cors_policy.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CorsPolicy defines the Cross-Origin Resource Sharing configuration of HTTP
+// services.
+type CorsPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	// For MeshGateway listeners the selectors are matched against the listener
+	// tags.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	// The CORS policy is applied by the inbound listeners of the matched
+	// services.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the CORS policy.
+	Conf *CorsPolicy_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *CorsPolicy) Reset() {
+	*x = CorsPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsPolicy) ProtoMessage() {}
+
+func (x *CorsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsPolicy.ProtoReflect.Descriptor instead.
+func (*CorsPolicy) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CorsPolicy) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *CorsPolicy) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *CorsPolicy) GetConf() *CorsPolicy_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *CorsPolicy) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Conf defines the CORS policy.
+type CorsPolicy_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of origins that are allowed to make cross-origin requests.
+	AllowOrigins []*CorsPolicy_Conf_Origin `protobuf:"bytes,1,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`
+	// List of methods that are allowed to be used in cross-origin requests
+	// (Access-Control-Allow-Methods).
+	AllowMethods []HttpMethod `protobuf:"varint,2,rep,packed,name=allow_methods,json=allowMethods,proto3,enum=kuma.mesh.v1alpha1.HttpMethod" json:"allow_methods,omitempty"`
+	// List of headers that are allowed to be sent in cross-origin requests
+	// (Access-Control-Allow-Headers).
+	AllowHeaders []string `protobuf:"bytes,3,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
+	// List of headers that are exposed to the client
+	// (Access-Control-Expose-Headers).
+	ExposeHeaders []string `protobuf:"bytes,4,rep,name=expose_headers,json=exposeHeaders,proto3" json:"expose_headers,omitempty"`
+	// How long the results of a preflight request can be cached
+	// (Access-Control-Max-Age).
+	MaxAge *durationpb.Duration `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// If true, the client is allowed to include credentials in cross-origin
+	// requests (Access-Control-Allow-Credentials).
+	AllowCredentials bool `protobuf:"varint,6,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+}
+
+func (x *CorsPolicy_Conf) Reset() {
+	*x = CorsPolicy_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorsPolicy_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsPolicy_Conf) ProtoMessage() {}
+
+func (x *CorsPolicy_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsPolicy_Conf.ProtoReflect.Descriptor instead.
+func (*CorsPolicy_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_policy_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *CorsPolicy_Conf) GetAllowOrigins() []*CorsPolicy_Conf_Origin {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf) GetAllowMethods() []HttpMethod {
+	if x != nil {
+		return x.AllowMethods
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf) GetAllowHeaders() []string {
+	if x != nil {
+		return x.AllowHeaders
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf) GetExposeHeaders() []string {
+	if x != nil {
+		return x.ExposeHeaders
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+// Origin matches the value of the Origin header.
+type CorsPolicy_Conf_Origin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to MatcherType:
+	//	*CorsPolicy_Conf_Origin_Exact
+	//	*CorsPolicy_Conf_Origin_Regex
+	MatcherType isCorsPolicy_Conf_Origin_MatcherType `protobuf_oneof:"matcherType"`
+}
+
+func (x *CorsPolicy_Conf_Origin) Reset() {
+	*x = CorsPolicy_Conf_Origin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorsPolicy_Conf_Origin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsPolicy_Conf_Origin) ProtoMessage() {}
+
+func (x *CorsPolicy_Conf_Origin) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsPolicy_Conf_Origin.ProtoReflect.Descriptor instead.
+func (*CorsPolicy_Conf_Origin) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_policy_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (m *CorsPolicy_Conf_Origin) GetMatcherType() isCorsPolicy_Conf_Origin_MatcherType {
+	if m != nil {
+		return m.MatcherType
+	}
+	return nil
+}
+
+func (x *CorsPolicy_Conf_Origin) GetExact() string {
+	if x, ok := x.GetMatcherType().(*CorsPolicy_Conf_Origin_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *CorsPolicy_Conf_Origin) GetRegex() string {
+	if x, ok := x.GetMatcherType().(*CorsPolicy_Conf_Origin_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+type isCorsPolicy_Conf_Origin_MatcherType interface {
+	isCorsPolicy_Conf_Origin_MatcherType()
+}
+
+type CorsPolicy_Conf_Origin_Exact struct {
+	// Exact checks that the origin is equal to the given value.
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type CorsPolicy_Conf_Origin_Regex struct {
+	// Regex checks the origin using RE2 syntax.
+	// https://github.com/google/re2/wiki/Syntax
+	Regex string `protobuf:"bytes,2,opt,name=regex,proto3,oneof"`
+}
+
+func (*CorsPolicy_Conf_Origin_Exact) isCorsPolicy_Conf_Origin_MatcherType() {}
+
+func (*CorsPolicy_Conf_Origin_Regex) isCorsPolicy_Conf_Origin_MatcherType() {}
+
+var File_mesh_v1alpha1_cors_policy_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_cors_policy_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x06, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x98, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x55, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x47, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x3a, 0x76, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x0a, 0x12, 0x43, 0x6f, 0x72, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x0c, 0x12, 0x0a, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x3a, 0x0d, 0x0a, 0x0b, 0x63,
+	0x6f, 0x72, 0x73, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x11,
+	0x3a, 0x0f, 0x12, 0x0d, 0x63, 0x6f, 0x72, 0x73, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1d, 0x50, 0x01, 0xa2, 0x01, 0x0a, 0x43, 0x6f,
+	0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0xf2, 0x01, 0x0b, 0x63, 0x6f, 0x72, 0x73, 0x2d,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_cors_policy_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_cors_policy_proto_rawDescData = file_mesh_v1alpha1_cors_policy_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_cors_policy_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_cors_policy_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_cors_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_cors_policy_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_cors_policy_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_cors_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_cors_policy_proto_goTypes = []interface{}{
+	(*CorsPolicy)(nil),             // 0: kuma.mesh.v1alpha1.CorsPolicy
+	(*CorsPolicy_Conf)(nil),        // 1: kuma.mesh.v1alpha1.CorsPolicy.Conf
+	(*CorsPolicy_Conf_Origin)(nil), // 2: kuma.mesh.v1alpha1.CorsPolicy.Conf.Origin
+	(*Selector)(nil),               // 3: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+	(HttpMethod)(0),                // 5: kuma.mesh.v1alpha1.HttpMethod
+	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_cors_policy_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.CorsPolicy.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	3, // 1: kuma.mesh.v1alpha1.CorsPolicy.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 2: kuma.mesh.v1alpha1.CorsPolicy.conf:type_name -> kuma.mesh.v1alpha1.CorsPolicy.Conf
+	4, // 3: kuma.mesh.v1alpha1.CorsPolicy.expires_at:type_name -> google.protobuf.Timestamp
+	2, // 4: kuma.mesh.v1alpha1.CorsPolicy.Conf.allow_origins:type_name -> kuma.mesh.v1alpha1.CorsPolicy.Conf.Origin
+	5, // 5: kuma.mesh.v1alpha1.CorsPolicy.Conf.allow_methods:type_name -> kuma.mesh.v1alpha1.HttpMethod
+	6, // 6: kuma.mesh.v1alpha1.CorsPolicy.Conf.max_age:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_cors_policy_proto_init() }
+func file_mesh_v1alpha1_cors_policy_proto_init() {
+	if File_mesh_v1alpha1_cors_policy_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_http_method_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_cors_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorsPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_cors_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorsPolicy_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_cors_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorsPolicy_Conf_Origin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_cors_policy_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*CorsPolicy_Conf_Origin_Exact)(nil),
+		(*CorsPolicy_Conf_Origin_Regex)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_cors_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_cors_policy_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_cors_policy_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_cors_policy_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_cors_policy_proto = out.File
+	file_mesh_v1alpha1_cors_policy_proto_rawDesc = nil
+	file_mesh_v1alpha1_cors_policy_proto_goTypes = nil
+	file_mesh_v1alpha1_cors_policy_proto_depIdxs = nil
+}
@@ -19,6 +19,10 @@ const (
 
 	// Locality related tags
 	ZoneTag = "kuma.io/zone"
+	// Optional tags which place the endpoints of a zone in a region
+	// and a sub-zone for the Locality Aware Load Balancing.
+	RegionTag  = "kuma.io/region"
+	SubZoneTag = "kuma.io/sub-zone"
 
 	// Optional tag that has a reserved meaning in Kuma.
 	// If absent, Kuma will treat application's protocol as opaque TCP.
@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -36,6 +37,9 @@ type FaultInjection struct {
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// Configuration of FaultInjection
 	Conf *FaultInjection_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *FaultInjection) Reset() {
@@ -91,6 +95,13 @@ func (x *FaultInjection) GetConf() *FaultInjection_Conf {
 	return nil
 }
 
+func (x *FaultInjection) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // Conf defines several types of faults, at least one fault should be
 // specified
 type FaultInjection_Conf struct {
@@ -101,10 +112,17 @@ type FaultInjection_Conf struct {
 	// Delay if specified then response from the destination will be delivered
 	// with a delay
 	Delay *FaultInjection_Conf_Delay `protobuf:"bytes,1,opt,name=delay,proto3" json:"delay,omitempty"`
-	// Abort if specified makes source side to receive specified httpStatus code
+	// Abort if specified makes source side to receive specified httpStatus or
+	// grpcStatus code
 	Abort *FaultInjection_Conf_Abort `protobuf:"bytes,2,opt,name=abort,proto3" json:"abort,omitempty"`
 	// ResponseBandwidth if specified limits the speed of sending response body
 	ResponseBandwidth *FaultInjection_Conf_ResponseBandwidth `protobuf:"bytes,3,opt,name=response_bandwidth,json=responseBandwidth,proto3" json:"response_bandwidth,omitempty"`
+	// Tcp if specified injects faults into the TCP connections
+	Tcp *FaultInjection_Conf_Tcp `protobuf:"bytes,4,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	// Headers if specified limits the HTTP faults to the requests with the
+	// matching headers, e.g. to inject faults only into the requests with
+	// the "x-chaos: true" header
+	Headers map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *FaultInjection_Conf) Reset() {
@@ -160,6 +178,20 @@ func (x *FaultInjection_Conf) GetResponseBandwidth() *FaultInjection_Conf_Respon
 	return nil
 }
 
+func (x *FaultInjection_Conf) GetTcp() *FaultInjection_Conf_Tcp {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *FaultInjection_Conf) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 // Delay defines configuration of delaying a response from a destination
 type FaultInjection_Conf_Delay struct {
 	state         protoimpl.MessageState
@@ -230,8 +262,12 @@ type FaultInjection_Conf_Abort struct {
 	// Percentage of requests on which abort will be injected, has to be in
 	// [0.0 - 100.0] range
 	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	// HTTP status code which will be returned to source side
+	// HTTP status code which will be returned to source side. Either
+	// httpStatus or grpcStatus has to be defined
 	HttpStatus *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=httpStatus,proto3" json:"httpStatus,omitempty"`
+	// gRPC status code which will be returned to source side, has to be in
+	// [0 - 16] range. Either httpStatus or grpcStatus has to be defined
+	GrpcStatus *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=grpcStatus,proto3" json:"grpcStatus,omitempty"`
 }
 
 func (x *FaultInjection_Conf_Abort) Reset() {
@@ -280,6 +316,13 @@ func (x *FaultInjection_Conf_Abort) GetHttpStatus() *wrapperspb.UInt32Value {
 	return nil
 }
 
+func (x *FaultInjection_Conf_Abort) GetGrpcStatus() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return nil
+}
+
 // ResponseBandwidth defines a configuration to limit the speed of
 // responding to the requests
 type FaultInjection_Conf_ResponseBandwidth struct {
@@ -341,6 +384,111 @@ func (x *FaultInjection_Conf_ResponseBandwidth) GetLimit() *wrapperspb.StringVal
 	return nil
 }
 
+// Tcp defines faults of the TCP connections. They are injected by the
+// source dataplanes on the outbounds of the destination services that
+// don't use an HTTP based protocol. Delay and ResponseBandwidth cannot be
+// injected into these connections, because Envoy has no network filter
+// which delays or throttles them.
+type FaultInjection_Conf_Tcp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ConnectionAbort if specified makes source side to have its
+	// connections closed
+	Abort *FaultInjection_Conf_Tcp_ConnectionAbort `protobuf:"bytes,1,opt,name=abort,proto3" json:"abort,omitempty"`
+}
+
+func (x *FaultInjection_Conf_Tcp) Reset() {
+	*x = FaultInjection_Conf_Tcp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_fault_injection_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultInjection_Conf_Tcp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultInjection_Conf_Tcp) ProtoMessage() {}
+
+func (x *FaultInjection_Conf_Tcp) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_fault_injection_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultInjection_Conf_Tcp.ProtoReflect.Descriptor instead.
+func (*FaultInjection_Conf_Tcp) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_fault_injection_proto_rawDescGZIP(), []int{0, 0, 3}
+}
+
+func (x *FaultInjection_Conf_Tcp) GetAbort() *FaultInjection_Conf_Tcp_ConnectionAbort {
+	if x != nil {
+		return x.Abort
+	}
+	return nil
+}
+
+// ConnectionAbort defines a configuration of closing the connections
+// before they reach the destination
+type FaultInjection_Conf_Tcp_ConnectionAbort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of connections which will be closed, has to be in
+	// [0.0 - 100.0] range
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *FaultInjection_Conf_Tcp_ConnectionAbort) Reset() {
+	*x = FaultInjection_Conf_Tcp_ConnectionAbort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_fault_injection_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultInjection_Conf_Tcp_ConnectionAbort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultInjection_Conf_Tcp_ConnectionAbort) ProtoMessage() {}
+
+func (x *FaultInjection_Conf_Tcp_ConnectionAbort) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_fault_injection_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultInjection_Conf_Tcp_ConnectionAbort.ProtoReflect.Descriptor instead.
+func (*FaultInjection_Conf_Tcp_ConnectionAbort) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_fault_injection_proto_rawDescGZIP(), []int{0, 0, 3, 0}
+}
+
+func (x *FaultInjection_Conf_Tcp_ConnectionAbort) GetPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
 var File_mesh_v1alpha1_fault_injection_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_fault_injection_proto_rawDesc = []byte{
@@ -352,79 +500,118 @@ var file_mesh_v1alpha1_fault_injection_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
 	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d,
 	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x07, 0x0a, 0x0e, 0x46, 0x61,
-	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18,
-	0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04,
-	0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
-	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xa5, 0x05, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x43,
-	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x05, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x68, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x0c,
+	0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x46,
+	0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49,
-	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52,
-	0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x1a, 0x82, 0x01, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x42, 0x0a, 0x0a,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04,
-	0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x8f, 0x01, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x68,
-	0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x11, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x1a, 0x95, 0x09, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x43, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x68, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x11,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x3d, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x03, 0x74, 0x63, 0x70,
+	0x12, 0x4e, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x1a, 0x82, 0x01, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x35,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xc7, 0x01, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12,
 	0x42, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
 	0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x6b, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x18, 0x0a, 0x16, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89,
-	0xa6, 0x01, 0x10, 0x12, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x3a,
-	0x11, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x53, 0x5a, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f,
-	0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x25, 0x50, 0x01, 0xa2, 0x01, 0x0e, 0x46,
-	0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0x01, 0x0f,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x91, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x1a, 0xaf, 0x01, 0x0a, 0x03, 0x54, 0x63, 0x70, 0x12, 0x51, 0x0a, 0x05, 0x61,
+	0x62, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x63, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x1a, 0x55,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x75, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x6b, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x18, 0x0a, 0x16, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x10, 0x12, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x3a, 0x11,
+	0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x53, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x25, 0x50, 0x01, 0xa2, 0x01, 0x0e, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0x01, 0x0f, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x2d, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -439,37 +626,49 @@ func file_mesh_v1alpha1_fault_injection_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_fault_injection_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_fault_injection_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_fault_injection_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_mesh_v1alpha1_fault_injection_proto_goTypes = []interface{}{
-	(*FaultInjection)(nil),                        // 0: kuma.mesh.v1alpha1.FaultInjection
-	(*FaultInjection_Conf)(nil),                   // 1: kuma.mesh.v1alpha1.FaultInjection.Conf
-	(*FaultInjection_Conf_Delay)(nil),             // 2: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay
-	(*FaultInjection_Conf_Abort)(nil),             // 3: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort
-	(*FaultInjection_Conf_ResponseBandwidth)(nil), // 4: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth
-	(*Selector)(nil),                              // 5: kuma.mesh.v1alpha1.Selector
-	(*wrapperspb.DoubleValue)(nil),                // 6: google.protobuf.DoubleValue
-	(*durationpb.Duration)(nil),                   // 7: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil),                // 8: google.protobuf.UInt32Value
-	(*wrapperspb.StringValue)(nil),                // 9: google.protobuf.StringValue
+	(*FaultInjection)(nil),                          // 0: kuma.mesh.v1alpha1.FaultInjection
+	(*FaultInjection_Conf)(nil),                     // 1: kuma.mesh.v1alpha1.FaultInjection.Conf
+	(*FaultInjection_Conf_Delay)(nil),               // 2: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay
+	(*FaultInjection_Conf_Abort)(nil),               // 3: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort
+	(*FaultInjection_Conf_ResponseBandwidth)(nil),   // 4: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth
+	(*FaultInjection_Conf_Tcp)(nil),                 // 5: kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp
+	nil,                                             // 6: kuma.mesh.v1alpha1.FaultInjection.Conf.HeadersEntry
+	(*FaultInjection_Conf_Tcp_ConnectionAbort)(nil), // 7: kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp.ConnectionAbort
+	(*Selector)(nil),                                // 8: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),                   // 9: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),                  // 10: google.protobuf.DoubleValue
+	(*durationpb.Duration)(nil),                     // 11: google.protobuf.Duration
+	(*wrapperspb.UInt32Value)(nil),                  // 12: google.protobuf.UInt32Value
+	(*wrapperspb.StringValue)(nil),                  // 13: google.protobuf.StringValue
+	(*TrafficRoute_Http_Match_StringMatcher)(nil),   // 14: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
 }
 var file_mesh_v1alpha1_fault_injection_proto_depIdxs = []int32{
-	5,  // 0: kuma.mesh.v1alpha1.FaultInjection.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	5,  // 1: kuma.mesh.v1alpha1.FaultInjection.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	8,  // 0: kuma.mesh.v1alpha1.FaultInjection.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	8,  // 1: kuma.mesh.v1alpha1.FaultInjection.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	1,  // 2: kuma.mesh.v1alpha1.FaultInjection.conf:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf
-	2,  // 3: kuma.mesh.v1alpha1.FaultInjection.Conf.delay:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Delay
-	3,  // 4: kuma.mesh.v1alpha1.FaultInjection.Conf.abort:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Abort
-	4,  // 5: kuma.mesh.v1alpha1.FaultInjection.Conf.response_bandwidth:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth
-	6,  // 6: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay.percentage:type_name -> google.protobuf.DoubleValue
-	7,  // 7: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay.value:type_name -> google.protobuf.Duration
-	6,  // 8: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort.percentage:type_name -> google.protobuf.DoubleValue
-	8,  // 9: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort.httpStatus:type_name -> google.protobuf.UInt32Value
-	6,  // 10: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth.percentage:type_name -> google.protobuf.DoubleValue
-	9,  // 11: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth.limit:type_name -> google.protobuf.StringValue
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	9,  // 3: kuma.mesh.v1alpha1.FaultInjection.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: kuma.mesh.v1alpha1.FaultInjection.Conf.delay:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Delay
+	3,  // 5: kuma.mesh.v1alpha1.FaultInjection.Conf.abort:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Abort
+	4,  // 6: kuma.mesh.v1alpha1.FaultInjection.Conf.response_bandwidth:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth
+	5,  // 7: kuma.mesh.v1alpha1.FaultInjection.Conf.tcp:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp
+	6,  // 8: kuma.mesh.v1alpha1.FaultInjection.Conf.headers:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.HeadersEntry
+	10, // 9: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay.percentage:type_name -> google.protobuf.DoubleValue
+	11, // 10: kuma.mesh.v1alpha1.FaultInjection.Conf.Delay.value:type_name -> google.protobuf.Duration
+	10, // 11: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort.percentage:type_name -> google.protobuf.DoubleValue
+	12, // 12: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort.httpStatus:type_name -> google.protobuf.UInt32Value
+	12, // 13: kuma.mesh.v1alpha1.FaultInjection.Conf.Abort.grpcStatus:type_name -> google.protobuf.UInt32Value
+	10, // 14: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth.percentage:type_name -> google.protobuf.DoubleValue
+	13, // 15: kuma.mesh.v1alpha1.FaultInjection.Conf.ResponseBandwidth.limit:type_name -> google.protobuf.StringValue
+	7,  // 16: kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp.abort:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp.ConnectionAbort
+	14, // 17: kuma.mesh.v1alpha1.FaultInjection.Conf.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	10, // 18: kuma.mesh.v1alpha1.FaultInjection.Conf.Tcp.ConnectionAbort.percentage:type_name -> google.protobuf.DoubleValue
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_fault_injection_proto_init() }
@@ -478,6 +677,7 @@ func file_mesh_v1alpha1_fault_injection_proto_init() {
 		return
 	}
 	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_traffic_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_fault_injection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultInjection); i {
@@ -539,6 +739,30 @@ func file_mesh_v1alpha1_fault_injection_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_fault_injection_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultInjection_Conf_Tcp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_fault_injection_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultInjection_Conf_Tcp_ConnectionAbort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -546,7 +770,7 @@ func file_mesh_v1alpha1_fault_injection_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_fault_injection_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -13,6 +13,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -137,7 +138,7 @@ func (x MeshGateway_Listener_Protocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MeshGateway_Listener_Protocol.Descriptor instead.
 func (MeshGateway_Listener_Protocol) EnumDescriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 0}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 4, 0}
 }
 
 // MeshGateway is a virtual proxy.
@@ -257,6 +258,189 @@ func (*MeshGateway_TLS) Descriptor() ([]byte, []int) {
 	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Authentication configures how the gateway authenticates the HTTP
+// requests it receives. Requests that fail authentication are
+// rejected before they are routed.
+type MeshGateway_Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JWT configures JSON Web Token validation.
+	Jwt *MeshGateway_Authentication_JWT `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// OIDC configures the OpenID Connect login flow.
+	Oidc *MeshGateway_Authentication_OIDC `protobuf:"bytes,2,opt,name=oidc,proto3" json:"oidc,omitempty"`
+}
+
+func (x *MeshGateway_Authentication) Reset() {
+	*x = MeshGateway_Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Authentication) ProtoMessage() {}
+
+func (x *MeshGateway_Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Authentication.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Authentication) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *MeshGateway_Authentication) GetJwt() *MeshGateway_Authentication_JWT {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication) GetOidc() *MeshGateway_Authentication_OIDC {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+// Logging configures the access log of a listener.
+type MeshGateway_Logging struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Backend is the name of the mesh logging backend that the access
+	// log is sent to. If it is empty, the backend of the TrafficLog
+	// policy that matches the gateway is used.
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Format overrides the access log format of the backend. If neither
+	// sets a format, the default gateway format is used, which includes
+	// the client address, the SNI hostname and the TLS version.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *MeshGateway_Logging) Reset() {
+	*x = MeshGateway_Logging{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Logging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Logging) ProtoMessage() {}
+
+func (x *MeshGateway_Logging) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Logging.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Logging) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MeshGateway_Logging) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *MeshGateway_Logging) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Tracing configures the tracing of requests received by a listener.
+type MeshGateway_Tracing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Backend is the name of the mesh tracing backend that the spans
+	// are sent to. If it is empty, the backend of the TrafficTrace
+	// policy that matches the gateway is used.
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Sampling overrides the percentage of requests that the backend
+	// samples, in the range 0.0 - 100.0.
+	Sampling *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
+}
+
+func (x *MeshGateway_Tracing) Reset() {
+	*x = MeshGateway_Tracing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Tracing) ProtoMessage() {}
+
+func (x *MeshGateway_Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Tracing.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Tracing) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *MeshGateway_Tracing) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *MeshGateway_Tracing) GetSampling() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
 type MeshGateway_Listener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -284,12 +468,26 @@ type MeshGateway_Listener struct {
 	// gateway tags and the listener tags. A route will be attached to the
 	// listener if all of the route's tags are preset in the matching tags
 	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Authentication configures how requests received on the listener
+	// are authenticated. It can only be set on HTTP and HTTPS listeners,
+	// and all the listeners that share a port must have the same
+	// authentication.
+	Authentication *MeshGateway_Authentication `protobuf:"bytes,6,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	// Logging configures the access log of the listener in place of the
+	// TrafficLog policies. It can't be set on UDP listeners, and all
+	// the listeners that share a port must have the same logging.
+	Logging *MeshGateway_Logging `protobuf:"bytes,7,opt,name=logging,proto3" json:"logging,omitempty"`
+	// Tracing configures the tracing of the listener in place of the
+	// TrafficTrace policies. It can only be set on HTTP and HTTPS
+	// listeners, and all the listeners that share a port must have the
+	// same tracing.
+	Tracing *MeshGateway_Tracing `protobuf:"bytes,8,opt,name=tracing,proto3" json:"tracing,omitempty"`
 }
 
 func (x *MeshGateway_Listener) Reset() {
 	*x = MeshGateway_Listener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[2]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -302,7 +500,7 @@ func (x *MeshGateway_Listener) String() string {
 func (*MeshGateway_Listener) ProtoMessage() {}
 
 func (x *MeshGateway_Listener) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[2]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +513,7 @@ func (x *MeshGateway_Listener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MeshGateway_Listener.ProtoReflect.Descriptor instead.
 func (*MeshGateway_Listener) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 4}
 }
 
 func (x *MeshGateway_Listener) GetHostname() string {
@@ -353,6 +551,27 @@ func (x *MeshGateway_Listener) GetTags() map[string]string {
 	return nil
 }
 
+func (x *MeshGateway_Listener) GetAuthentication() *MeshGateway_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *MeshGateway_Listener) GetLogging() *MeshGateway_Logging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *MeshGateway_Listener) GetTracing() *MeshGateway_Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
 // Conf defines the desired state of MeshGateway.
 //
 // Aligns with MeshGatewaySpec.
@@ -369,7 +588,7 @@ type MeshGateway_Conf struct {
 func (x *MeshGateway_Conf) Reset() {
 	*x = MeshGateway_Conf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -382,7 +601,7 @@ func (x *MeshGateway_Conf) String() string {
 func (*MeshGateway_Conf) ProtoMessage() {}
 
 func (x *MeshGateway_Conf) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +614,7 @@ func (x *MeshGateway_Conf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MeshGateway_Conf.ProtoReflect.Descriptor instead.
 func (*MeshGateway_Conf) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 2}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 5}
 }
 
 func (x *MeshGateway_Conf) GetListeners() []*MeshGateway_Listener {
@@ -414,7 +633,7 @@ type MeshGateway_TLS_Options struct {
 func (x *MeshGateway_TLS_Options) Reset() {
 	*x = MeshGateway_TLS_Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +646,7 @@ func (x *MeshGateway_TLS_Options) String() string {
 func (*MeshGateway_TLS_Options) ProtoMessage() {}
 
 func (x *MeshGateway_TLS_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,6 +662,80 @@ func (*MeshGateway_TLS_Options) Descriptor() ([]byte, []int) {
 	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+// ACME configures a certificate issuer that implements the ACME
+// protocol (RFC 8555), such as Let's Encrypt. The control plane
+// obtains and renews the certificate for the listener hostname,
+// answering the HTTP-01 challenge through the gateway itself, and
+// stores it in a Secret.
+type MeshGateway_TLS_ACME struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DirectoryUrl is the URL of the ACME directory of the issuer.
+	DirectoryUrl string `protobuf:"bytes,1,opt,name=directory_url,json=directoryUrl,proto3" json:"directory_url,omitempty"`
+	// Email is the contact address of the ACME account. This is
+	// optional.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// CaCert is a datasource that contains the CA certificate used to
+	// verify the TLS certificate of the ACME directory. This is
+	// optional, and is mostly useful with local test issuers.
+	CaCert *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+}
+
+func (x *MeshGateway_TLS_ACME) Reset() {
+	*x = MeshGateway_TLS_ACME{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_TLS_ACME) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_TLS_ACME) ProtoMessage() {}
+
+func (x *MeshGateway_TLS_ACME) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_TLS_ACME.ProtoReflect.Descriptor instead.
+func (*MeshGateway_TLS_ACME) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MeshGateway_TLS_ACME) GetDirectoryUrl() string {
+	if x != nil {
+		return x.DirectoryUrl
+	}
+	return ""
+}
+
+func (x *MeshGateway_TLS_ACME) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MeshGateway_TLS_ACME) GetCaCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
 // Aligns with MeshGatewayTLSConfig.
 type MeshGateway_TLS_Conf struct {
 	state         protoimpl.MessageState
@@ -463,17 +756,24 @@ type MeshGateway_TLS_Conf struct {
 	// different key types. In practice, this means that one datasource
 	// should contain an RSA key and certificate, and the other an
 	// ECDSA key and certificate.
+	//
+	// Certificates must be empty if ACME is configured.
 	Certificates []*v1alpha1.DataSource `protobuf:"bytes,2,rep,name=certificates,proto3" json:"certificates,omitempty"`
 	// Options should eventually configure how TLS is configured. This
 	// is where cipher suite and version configuration can be specified,
 	// client certificates enforced, and so on.
 	Options *MeshGateway_TLS_Options `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// ACME obtains the certificate from an ACME issuer. The listener
+	// must have a hostname that is not a wildcard, and the issuer
+	// must be able to reach a HTTP listener of the gateway for that
+	// hostname on port 80.
+	Acme *MeshGateway_TLS_ACME `protobuf:"bytes,4,opt,name=acme,proto3" json:"acme,omitempty"`
 }
 
 func (x *MeshGateway_TLS_Conf) Reset() {
 	*x = MeshGateway_TLS_Conf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -486,7 +786,7 @@ func (x *MeshGateway_TLS_Conf) String() string {
 func (*MeshGateway_TLS_Conf) ProtoMessage() {}
 
 func (x *MeshGateway_TLS_Conf) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +799,7 @@ func (x *MeshGateway_TLS_Conf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MeshGateway_TLS_Conf.ProtoReflect.Descriptor instead.
 func (*MeshGateway_TLS_Conf) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 1}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 2}
 }
 
 func (x *MeshGateway_TLS_Conf) GetMode() MeshGateway_TLS_Mode {
@@ -523,6 +823,317 @@ func (x *MeshGateway_TLS_Conf) GetOptions() *MeshGateway_TLS_Options {
 	return nil
 }
 
+func (x *MeshGateway_TLS_Conf) GetAcme() *MeshGateway_TLS_ACME {
+	if x != nil {
+		return x.Acme
+	}
+	return nil
+}
+
+// JWT validates the JSON Web Tokens that clients send as bearer
+// tokens in the Authorization header.
+type MeshGateway_Authentication_JWT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Providers lists the token providers. A token is valid if it
+	// is verified by any of the providers.
+	Providers []*MeshGateway_Authentication_JWT_Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// AllowMissing specifies whether requests without a token are
+	// allowed. Requests with an invalid token are always rejected.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+}
+
+func (x *MeshGateway_Authentication_JWT) Reset() {
+	*x = MeshGateway_Authentication_JWT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Authentication_JWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Authentication_JWT) ProtoMessage() {}
+
+func (x *MeshGateway_Authentication_JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Authentication_JWT.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Authentication_JWT) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *MeshGateway_Authentication_JWT) GetProviders() []*MeshGateway_Authentication_JWT_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_JWT) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+// OIDC authenticates users with the OpenID Connect (OAuth2)
+// authorization code flow. Requests without a valid session are
+// redirected to the authorization endpoint of the identity provider.
+type MeshGateway_Authentication_OIDC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AuthorizationEndpoint is the URL of the identity provider
+	// authorization endpoint.
+	AuthorizationEndpoint string `protobuf:"bytes,1,opt,name=authorization_endpoint,json=authorizationEndpoint,proto3" json:"authorization_endpoint,omitempty"`
+	// TokenEndpoint is the HTTPS URL of the identity provider token
+	// endpoint.
+	TokenEndpoint string `protobuf:"bytes,2,opt,name=token_endpoint,json=tokenEndpoint,proto3" json:"token_endpoint,omitempty"`
+	// CaCert is a datasource that contains the CA certificate used to
+	// verify the TLS certificate of the token endpoint. This is
+	// optional, and the system trust store is used if it is empty.
+	CaCert *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+	// ClientId is the client identifier registered with the identity
+	// provider.
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// ClientSecret is a datasource that contains the client secret
+	// registered with the identity provider.
+	ClientSecret *v1alpha1.DataSource `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// HmacSecret is a datasource that contains the secret used to sign
+	// the session cookies.
+	HmacSecret *v1alpha1.DataSource `protobuf:"bytes,6,opt,name=hmac_secret,json=hmacSecret,proto3" json:"hmac_secret,omitempty"`
+	// RedirectPath is the path that the identity provider redirects
+	// users back to after they have logged in. The default is
+	// "/oauth2/callback".
+	RedirectPath string `protobuf:"bytes,7,opt,name=redirect_path,json=redirectPath,proto3" json:"redirect_path,omitempty"`
+	// SignoutPath is a path that ends the user session. This is
+	// optional.
+	SignoutPath string `protobuf:"bytes,8,opt,name=signout_path,json=signoutPath,proto3" json:"signout_path,omitempty"`
+	// Scopes lists the OAuth2 scopes to request. The default is
+	// "openid".
+	Scopes []string `protobuf:"bytes,9,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// ForwardBearerToken specifies whether the access token is
+	// forwarded to the backends in the Authorization header.
+	ForwardBearerToken bool `protobuf:"varint,10,opt,name=forward_bearer_token,json=forwardBearerToken,proto3" json:"forward_bearer_token,omitempty"`
+}
+
+func (x *MeshGateway_Authentication_OIDC) Reset() {
+	*x = MeshGateway_Authentication_OIDC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Authentication_OIDC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Authentication_OIDC) ProtoMessage() {}
+
+func (x *MeshGateway_Authentication_OIDC) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Authentication_OIDC.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Authentication_OIDC) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetAuthorizationEndpoint() string {
+	if x != nil {
+		return x.AuthorizationEndpoint
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetTokenEndpoint() string {
+	if x != nil {
+		return x.TokenEndpoint
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetCaCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetClientSecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetHmacSecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.HmacSecret
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetRedirectPath() string {
+	if x != nil {
+		return x.RedirectPath
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetSignoutPath() string {
+	if x != nil {
+		return x.SignoutPath
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_OIDC) GetForwardBearerToken() bool {
+	if x != nil {
+		return x.ForwardBearerToken
+	}
+	return false
+}
+
+type MeshGateway_Authentication_JWT_Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name uniquely identifies the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Issuer is the expected value of the token "iss" claim. If it
+	// is empty, the issuer is not checked.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Audiences lists the accepted values of the token "aud" claim.
+	// If it is empty, the audience is not checked.
+	Audiences []string `protobuf:"bytes,3,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// JWKS is a datasource that contains the JSON Web Key Set used
+	// to verify the token signatures.
+	Jwks *v1alpha1.DataSource `protobuf:"bytes,4,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	// Forward specifies whether the token is forwarded to the
+	// backends after it has been verified.
+	Forward bool `protobuf:"varint,5,opt,name=forward,proto3" json:"forward,omitempty"`
+	// ForwardPayloadHeader is the name of a request header that the
+	// base64url encoded token payload is forwarded in. This is
+	// optional.
+	ForwardPayloadHeader string `protobuf:"bytes,6,opt,name=forward_payload_header,json=forwardPayloadHeader,proto3" json:"forward_payload_header,omitempty"`
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) Reset() {
+	*x = MeshGateway_Authentication_JWT_Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGateway_Authentication_JWT_Provider) ProtoMessage() {}
+
+func (x *MeshGateway_Authentication_JWT_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGateway_Authentication_JWT_Provider.ProtoReflect.Descriptor instead.
+func (*MeshGateway_Authentication_JWT_Provider) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 0, 0}
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetJwks() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Jwks
+	}
+	return nil
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+func (x *MeshGateway_Authentication_JWT_Provider) GetForwardPayloadHeader() string {
+	if x != nil {
+		return x.ForwardPayloadHeader
+	}
+	return ""
+}
+
 var File_mesh_v1alpha1_gateway_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_gateway_proto_rawDesc = []byte{
@@ -530,13 +1141,15 @@ var file_mesh_v1alpha1_gateway_proto_rawDesc = []byte{
 	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b,
 	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x09, 0x0a,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x15, 0x0a,
 	0x0b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x09,
 	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
@@ -550,71 +1163,168 @@ var file_mesh_v1alpha1_gateway_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
 	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52,
-	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xa2, 0x02, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x1a, 0x09, 0x0a,
-	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xdd, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x6e,
-	0x66, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x50, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x45, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xdc, 0x03, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x1a, 0x09, 0x0a,
+	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x7c, 0x0a, 0x04, 0x41, 0x43, 0x4d, 0x45,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x99, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
+	0x4c, 0x53, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a,
+	0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x02, 0x52,
+	0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x54, 0x4c, 0x53, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45,
-	0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x53,
-	0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x02, 0x1a, 0x8c, 0x03, 0x0a, 0x08, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x03, 0x74,
-	0x6c, 0x73, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61,
-	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50,
-	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x54,
-	0x4c, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x04, 0x12, 0x09,
-	0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x05, 0x1a, 0x58, 0x0a, 0x04, 0x43, 0x6f, 0x6e,
-	0x66, 0x12, 0x50, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x52, 0x04, 0x61, 0x63,
+	0x6d, 0x65, 0x22, 0x30, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x4f, 0x55,
+	0x47, 0x48, 0x10, 0x02, 0x1a, 0xdf, 0x07, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x61, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x15, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0d, 0x12,
-	0x0b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f,
-	0x3a, 0x0d, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42,
-	0x4c, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
-	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
-	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1e, 0x50,
-	0x01, 0xa2, 0x01, 0x0b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0xf2,
-	0x01, 0x0b, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x57, 0x54, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x47, 0x0a,
+	0x04, 0x6f, 0x69, 0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43,
+	0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x1a, 0xe2, 0x02, 0x0a, 0x03, 0x4a, 0x57, 0x54, 0x12, 0x59,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4a, 0x57, 0x54, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x1a, 0xda,
+	0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xd8, 0x03, 0x0a, 0x04,
+	0x4f, 0x49, 0x44, 0x43, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x41, 0x0a, 0x0b, 0x68, 0x6d, 0x61, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x68, 0x6d, 0x61, 0x63, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x3b, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x1a, 0x5d, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x1a, 0xea, 0x04, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x4d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3a,
+	0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54,
+	0x54, 0x50, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x05, 0x1a,
+	0x58, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x50, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x3a, 0x61, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x15, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x68,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x0d, 0x12, 0x0b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x3a, 0x0d, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x42, 0x4c, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x8a, 0xb5, 0x18, 0x1e, 0x50, 0x01, 0xa2, 0x01, 0x0b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0xf2, 0x01, 0x0b, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -630,37 +1340,58 @@ func file_mesh_v1alpha1_gateway_proto_rawDescGZIP() []byte {
 }
 
 var file_mesh_v1alpha1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mesh_v1alpha1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mesh_v1alpha1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_mesh_v1alpha1_gateway_proto_goTypes = []interface{}{
-	(MeshGateway_TLS_Mode)(0),          // 0: kuma.mesh.v1alpha1.MeshGateway.TLS.Mode
-	(MeshGateway_Listener_Protocol)(0), // 1: kuma.mesh.v1alpha1.MeshGateway.Listener.Protocol
-	(*MeshGateway)(nil),                // 2: kuma.mesh.v1alpha1.MeshGateway
-	(*MeshGateway_TLS)(nil),            // 3: kuma.mesh.v1alpha1.MeshGateway.TLS
-	(*MeshGateway_Listener)(nil),       // 4: kuma.mesh.v1alpha1.MeshGateway.Listener
-	(*MeshGateway_Conf)(nil),           // 5: kuma.mesh.v1alpha1.MeshGateway.Conf
-	nil,                                // 6: kuma.mesh.v1alpha1.MeshGateway.TagsEntry
-	(*MeshGateway_TLS_Options)(nil),    // 7: kuma.mesh.v1alpha1.MeshGateway.TLS.Options
-	(*MeshGateway_TLS_Conf)(nil),       // 8: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf
-	nil,                                // 9: kuma.mesh.v1alpha1.MeshGateway.Listener.TagsEntry
-	(*Selector)(nil),                   // 10: kuma.mesh.v1alpha1.Selector
-	(*v1alpha1.DataSource)(nil),        // 11: kuma.system.v1alpha1.DataSource
+	(MeshGateway_TLS_Mode)(0),                       // 0: kuma.mesh.v1alpha1.MeshGateway.TLS.Mode
+	(MeshGateway_Listener_Protocol)(0),              // 1: kuma.mesh.v1alpha1.MeshGateway.Listener.Protocol
+	(*MeshGateway)(nil),                             // 2: kuma.mesh.v1alpha1.MeshGateway
+	(*MeshGateway_TLS)(nil),                         // 3: kuma.mesh.v1alpha1.MeshGateway.TLS
+	(*MeshGateway_Authentication)(nil),              // 4: kuma.mesh.v1alpha1.MeshGateway.Authentication
+	(*MeshGateway_Logging)(nil),                     // 5: kuma.mesh.v1alpha1.MeshGateway.Logging
+	(*MeshGateway_Tracing)(nil),                     // 6: kuma.mesh.v1alpha1.MeshGateway.Tracing
+	(*MeshGateway_Listener)(nil),                    // 7: kuma.mesh.v1alpha1.MeshGateway.Listener
+	(*MeshGateway_Conf)(nil),                        // 8: kuma.mesh.v1alpha1.MeshGateway.Conf
+	nil,                                             // 9: kuma.mesh.v1alpha1.MeshGateway.TagsEntry
+	(*MeshGateway_TLS_Options)(nil),                 // 10: kuma.mesh.v1alpha1.MeshGateway.TLS.Options
+	(*MeshGateway_TLS_ACME)(nil),                    // 11: kuma.mesh.v1alpha1.MeshGateway.TLS.ACME
+	(*MeshGateway_TLS_Conf)(nil),                    // 12: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf
+	(*MeshGateway_Authentication_JWT)(nil),          // 13: kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT
+	(*MeshGateway_Authentication_OIDC)(nil),         // 14: kuma.mesh.v1alpha1.MeshGateway.Authentication.OIDC
+	(*MeshGateway_Authentication_JWT_Provider)(nil), // 15: kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT.Provider
+	nil,                            // 16: kuma.mesh.v1alpha1.MeshGateway.Listener.TagsEntry
+	(*Selector)(nil),               // 17: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.DoubleValue)(nil), // 18: google.protobuf.DoubleValue
+	(*v1alpha1.DataSource)(nil),    // 19: kuma.system.v1alpha1.DataSource
 }
 var file_mesh_v1alpha1_gateway_proto_depIdxs = []int32{
-	10, // 0: kuma.mesh.v1alpha1.MeshGateway.selectors:type_name -> kuma.mesh.v1alpha1.Selector
-	6,  // 1: kuma.mesh.v1alpha1.MeshGateway.tags:type_name -> kuma.mesh.v1alpha1.MeshGateway.TagsEntry
-	5,  // 2: kuma.mesh.v1alpha1.MeshGateway.conf:type_name -> kuma.mesh.v1alpha1.MeshGateway.Conf
-	1,  // 3: kuma.mesh.v1alpha1.MeshGateway.Listener.protocol:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener.Protocol
-	8,  // 4: kuma.mesh.v1alpha1.MeshGateway.Listener.tls:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Conf
-	9,  // 5: kuma.mesh.v1alpha1.MeshGateway.Listener.tags:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener.TagsEntry
-	4,  // 6: kuma.mesh.v1alpha1.MeshGateway.Conf.listeners:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener
-	0,  // 7: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.mode:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Mode
-	11, // 8: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.certificates:type_name -> kuma.system.v1alpha1.DataSource
-	7,  // 9: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.options:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Options
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	17, // 0: kuma.mesh.v1alpha1.MeshGateway.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	9,  // 1: kuma.mesh.v1alpha1.MeshGateway.tags:type_name -> kuma.mesh.v1alpha1.MeshGateway.TagsEntry
+	8,  // 2: kuma.mesh.v1alpha1.MeshGateway.conf:type_name -> kuma.mesh.v1alpha1.MeshGateway.Conf
+	13, // 3: kuma.mesh.v1alpha1.MeshGateway.Authentication.jwt:type_name -> kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT
+	14, // 4: kuma.mesh.v1alpha1.MeshGateway.Authentication.oidc:type_name -> kuma.mesh.v1alpha1.MeshGateway.Authentication.OIDC
+	18, // 5: kuma.mesh.v1alpha1.MeshGateway.Tracing.sampling:type_name -> google.protobuf.DoubleValue
+	1,  // 6: kuma.mesh.v1alpha1.MeshGateway.Listener.protocol:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener.Protocol
+	12, // 7: kuma.mesh.v1alpha1.MeshGateway.Listener.tls:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Conf
+	16, // 8: kuma.mesh.v1alpha1.MeshGateway.Listener.tags:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener.TagsEntry
+	4,  // 9: kuma.mesh.v1alpha1.MeshGateway.Listener.authentication:type_name -> kuma.mesh.v1alpha1.MeshGateway.Authentication
+	5,  // 10: kuma.mesh.v1alpha1.MeshGateway.Listener.logging:type_name -> kuma.mesh.v1alpha1.MeshGateway.Logging
+	6,  // 11: kuma.mesh.v1alpha1.MeshGateway.Listener.tracing:type_name -> kuma.mesh.v1alpha1.MeshGateway.Tracing
+	7,  // 12: kuma.mesh.v1alpha1.MeshGateway.Conf.listeners:type_name -> kuma.mesh.v1alpha1.MeshGateway.Listener
+	19, // 13: kuma.mesh.v1alpha1.MeshGateway.TLS.ACME.ca_cert:type_name -> kuma.system.v1alpha1.DataSource
+	0,  // 14: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.mode:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Mode
+	19, // 15: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.certificates:type_name -> kuma.system.v1alpha1.DataSource
+	10, // 16: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.options:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.Options
+	11, // 17: kuma.mesh.v1alpha1.MeshGateway.TLS.Conf.acme:type_name -> kuma.mesh.v1alpha1.MeshGateway.TLS.ACME
+	15, // 18: kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT.providers:type_name -> kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT.Provider
+	19, // 19: kuma.mesh.v1alpha1.MeshGateway.Authentication.OIDC.ca_cert:type_name -> kuma.system.v1alpha1.DataSource
+	19, // 20: kuma.mesh.v1alpha1.MeshGateway.Authentication.OIDC.client_secret:type_name -> kuma.system.v1alpha1.DataSource
+	19, // 21: kuma.mesh.v1alpha1.MeshGateway.Authentication.OIDC.hmac_secret:type_name -> kuma.system.v1alpha1.DataSource
+	19, // 22: kuma.mesh.v1alpha1.MeshGateway.Authentication.JWT.Provider.jwks:type_name -> kuma.system.v1alpha1.DataSource
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_gateway_proto_init() }
@@ -695,7 +1426,7 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGateway_Listener); i {
+			switch v := v.(*MeshGateway_Authentication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -707,7 +1438,19 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGateway_Conf); i {
+			switch v := v.(*MeshGateway_Logging); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_Tracing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -719,7 +1462,7 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGateway_TLS_Options); i {
+			switch v := v.(*MeshGateway_Listener); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -731,6 +1474,42 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_TLS_Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_TLS_ACME); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshGateway_TLS_Conf); i {
 			case 0:
 				return &v.state
@@ -742,6 +1521,42 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_Authentication_JWT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_Authentication_OIDC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGateway_Authentication_JWT_Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -749,7 +1564,7 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_gateway_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
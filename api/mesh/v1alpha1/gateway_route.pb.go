@@ -12,6 +12,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -174,6 +175,9 @@ type MeshGatewayRoute struct {
 	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
 	// Conf specifies the route configuration.
 	Conf *MeshGatewayRoute_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *MeshGatewayRoute) Reset() {
@@ -222,6 +226,13 @@ func (x *MeshGatewayRoute) GetConf() *MeshGatewayRoute_Conf {
 	return nil
 }
 
+func (x *MeshGatewayRoute) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // Backend selects a target for HTTP request forwarding.
 type MeshGatewayRoute_Backend struct {
 	state         protoimpl.MessageState
@@ -291,6 +302,10 @@ type MeshGatewayRoute_UdpRoute struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Rules specifies how the gateway should forward UDP datagrams.
+	// Datagrams can't be matched to a rule, so the backends of all the
+	// rules of all the UDP routes of a listener share the datagrams
+	// according to their weights.
 	Rules []*MeshGatewayRoute_UdpRoute_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
@@ -333,12 +348,16 @@ func (x *MeshGatewayRoute_UdpRoute) GetRules() []*MeshGatewayRoute_UdpRoute_Rule
 	return nil
 }
 
-// TLS routes are valid for listeners that accept connections over TLS.
+// TCP routes are valid for listeners that accept TCP connections.
 type MeshGatewayRoute_TcpRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Rules specifies how the gateway should forward TCP connections.
+	// Connections can't be matched to a rule, so the backends of all the
+	// rules of all the TCP routes of a listener share the connections
+	// according to their weights.
 	Rules []*MeshGatewayRoute_TcpRoute_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
@@ -383,7 +402,8 @@ func (x *MeshGatewayRoute_TcpRoute) GetRules() []*MeshGatewayRoute_TcpRoute_Rule
 
 // TLS routes are valid for listeners that accept connections over TLS.
 // This can be a raw TLS connection, but can also be used to forward
-// HTTP and other protocols that layer on top of TLS.
+// HTTP and other protocols that layer on top of TLS. The gateway does
+// not terminate the TLS session, so the backends have to.
 type MeshGatewayRoute_TlsRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -392,8 +412,13 @@ type MeshGatewayRoute_TlsRoute struct {
 	// Hostnames lists the server names for which this route is valid. The
 	// hostnames are matched against the TLS Server Name Indication extension
 	// send by the client.
-	Hostnames []string                          `protobuf:"bytes,1,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
-	Rules     []*MeshGatewayRoute_TlsRoute_Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Hostnames []string `protobuf:"bytes,1,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	// Rules specifies how the gateway should forward TLS connections.
+	// Connections are only matched on the hostnames, so the backends of
+	// all the rules of all the TLS routes that match a hostname share the
+	// connections according to their weights. Routes that list the
+	// hostname take precedence over the routes without hostnames.
+	Rules []*MeshGatewayRoute_TlsRoute_Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
 func (x *MeshGatewayRoute_TlsRoute) Reset() {
@@ -615,6 +640,9 @@ func (*MeshGatewayRoute_Conf_Tls) isMeshGatewayRoute_Conf_Route() {}
 
 func (*MeshGatewayRoute_Conf_Http) isMeshGatewayRoute_Conf_Route() {}
 
+// Match is reserved for future use. UDP datagrams have no attributes
+// that a route can match on, so every datagram received by the
+// listener is forwarded to the backends of the route.
 type MeshGatewayRoute_UdpRoute_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -658,8 +686,11 @@ type MeshGatewayRoute_UdpRoute_Rule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Matches  []*MeshGatewayRoute_UdpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	Backends []*MeshGatewayRoute_Backend        `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	Matches []*MeshGatewayRoute_UdpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Backends is the set of services the datagrams are forwarded to.
+	// Datagrams are distributed across the backends according to
+	// their weights.
+	Backends []*MeshGatewayRoute_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
 func (x *MeshGatewayRoute_UdpRoute_Rule) Reset() {
@@ -708,6 +739,9 @@ func (x *MeshGatewayRoute_UdpRoute_Rule) GetBackends() []*MeshGatewayRoute_Backe
 	return nil
 }
 
+// Match is reserved for future use. TCP connections have no attributes
+// that a route can match on, so every connection accepted by the
+// listener is forwarded to the backends of the route.
 type MeshGatewayRoute_TcpRoute_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -751,8 +785,11 @@ type MeshGatewayRoute_TcpRoute_Rule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Matches  []*MeshGatewayRoute_TcpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	Backends []*MeshGatewayRoute_Backend        `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	Matches []*MeshGatewayRoute_TcpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Backends is the set of services the connections are forwarded to.
+	// Connections are distributed across the backends according to
+	// their weights.
+	Backends []*MeshGatewayRoute_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
 func (x *MeshGatewayRoute_TcpRoute_Rule) Reset() {
@@ -801,6 +838,8 @@ func (x *MeshGatewayRoute_TcpRoute_Rule) GetBackends() []*MeshGatewayRoute_Backe
 	return nil
 }
 
+// Match is reserved for future use. Connections are matched on the
+// route hostnames.
 type MeshGatewayRoute_TlsRoute_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -844,8 +883,11 @@ type MeshGatewayRoute_TlsRoute_Rule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Matches  []*MeshGatewayRoute_TlsRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	Backends []*MeshGatewayRoute_Backend        `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	Matches []*MeshGatewayRoute_TlsRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Backends is the set of services the connections are forwarded to.
+	// Connections are distributed across the backends according to
+	// their weights.
+	Backends []*MeshGatewayRoute_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
 func (x *MeshGatewayRoute_TlsRoute_Rule) Reset() {
@@ -978,6 +1020,9 @@ type MeshGatewayRoute_HttpRoute_Filter struct {
 	//	*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_
 	//	*MeshGatewayRoute_HttpRoute_Filter_Mirror_
 	//	*MeshGatewayRoute_HttpRoute_Filter_Redirect_
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_
+	//	*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader_
+	//	*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_
 	Filter isMeshGatewayRoute_HttpRoute_Filter_Filter `protobuf_oneof:"filter"`
 }
 
@@ -1041,6 +1086,27 @@ func (x *MeshGatewayRoute_HttpRoute_Filter) GetRedirect() *MeshGatewayRoute_Http
 	return nil
 }
 
+func (x *MeshGatewayRoute_HttpRoute_Filter) GetRewrite() *MeshGatewayRoute_HttpRoute_Filter_Rewrite {
+	if x, ok := x.GetFilter().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_); ok {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter) GetResponseHeader() *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader {
+	if x, ok := x.GetFilter().(*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader_); ok {
+		return x.ResponseHeader
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter) GetDirectResponse() *MeshGatewayRoute_HttpRoute_Filter_DirectResponse {
+	if x, ok := x.GetFilter().(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_); ok {
+		return x.DirectResponse
+	}
+	return nil
+}
+
 type isMeshGatewayRoute_HttpRoute_Filter_Filter interface {
 	isMeshGatewayRoute_HttpRoute_Filter_Filter()
 }
@@ -1057,6 +1123,18 @@ type MeshGatewayRoute_HttpRoute_Filter_Redirect_ struct {
 	Redirect *MeshGatewayRoute_HttpRoute_Filter_Redirect `protobuf:"bytes,3,opt,name=redirect,proto3,oneof"`
 }
 
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_ struct {
+	Rewrite *MeshGatewayRoute_HttpRoute_Filter_Rewrite `protobuf:"bytes,4,opt,name=rewrite,proto3,oneof"`
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_ResponseHeader_ struct {
+	ResponseHeader *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader `protobuf:"bytes,5,opt,name=response_header,json=responseHeader,proto3,oneof"`
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_DirectResponse_ struct {
+	DirectResponse *MeshGatewayRoute_HttpRoute_Filter_DirectResponse `protobuf:"bytes,6,opt,name=direct_response,json=directResponse,proto3,oneof"`
+}
+
 func (*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {
 }
 
@@ -1064,6 +1142,14 @@ func (*MeshGatewayRoute_HttpRoute_Filter_Mirror_) isMeshGatewayRoute_HttpRoute_F
 
 func (*MeshGatewayRoute_HttpRoute_Filter_Redirect_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {}
 
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {
+}
+
 type MeshGatewayRoute_HttpRoute_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1076,13 +1162,19 @@ type MeshGatewayRoute_HttpRoute_Rule struct {
 	// matched requests.
 	//
 	// If the redirect filter is specified, it must be the only
-	// filter given.
+	// filter given. If the direct response filter is specified, it
+	// can only be combined with response header filters.
 	Filters []*MeshGatewayRoute_HttpRoute_Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
 	// Backends is the set of services to which the gateway will
-	// forward requests. If a redirect filter is specified, no
-	// backends are allowed. Otherwise, at least one backend
-	// must be given.
+	// forward requests. If a redirect or direct response filter is
+	// specified, no backends are allowed. Otherwise, at least one
+	// backend must be given.
 	Backends []*MeshGatewayRoute_Backend `protobuf:"bytes,3,rep,name=backends,proto3" json:"backends,omitempty"`
+	// LoadBalancer configures the load balancing of the requests to the
+	// backends. Hash policies let the "ring_hash" and "maglev" load
+	// balancers keep the requests of a client on the same backend host,
+	// e.g. by hashing a cookie.
+	LoadBalancer *TrafficRoute_LoadBalancer `protobuf:"bytes,4,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
 }
 
 func (x *MeshGatewayRoute_HttpRoute_Rule) Reset() {
@@ -1138,6 +1230,13 @@ func (x *MeshGatewayRoute_HttpRoute_Rule) GetBackends() []*MeshGatewayRoute_Back
 	return nil
 }
 
+func (x *MeshGatewayRoute_HttpRoute_Rule) GetLoadBalancer() *TrafficRoute_LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
 // Path matches may be "EXACT", "PREFIX", or "REGEX" matches. If
 // the match type is not specified, "EXACT" is the default.
 type MeshGatewayRoute_HttpRoute_Match_Path struct {
@@ -1395,6 +1494,132 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader) GetRemove() []string {
 	return nil
 }
 
+// The response header filter modifies the headers of the HTTP
+// response before it is sent to the client.
+type MeshGatewayRoute_HttpRoute_Filter_ResponseHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set    []*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Add    []*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove []string                                                  `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) Reset() {
+	*x = MeshGatewayRoute_HttpRoute_Filter_ResponseHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) ProtoMessage() {}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_ResponseHeader.ProtoReflect.Descriptor instead.
+func (*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 1}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) GetSet() []*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) GetAdd() []*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_ResponseHeader) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+// The direct response filter responds to the HTTP request
+// immediately, without forwarding it to any backend. The response
+// has the given status code and body.
+type MeshGatewayRoute_HttpRoute_Filter_DirectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP response status code. This must be in the range 200 - 599.
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Body is the content of the response body. It can be at most
+	// 4096 bytes.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) Reset() {
+	*x = MeshGatewayRoute_HttpRoute_Filter_DirectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse) ProtoMessage() {}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_DirectResponse.ProtoReflect.Descriptor instead.
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 2}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 // The mirror filter sends a percentage of HTTP requests to the
 // given backend. The gateway ignores any responses to these requests.
 type MeshGatewayRoute_HttpRoute_Filter_Mirror struct {
@@ -1413,7 +1638,7 @@ type MeshGatewayRoute_HttpRoute_Filter_Mirror struct {
 func (x *MeshGatewayRoute_HttpRoute_Filter_Mirror) Reset() {
 	*x = MeshGatewayRoute_HttpRoute_Filter_Mirror{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1426,7 +1651,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Mirror) String() string {
 func (*MeshGatewayRoute_HttpRoute_Filter_Mirror) ProtoMessage() {}
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Mirror) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +1664,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Mirror) ProtoReflect() protoreflect.M
 
 // Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_Mirror.ProtoReflect.Descriptor instead.
 func (*MeshGatewayRoute_HttpRoute_Filter_Mirror) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 1}
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 3}
 }
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Mirror) GetBackend() *MeshGatewayRoute_Backend {
@@ -1477,7 +1702,7 @@ type MeshGatewayRoute_HttpRoute_Filter_Redirect struct {
 func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) Reset() {
 	*x = MeshGatewayRoute_HttpRoute_Filter_Redirect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1490,7 +1715,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) String() string {
 func (*MeshGatewayRoute_HttpRoute_Filter_Redirect) ProtoMessage() {}
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,7 +1728,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) ProtoReflect() protoreflect
 
 // Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_Redirect.ProtoReflect.Descriptor instead.
 func (*MeshGatewayRoute_HttpRoute_Filter_Redirect) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 2}
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 4}
 }
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) GetScheme() string {
@@ -1534,6 +1759,162 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) GetStatusCode() uint32 {
 	return 0
 }
 
+// The rewrite filter modifies the request URL before the request
+// is forwarded to the backends. At most one of the path rewrites
+// and one of the host rewrites may be given.
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Path:
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex
+	Path isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path `protobuf_oneof:"path"`
+	// Types that are assignable to Host:
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname
+	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname
+	Host isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host `protobuf_oneof:"host"`
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) Reset() {
+	*x = MeshGatewayRoute_HttpRoute_Filter_Rewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite) ProtoMessage() {}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_Rewrite.ProtoReflect.Descriptor instead.
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 5}
+}
+
+func (m *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetPath() isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetReplaceFull() string {
+	if x, ok := x.GetPath().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull); ok {
+		return x.ReplaceFull
+	}
+	return ""
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetReplacePrefixMatch() string {
+	if x, ok := x.GetPath().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch); ok {
+		return x.ReplacePrefixMatch
+	}
+	return ""
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetReplaceRegex() *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution {
+	if x, ok := x.GetPath().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex); ok {
+		return x.ReplaceRegex
+	}
+	return nil
+}
+
+func (m *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetHost() isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetHostname() string {
+	if x, ok := x.GetHost().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname); ok {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetHostToBackendHostname() bool {
+	if x, ok := x.GetHost().(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname); ok {
+		return x.HostToBackendHostname
+	}
+	return false
+}
+
+type isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path interface {
+	isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path()
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull struct {
+	// ReplaceFull replaces the whole request path. The query
+	// string is preserved.
+	ReplaceFull string `protobuf:"bytes,1,opt,name=replace_full,json=replaceFull,proto3,oneof"`
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch struct {
+	// ReplacePrefixMatch replaces the part of the request path that
+	// was matched by the "PREFIX" path match of the rule. An empty
+	// value removes the matched prefix. Every match of the rule
+	// must be a "PREFIX" path match.
+	ReplacePrefixMatch string `protobuf:"bytes,2,opt,name=replace_prefix_match,json=replacePrefixMatch,proto3,oneof"`
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex struct {
+	// ReplaceRegex rewrites the request path with a regular
+	// expression substitution.
+	ReplaceRegex *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution `protobuf:"bytes,3,opt,name=replace_regex,json=replaceRegex,proto3,oneof"`
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull) isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path() {
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch) isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path() {
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex) isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path() {
+}
+
+type isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host interface {
+	isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host()
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname struct {
+	// Hostname replaces the Host header of the forwarded request.
+	Hostname string `protobuf:"bytes,4,opt,name=hostname,proto3,oneof"`
+}
+
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname struct {
+	// HostToBackendHostname replaces the Host header of the forwarded
+	// request with the hostname of the selected backend. This only
+	// has an effect for backends that are addressed by hostname,
+	// such as external services.
+	HostToBackendHostname bool `protobuf:"varint,5,opt,name=host_to_backend_hostname,json=hostToBackendHostname,proto3,oneof"`
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname) isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host() {
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname) isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Host() {
+}
+
 type MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1546,7 +1927,7 @@ type MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header struct {
 func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) Reset() {
 	*x = MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1559,7 +1940,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) String() string
 func (*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoMessage() {}
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,6 +1970,68 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) GetValue() stri
 	return ""
 }
 
+// RegexSubstitution rewrites the parts of the path that match
+// a regular expression.
+type MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pattern is the RE2 regular expression to match against
+	// the request path.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Substitution is the string that replaces the matched parts
+	// of the path. It may contain numbered capture groups, like
+	// "\1".
+	Substitution string `protobuf:"bytes,2,opt,name=substitution,proto3" json:"substitution,omitempty"`
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) Reset() {
+	*x = MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) ProtoMessage() {}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution.ProtoReflect.Descriptor instead.
+func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 5, 0}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
 var File_mesh_v1alpha1_gateway_route_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
@@ -1596,274 +2039,353 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xca, 0x1f, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x12, 0x4b, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
-	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xea, 0x01,
-	0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x8a, 0x01,
-	0x02, 0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x79, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x18, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x08,
-	0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa1, 0x02, 0x0a, 0x08, 0x55,
-	0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01,
-	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a,
-	0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xad, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x12, 0x56, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x28, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x1a, 0xa1,
-	0x02, 0x0a, 0x08, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d,
-	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c,
-	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xad, 0x01, 0x0a,
-	0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04, 0x88, 0xb5,
-	0x18, 0x01, 0x1a, 0xbf, 0x02, 0x0a, 0x08, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x56, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
-	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65,
-	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xad,
-	0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54,
-	0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04,
-	0x88, 0xb5, 0x18, 0x01, 0x1a, 0x94, 0x12, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x0c, 0x88,
+	0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e,
+	0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0xea, 0x01, 0x0a,
+	0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x79,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x18, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x08, 0x01,
+	0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x9b, 0x02, 0x0a, 0x08, 0x55, 0x64,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
+	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07,
+	0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xad, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x56, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42,
+	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x9b, 0x02, 0x0a, 0x08, 0x54, 0x63, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xad, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4d,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x56, 0x0a,
+	0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88,
+	0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0xb9, 0x02, 0x0a, 0x08, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75,
 	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x57, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x12, 0x56, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xaa, 0x07, 0x0a, 0x05, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x4d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x36, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x1a, 0xad, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x65, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75,
+	0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x08, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0xbb, 0x01, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x59, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x88, 0xb5, 0x18,
-	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x1a, 0xce, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x5b, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x45, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5,
-	0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x1a, 0xcc, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x5a, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18,
-	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x1a, 0xda, 0x07, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x6c, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00,
-	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x56, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x1a, 0xb3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x5c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03,
-	0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb0, 0x01, 0x0a, 0x06,
-	0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0a,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x12,
-	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x59, 0x40, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0xb8,
-	0x01, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18,
-	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x65, 0x12, 0x28, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04,
-	0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x1c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x05,
-	0x2a, 0x03, 0x28, 0xac, 0x02, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xb4, 0x02, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x1a, 0x85, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5c, 0x0a, 0x07,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
+	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x73, 0x1a, 0x98, 0x1b, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x57, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xaa, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x4d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x36, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x65,
+	0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0xbb, 0x01, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x59,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
 	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
-	0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45,
+	0x58, 0x10, 0x02, 0x1a, 0xce, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5b,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x04, 0x88, 0xb5, 0x18,
-	0x00, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x9e, 0x02, 0x0a, 0x04,
-	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x41, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x41, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x41, 0x0a, 0x03, 0x74, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5,
+	0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
+	0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47,
+	0x45, 0x58, 0x10, 0x01, 0x1a, 0xcc, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x5a,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45,
+	0x58, 0x10, 0x01, 0x1a, 0x8a, 0x10, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x6c,
+	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x06,
+	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x59, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x6f, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f,
+	0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c,
-	0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x44, 0x0a,
-	0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75,
+	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0xb3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x5c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12,
+	0x5c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88,
+	0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xe4, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x5c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x63, 0x0a, 0x0e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x1c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0xc8, 0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xd7,
+	0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x1a, 0xb0, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x12, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x1a, 0xb8, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0xac, 0x02, 0xfa, 0x42, 0x05, 0x2a, 0x03,
+	0x18, 0xb4, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0xa4, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x75, 0x6c, 0x6c,
+	0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x76, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x70, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x1a, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x12, 0x12, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65,
-	0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04,
-	0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x3a, 0x12, 0x0a, 0x10, 0x6d, 0x65,
-	0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x56,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x28, 0x50, 0x01,
-	0xa2, 0x01, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0xf2, 0x01, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78,
+	0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x18, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x15,
+	0x68, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x5f, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x65, 0x78, 0x53, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x06,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0xd9, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5c, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x52, 0x08,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x0c,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x1a, 0x9e, 0x02, 0x0a,
+	0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x41, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x41, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x41, 0x0a, 0x03, 0x74,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54,
+	0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x44,
+	0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x70, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x1a, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x12, 0x12, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d,
+	0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x3a, 0x12, 0x0a, 0x10, 0x6d,
+	0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42,
+	0x56, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x28, 0x50,
+	0x01, 0xa2, 0x01, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0xf2, 0x01, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1879,79 +2401,93 @@ func file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP() []byte {
 }
 
 var file_mesh_v1alpha1_gateway_route_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_mesh_v1alpha1_gateway_route_proto_goTypes = []interface{}{
-	(MeshGatewayRoute_HttpRoute_Match_Path_MatchType)(0),           // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
-	(MeshGatewayRoute_HttpRoute_Match_Header_MatchType)(0),         // 1: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
-	(MeshGatewayRoute_HttpRoute_Match_Query_MatchType)(0),          // 2: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
-	(*MeshGatewayRoute)(nil),                                       // 3: kuma.mesh.v1alpha1.MeshGatewayRoute
-	(*MeshGatewayRoute_Backend)(nil),                               // 4: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	(*MeshGatewayRoute_UdpRoute)(nil),                              // 5: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute
-	(*MeshGatewayRoute_TcpRoute)(nil),                              // 6: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute
-	(*MeshGatewayRoute_TlsRoute)(nil),                              // 7: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute
-	(*MeshGatewayRoute_HttpRoute)(nil),                             // 8: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute
-	(*MeshGatewayRoute_Conf)(nil),                                  // 9: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf
-	nil,                                                            // 10: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.DestinationEntry
-	(*MeshGatewayRoute_UdpRoute_Match)(nil),                        // 11: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Match
-	(*MeshGatewayRoute_UdpRoute_Rule)(nil),                         // 12: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule
-	(*MeshGatewayRoute_TcpRoute_Match)(nil),                        // 13: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Match
-	(*MeshGatewayRoute_TcpRoute_Rule)(nil),                         // 14: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule
-	(*MeshGatewayRoute_TlsRoute_Match)(nil),                        // 15: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Match
-	(*MeshGatewayRoute_TlsRoute_Rule)(nil),                         // 16: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule
-	(*MeshGatewayRoute_HttpRoute_Match)(nil),                       // 17: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
-	(*MeshGatewayRoute_HttpRoute_Filter)(nil),                      // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
-	(*MeshGatewayRoute_HttpRoute_Rule)(nil),                        // 19: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule
-	(*MeshGatewayRoute_HttpRoute_Match_Path)(nil),                  // 20: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path
-	(*MeshGatewayRoute_HttpRoute_Match_Header)(nil),                // 21: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header
-	(*MeshGatewayRoute_HttpRoute_Match_Query)(nil),                 // 22: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query
-	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader)(nil),        // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
-	(*MeshGatewayRoute_HttpRoute_Filter_Mirror)(nil),               // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
-	(*MeshGatewayRoute_HttpRoute_Filter_Redirect)(nil),             // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
-	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil), // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	(*Selector)(nil),                                               // 27: kuma.mesh.v1alpha1.Selector
-	(HttpMethod)(0),                                                // 28: kuma.mesh.v1alpha1.HttpMethod
-	(*wrapperspb.DoubleValue)(nil),                                 // 29: google.protobuf.DoubleValue
+	(MeshGatewayRoute_HttpRoute_Match_Path_MatchType)(0),                // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
+	(MeshGatewayRoute_HttpRoute_Match_Header_MatchType)(0),              // 1: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
+	(MeshGatewayRoute_HttpRoute_Match_Query_MatchType)(0),               // 2: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
+	(*MeshGatewayRoute)(nil),                                            // 3: kuma.mesh.v1alpha1.MeshGatewayRoute
+	(*MeshGatewayRoute_Backend)(nil),                                    // 4: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	(*MeshGatewayRoute_UdpRoute)(nil),                                   // 5: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute
+	(*MeshGatewayRoute_TcpRoute)(nil),                                   // 6: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute
+	(*MeshGatewayRoute_TlsRoute)(nil),                                   // 7: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute
+	(*MeshGatewayRoute_HttpRoute)(nil),                                  // 8: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute
+	(*MeshGatewayRoute_Conf)(nil),                                       // 9: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf
+	nil,                                                                 // 10: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.DestinationEntry
+	(*MeshGatewayRoute_UdpRoute_Match)(nil),                             // 11: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Match
+	(*MeshGatewayRoute_UdpRoute_Rule)(nil),                              // 12: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule
+	(*MeshGatewayRoute_TcpRoute_Match)(nil),                             // 13: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Match
+	(*MeshGatewayRoute_TcpRoute_Rule)(nil),                              // 14: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule
+	(*MeshGatewayRoute_TlsRoute_Match)(nil),                             // 15: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Match
+	(*MeshGatewayRoute_TlsRoute_Rule)(nil),                              // 16: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule
+	(*MeshGatewayRoute_HttpRoute_Match)(nil),                            // 17: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
+	(*MeshGatewayRoute_HttpRoute_Filter)(nil),                           // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
+	(*MeshGatewayRoute_HttpRoute_Rule)(nil),                             // 19: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule
+	(*MeshGatewayRoute_HttpRoute_Match_Path)(nil),                       // 20: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path
+	(*MeshGatewayRoute_HttpRoute_Match_Header)(nil),                     // 21: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header
+	(*MeshGatewayRoute_HttpRoute_Match_Query)(nil),                      // 22: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query
+	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader)(nil),             // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
+	(*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader)(nil),            // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.ResponseHeader
+	(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse)(nil),            // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.DirectResponse
+	(*MeshGatewayRoute_HttpRoute_Filter_Mirror)(nil),                    // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
+	(*MeshGatewayRoute_HttpRoute_Filter_Redirect)(nil),                  // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
+	(*MeshGatewayRoute_HttpRoute_Filter_Rewrite)(nil),                   // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
+	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil),      // 29: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution)(nil), // 30: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite.RegexSubstitution
+	(*Selector)(nil),                                                    // 31: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),                                       // 32: google.protobuf.Timestamp
+	(HttpMethod)(0),                                                     // 33: kuma.mesh.v1alpha1.HttpMethod
+	(*TrafficRoute_LoadBalancer)(nil),                                   // 34: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	(*wrapperspb.DoubleValue)(nil),                                      // 35: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_gateway_route_proto_depIdxs = []int32{
-	27, // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	31, // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
 	9,  // 1: kuma.mesh.v1alpha1.MeshGatewayRoute.conf:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Conf
-	10, // 2: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.destination:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.DestinationEntry
-	12, // 3: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule
-	14, // 4: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule
-	16, // 5: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule
-	19, // 6: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule
-	5,  // 7: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.udp:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute
-	6,  // 8: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.tcp:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute
-	7,  // 9: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.tls:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute
-	8,  // 10: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute
-	11, // 11: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Match
-	4,  // 12: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	13, // 13: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Match
-	4,  // 14: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	15, // 15: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Match
-	4,  // 16: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	20, // 17: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.path:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path
-	28, // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.method:type_name -> kuma.mesh.v1alpha1.HttpMethod
-	21, // 19: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.headers:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header
-	22, // 20: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.query_parameters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query
-	23, // 21: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.request_header:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
-	24, // 22: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.mirror:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
-	25, // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.redirect:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
-	17, // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
-	18, // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
-	4,  // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	0,  // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
-	1,  // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
-	2,  // 29: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
-	26, // 30: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	26, // 31: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	4,  // 32: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	29, // 33: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
-	34, // [34:34] is the sub-list for method output_type
-	34, // [34:34] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	32, // 2: kuma.mesh.v1alpha1.MeshGatewayRoute.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 3: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.destination:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.DestinationEntry
+	12, // 4: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule
+	14, // 5: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule
+	16, // 6: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule
+	19, // 7: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule
+	5,  // 8: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.udp:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute
+	6,  // 9: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.tcp:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute
+	7,  // 10: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.tls:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute
+	8,  // 11: kuma.mesh.v1alpha1.MeshGatewayRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute
+	11, // 12: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Match
+	4,  // 13: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	13, // 14: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Match
+	4,  // 15: kuma.mesh.v1alpha1.MeshGatewayRoute.TcpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	15, // 16: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Match
+	4,  // 17: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	20, // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.path:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path
+	33, // 19: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.method:type_name -> kuma.mesh.v1alpha1.HttpMethod
+	21, // 20: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.headers:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header
+	22, // 21: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.query_parameters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query
+	23, // 22: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.request_header:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
+	26, // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.mirror:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
+	27, // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.redirect:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
+	28, // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.rewrite:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
+	24, // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.response_header:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.ResponseHeader
+	25, // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.direct_response:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.DirectResponse
+	17, // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
+	18, // 29: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
+	4,  // 30: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	34, // 31: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.load_balancer:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	0,  // 32: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
+	1,  // 33: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
+	2,  // 34: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
+	29, // 35: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	29, // 36: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	29, // 37: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.ResponseHeader.set:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	29, // 38: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.ResponseHeader.add:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	4,  // 39: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	35, // 40: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
+	30, // 41: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite.replace_regex:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite.RegexSubstitution
+	42, // [42:42] is the sub-list for method output_type
+	42, // [42:42] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_gateway_route_proto_init() }
@@ -1961,6 +2497,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 	}
 	file_mesh_v1alpha1_selector_proto_init()
 	file_mesh_v1alpha1_http_method_proto_init()
+	file_mesh_v1alpha1_traffic_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshGatewayRoute); i {
@@ -2203,7 +2740,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Mirror); i {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2215,7 +2752,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Redirect); i {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2227,6 +2764,42 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Mirror); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Redirect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Rewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header); i {
 			case 0:
 				return &v.state
@@ -2238,6 +2811,18 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_RegexSubstitution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mesh_v1alpha1_gateway_route_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*MeshGatewayRoute_Conf_Udp)(nil),
@@ -2249,6 +2834,16 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 		(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_)(nil),
 		(*MeshGatewayRoute_HttpRoute_Filter_Mirror_)(nil),
 		(*MeshGatewayRoute_HttpRoute_Filter_Redirect_)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_ResponseHeader_)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_)(nil),
+	}
+	file_mesh_v1alpha1_gateway_route_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2256,7 +2851,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_gateway_route_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   24,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/header_modification.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HeaderModification defines modifications of HTTP headers that are applied
+// regardless of the TrafficRoute.
+type HeaderModification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the header modifications.
+	Conf *HeaderModification_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *HeaderModification) Reset() {
+	*x = HeaderModification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderModification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderModification) ProtoMessage() {}
+
+func (x *HeaderModification) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderModification.ProtoReflect.Descriptor instead.
+func (*HeaderModification) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HeaderModification) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *HeaderModification) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *HeaderModification) GetConf() *HeaderModification_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *HeaderModification) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Conf defines the modifications of the HTTP headers.
+type HeaderModification_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Modifications applied by the source dataplanes to the outbound
+	// traffic.
+	Outbound *HeaderModification_Conf_Headers `protobuf:"bytes,1,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	// Modifications applied by the destination dataplanes to the inbound
+	// traffic. Sources are not taken into account, because the source of
+	// the traffic is not known when the inbound is configured.
+	Inbound *HeaderModification_Conf_Headers `protobuf:"bytes,2,opt,name=inbound,proto3" json:"inbound,omitempty"`
+}
+
+func (x *HeaderModification_Conf) Reset() {
+	*x = HeaderModification_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderModification_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderModification_Conf) ProtoMessage() {}
+
+func (x *HeaderModification_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderModification_Conf.ProtoReflect.Descriptor instead.
+func (*HeaderModification_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modification_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *HeaderModification_Conf) GetOutbound() *HeaderModification_Conf_Headers {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+func (x *HeaderModification_Conf) GetInbound() *HeaderModification_Conf_Headers {
+	if x != nil {
+		return x.Inbound
+	}
+	return nil
+}
+
+// Headers defines modifications of the HTTP request and response
+// headers. Header values can contain the following placeholders:
+// %KUMA_MESH%, %KUMA_SOURCE_SERVICE% (outbound only) and
+// %KUMA_DESTINATION_SERVICE%.
+type HeaderModification_Conf_Headers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request headers modifications.
+	RequestHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,1,opt,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty"`
+	// Response headers modifications.
+	ResponseHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,2,opt,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty"`
+}
+
+func (x *HeaderModification_Conf_Headers) Reset() {
+	*x = HeaderModification_Conf_Headers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderModification_Conf_Headers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderModification_Conf_Headers) ProtoMessage() {}
+
+func (x *HeaderModification_Conf_Headers) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modification_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderModification_Conf_Headers.ProtoReflect.Descriptor instead.
+func (*HeaderModification_Conf_Headers) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modification_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *HeaderModification_Conf_Headers) GetRequestHeaders() *TrafficRoute_Http_Modify_Headers {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *HeaderModification_Conf_Headers) GetResponseHeaders() *TrafficRoute_Http_Modify_Headers {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_header_modification_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_header_modification_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8a, 0x06, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45,
+	0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x1a, 0xf2, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4f, 0x0a, 0x08, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x07, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x5f, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x77, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1c, 0x0a, 0x1a, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x12,
+	0x12, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x17, 0x3a,
+	0x15, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x5b,
+	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
+	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x2d, 0x50, 0x01,
+	0xa2, 0x01, 0x12, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0x01, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2d, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_header_modification_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_header_modification_proto_rawDescData = file_mesh_v1alpha1_header_modification_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_header_modification_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_header_modification_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_header_modification_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_header_modification_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_header_modification_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_header_modification_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_header_modification_proto_goTypes = []interface{}{
+	(*HeaderModification)(nil),               // 0: kuma.mesh.v1alpha1.HeaderModification
+	(*HeaderModification_Conf)(nil),          // 1: kuma.mesh.v1alpha1.HeaderModification.Conf
+	(*HeaderModification_Conf_Headers)(nil),  // 2: kuma.mesh.v1alpha1.HeaderModification.Conf.Headers
+	(*Selector)(nil),                         // 3: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
+	(*TrafficRoute_Http_Modify_Headers)(nil), // 5: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+}
+var file_mesh_v1alpha1_header_modification_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.HeaderModification.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	3, // 1: kuma.mesh.v1alpha1.HeaderModification.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 2: kuma.mesh.v1alpha1.HeaderModification.conf:type_name -> kuma.mesh.v1alpha1.HeaderModification.Conf
+	4, // 3: kuma.mesh.v1alpha1.HeaderModification.expires_at:type_name -> google.protobuf.Timestamp
+	2, // 4: kuma.mesh.v1alpha1.HeaderModification.Conf.outbound:type_name -> kuma.mesh.v1alpha1.HeaderModification.Conf.Headers
+	2, // 5: kuma.mesh.v1alpha1.HeaderModification.Conf.inbound:type_name -> kuma.mesh.v1alpha1.HeaderModification.Conf.Headers
+	5, // 6: kuma.mesh.v1alpha1.HeaderModification.Conf.Headers.request_headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	5, // 7: kuma.mesh.v1alpha1.HeaderModification.Conf.Headers.response_headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_header_modification_proto_init() }
+func file_mesh_v1alpha1_header_modification_proto_init() {
+	if File_mesh_v1alpha1_header_modification_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_traffic_route_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_header_modification_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderModification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modification_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderModification_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modification_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderModification_Conf_Headers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_header_modification_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_header_modification_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_header_modification_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_header_modification_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_header_modification_proto = out.File
+	file_mesh_v1alpha1_header_modification_proto_rawDesc = nil
+	file_mesh_v1alpha1_header_modification_proto_goTypes = nil
+	file_mesh_v1alpha1_header_modification_proto_depIdxs = nil
+}
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -25,6 +26,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// HttpRetryOn defines the conditions of the HTTP retries. It's not
+// nested in Http, because its values would collide with the fields of
+// Http.
+type Retry_Conf_HttpRetryOn int32
+
+const (
+	Retry_Conf_all_5xx                Retry_Conf_HttpRetryOn = 0
+	Retry_Conf_gateway_error          Retry_Conf_HttpRetryOn = 1
+	Retry_Conf_reset                  Retry_Conf_HttpRetryOn = 2
+	Retry_Conf_connect_failure        Retry_Conf_HttpRetryOn = 3
+	Retry_Conf_retriable_4xx          Retry_Conf_HttpRetryOn = 4
+	Retry_Conf_refused_stream         Retry_Conf_HttpRetryOn = 5
+	Retry_Conf_retriable_status_codes Retry_Conf_HttpRetryOn = 6
+	Retry_Conf_retriable_headers      Retry_Conf_HttpRetryOn = 7
+)
+
+// Enum value maps for Retry_Conf_HttpRetryOn.
+var (
+	Retry_Conf_HttpRetryOn_name = map[int32]string{
+		0: "all_5xx",
+		1: "gateway_error",
+		2: "reset",
+		3: "connect_failure",
+		4: "retriable_4xx",
+		5: "refused_stream",
+		6: "retriable_status_codes",
+		7: "retriable_headers",
+	}
+	Retry_Conf_HttpRetryOn_value = map[string]int32{
+		"all_5xx":                0,
+		"gateway_error":          1,
+		"reset":                  2,
+		"connect_failure":        3,
+		"retriable_4xx":          4,
+		"refused_stream":         5,
+		"retriable_status_codes": 6,
+		"retriable_headers":      7,
+	}
+)
+
+func (x Retry_Conf_HttpRetryOn) Enum() *Retry_Conf_HttpRetryOn {
+	p := new(Retry_Conf_HttpRetryOn)
+	*p = x
+	return p
+}
+
+func (x Retry_Conf_HttpRetryOn) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Retry_Conf_HttpRetryOn) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_retry_proto_enumTypes[0].Descriptor()
+}
+
+func (Retry_Conf_HttpRetryOn) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_retry_proto_enumTypes[0]
+}
+
+func (x Retry_Conf_HttpRetryOn) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Retry_Conf_HttpRetryOn.Descriptor instead.
+func (Retry_Conf_HttpRetryOn) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+type Retry_Conf_RateLimitedBackOff_ResetHeader_Format int32
+
+const (
+	Retry_Conf_RateLimitedBackOff_ResetHeader_seconds        Retry_Conf_RateLimitedBackOff_ResetHeader_Format = 0
+	Retry_Conf_RateLimitedBackOff_ResetHeader_unix_timestamp Retry_Conf_RateLimitedBackOff_ResetHeader_Format = 1
+)
+
+// Enum value maps for Retry_Conf_RateLimitedBackOff_ResetHeader_Format.
+var (
+	Retry_Conf_RateLimitedBackOff_ResetHeader_Format_name = map[int32]string{
+		0: "seconds",
+		1: "unix_timestamp",
+	}
+	Retry_Conf_RateLimitedBackOff_ResetHeader_Format_value = map[string]int32{
+		"seconds":        0,
+		"unix_timestamp": 1,
+	}
+)
+
+func (x Retry_Conf_RateLimitedBackOff_ResetHeader_Format) Enum() *Retry_Conf_RateLimitedBackOff_ResetHeader_Format {
+	p := new(Retry_Conf_RateLimitedBackOff_ResetHeader_Format)
+	*p = x
+	return p
+}
+
+func (x Retry_Conf_RateLimitedBackOff_ResetHeader_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Retry_Conf_RateLimitedBackOff_ResetHeader_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_retry_proto_enumTypes[1].Descriptor()
+}
+
+func (Retry_Conf_RateLimitedBackOff_ResetHeader_Format) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_retry_proto_enumTypes[1]
+}
+
+func (x Retry_Conf_RateLimitedBackOff_ResetHeader_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Retry_Conf_RateLimitedBackOff_ResetHeader_Format.Descriptor instead.
+func (Retry_Conf_RateLimitedBackOff_ResetHeader_Format) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 1, 0, 0}
+}
+
 type Retry_Conf_Grpc_RetryOn int32
 
 const (
@@ -64,11 +178,11 @@ func (x Retry_Conf_Grpc_RetryOn) String() string {
 }
 
 func (Retry_Conf_Grpc_RetryOn) Descriptor() protoreflect.EnumDescriptor {
-	return file_mesh_v1alpha1_retry_proto_enumTypes[0].Descriptor()
+	return file_mesh_v1alpha1_retry_proto_enumTypes[2].Descriptor()
 }
 
 func (Retry_Conf_Grpc_RetryOn) Type() protoreflect.EnumType {
-	return &file_mesh_v1alpha1_retry_proto_enumTypes[0]
+	return &file_mesh_v1alpha1_retry_proto_enumTypes[2]
 }
 
 func (x Retry_Conf_Grpc_RetryOn) Number() protoreflect.EnumNumber {
@@ -77,7 +191,7 @@ func (x Retry_Conf_Grpc_RetryOn) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Retry_Conf_Grpc_RetryOn.Descriptor instead.
 func (Retry_Conf_Grpc_RetryOn) EnumDescriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 3, 0}
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 5, 0}
 }
 
 type Retry struct {
@@ -92,6 +206,9 @@ type Retry struct {
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	//  +required
 	Conf *Retry_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *Retry) Reset() {
@@ -147,6 +264,13 @@ func (x *Retry) GetConf() *Retry_Conf {
 	return nil
 }
 
+func (x *Retry) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type Retry_Conf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -155,6 +279,10 @@ type Retry_Conf struct {
 	Http *Retry_Conf_Http `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Tcp  *Retry_Conf_Tcp  `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
 	Grpc *Retry_Conf_Grpc `protobuf:"bytes,3,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// Budget of the HTTP and gRPC retries. When set, the max retries
+	// threshold of the circuit breaker is ignored.
+	//  +optional
+	Budget *Retry_Conf_Budget `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
 func (x *Retry_Conf) Reset() {
@@ -210,6 +338,13 @@ func (x *Retry_Conf) GetGrpc() *Retry_Conf_Grpc {
 	return nil
 }
 
+func (x *Retry_Conf) GetBudget() *Retry_Conf_Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
 type Retry_Conf_BackOff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -267,6 +402,128 @@ func (x *Retry_Conf_BackOff) GetMaxInterval() *durationpb.Duration {
 	return nil
 }
 
+// RateLimitedBackOff defines the back off of the retries of the rate
+// limited requests. The back off interval is taken from the headers of
+// the upstream response.
+type Retry_Conf_RateLimitedBackOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response headers that hold the back off interval. The first header
+	// that is present in the response is used.
+	ResetHeaders []*Retry_Conf_RateLimitedBackOff_ResetHeader `protobuf:"bytes,1,rep,name=reset_headers,json=resetHeaders,proto3" json:"reset_headers,omitempty"`
+	// Maximum back off interval. Default: 300s
+	MaxInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+}
+
+func (x *Retry_Conf_RateLimitedBackOff) Reset() {
+	*x = Retry_Conf_RateLimitedBackOff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retry_Conf_RateLimitedBackOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retry_Conf_RateLimitedBackOff) ProtoMessage() {}
+
+func (x *Retry_Conf_RateLimitedBackOff) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retry_Conf_RateLimitedBackOff.ProtoReflect.Descriptor instead.
+func (*Retry_Conf_RateLimitedBackOff) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *Retry_Conf_RateLimitedBackOff) GetResetHeaders() []*Retry_Conf_RateLimitedBackOff_ResetHeader {
+	if x != nil {
+		return x.ResetHeaders
+	}
+	return nil
+}
+
+func (x *Retry_Conf_RateLimitedBackOff) GetMaxInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return nil
+}
+
+// Budget limits the number of concurrent retries to the destination
+// relative to the number of its active requests.
+type Retry_Conf_Budget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of the active requests that can be retried concurrently
+	// (in the range 0.0 - 100.0). Default: 20.0
+	BudgetPercent *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=budget_percent,json=budgetPercent,proto3" json:"budget_percent,omitempty"`
+	// Number of concurrent retries that are allowed regardless of the
+	// number of active requests. Default: 3
+	MinRetryConcurrency *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=min_retry_concurrency,json=minRetryConcurrency,proto3" json:"min_retry_concurrency,omitempty"`
+}
+
+func (x *Retry_Conf_Budget) Reset() {
+	*x = Retry_Conf_Budget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retry_Conf_Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retry_Conf_Budget) ProtoMessage() {}
+
+func (x *Retry_Conf_Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retry_Conf_Budget.ProtoReflect.Descriptor instead.
+func (*Retry_Conf_Budget) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *Retry_Conf_Budget) GetBudgetPercent() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.BudgetPercent
+	}
+	return nil
+}
+
+func (x *Retry_Conf_Budget) GetMinRetryConcurrency() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MinRetryConcurrency
+	}
+	return nil
+}
+
 type Retry_Conf_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -282,12 +539,25 @@ type Retry_Conf_Http struct {
 	RetriableStatusCodes []uint32 `protobuf:"varint,5,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
 	//  +optional
 	RetriableMethods []HttpMethod `protobuf:"varint,6,rep,packed,name=retriable_methods,json=retriableMethods,proto3,enum=kuma.mesh.v1alpha1.HttpMethod" json:"retriable_methods,omitempty"`
+	// Conditions that trigger the retry. If not specified, the retry is
+	// triggered on gateway_error, connect_failure and refused_stream.
+	//  +optional
+	RetryOn []Retry_Conf_HttpRetryOn `protobuf:"varint,7,rep,packed,name=retry_on,json=retryOn,proto3,enum=kuma.mesh.v1alpha1.Retry_Conf_HttpRetryOn" json:"retry_on,omitempty"`
+	// Names of the response headers that trigger the retry when present.
+	//  +optional
+	RetriableHeaders []string `protobuf:"bytes,8,rep,name=retriable_headers,json=retriableHeaders,proto3" json:"retriable_headers,omitempty"`
+	//  +optional
+	RateLimitedBackOff *Retry_Conf_RateLimitedBackOff `protobuf:"bytes,9,opt,name=rate_limited_back_off,json=rateLimitedBackOff,proto3" json:"rate_limited_back_off,omitempty"`
+	// If true, a new request is sent when the per try timeout elapses
+	// without cancelling the previous one. The first response is used.
+	//  +optional
+	HedgeOnPerTryTimeout bool `protobuf:"varint,10,opt,name=hedge_on_per_try_timeout,json=hedgeOnPerTryTimeout,proto3" json:"hedge_on_per_try_timeout,omitempty"`
 }
 
 func (x *Retry_Conf_Http) Reset() {
 	*x = Retry_Conf_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[3]
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -300,7 +570,7 @@ func (x *Retry_Conf_Http) String() string {
 func (*Retry_Conf_Http) ProtoMessage() {}
 
 func (x *Retry_Conf_Http) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[3]
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +583,7 @@ func (x *Retry_Conf_Http) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Retry_Conf_Http.ProtoReflect.Descriptor instead.
 func (*Retry_Conf_Http) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 1}
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 3}
 }
 
 func (x *Retry_Conf_Http) GetNumRetries() *wrapperspb.UInt32Value {
@@ -351,6 +621,34 @@ func (x *Retry_Conf_Http) GetRetriableMethods() []HttpMethod {
 	return nil
 }
 
+func (x *Retry_Conf_Http) GetRetryOn() []Retry_Conf_HttpRetryOn {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+func (x *Retry_Conf_Http) GetRetriableHeaders() []string {
+	if x != nil {
+		return x.RetriableHeaders
+	}
+	return nil
+}
+
+func (x *Retry_Conf_Http) GetRateLimitedBackOff() *Retry_Conf_RateLimitedBackOff {
+	if x != nil {
+		return x.RateLimitedBackOff
+	}
+	return nil
+}
+
+func (x *Retry_Conf_Http) GetHedgeOnPerTryTimeout() bool {
+	if x != nil {
+		return x.HedgeOnPerTryTimeout
+	}
+	return false
+}
+
 type Retry_Conf_Tcp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -363,7 +661,7 @@ type Retry_Conf_Tcp struct {
 func (x *Retry_Conf_Tcp) Reset() {
 	*x = Retry_Conf_Tcp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[4]
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -376,7 +674,7 @@ func (x *Retry_Conf_Tcp) String() string {
 func (*Retry_Conf_Tcp) ProtoMessage() {}
 
 func (x *Retry_Conf_Tcp) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[4]
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +687,7 @@ func (x *Retry_Conf_Tcp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Retry_Conf_Tcp.ProtoReflect.Descriptor instead.
 func (*Retry_Conf_Tcp) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 2}
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 4}
 }
 
 func (x *Retry_Conf_Tcp) GetMaxConnectAttempts() uint32 {
@@ -412,12 +710,18 @@ type Retry_Conf_Grpc struct {
 	PerTryTimeout *durationpb.Duration `protobuf:"bytes,3,opt,name=per_try_timeout,json=perTryTimeout,proto3" json:"per_try_timeout,omitempty"`
 	//  +optional
 	BackOff *Retry_Conf_BackOff `protobuf:"bytes,4,opt,name=back_off,json=backOff,proto3" json:"back_off,omitempty"`
+	//  +optional
+	RateLimitedBackOff *Retry_Conf_RateLimitedBackOff `protobuf:"bytes,5,opt,name=rate_limited_back_off,json=rateLimitedBackOff,proto3" json:"rate_limited_back_off,omitempty"`
+	// If true, a new request is sent when the per try timeout elapses
+	// without cancelling the previous one. The first response is used.
+	//  +optional
+	HedgeOnPerTryTimeout bool `protobuf:"varint,6,opt,name=hedge_on_per_try_timeout,json=hedgeOnPerTryTimeout,proto3" json:"hedge_on_per_try_timeout,omitempty"`
 }
 
 func (x *Retry_Conf_Grpc) Reset() {
 	*x = Retry_Conf_Grpc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[5]
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -430,7 +734,7 @@ func (x *Retry_Conf_Grpc) String() string {
 func (*Retry_Conf_Grpc) ProtoMessage() {}
 
 func (x *Retry_Conf_Grpc) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[5]
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +747,7 @@ func (x *Retry_Conf_Grpc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Retry_Conf_Grpc.ProtoReflect.Descriptor instead.
 func (*Retry_Conf_Grpc) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 3}
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 5}
 }
 
 func (x *Retry_Conf_Grpc) GetRetryOn() []Retry_Conf_Grpc_RetryOn {
@@ -474,6 +778,79 @@ func (x *Retry_Conf_Grpc) GetBackOff() *Retry_Conf_BackOff {
 	return nil
 }
 
+func (x *Retry_Conf_Grpc) GetRateLimitedBackOff() *Retry_Conf_RateLimitedBackOff {
+	if x != nil {
+		return x.RateLimitedBackOff
+	}
+	return nil
+}
+
+func (x *Retry_Conf_Grpc) GetHedgeOnPerTryTimeout() bool {
+	if x != nil {
+		return x.HedgeOnPerTryTimeout
+	}
+	return false
+}
+
+// ResetHeader defines the response header that holds the back off
+// interval.
+type Retry_Conf_RateLimitedBackOff_ResetHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the header, e.g. "Retry-After".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Format of the header value. Default: seconds
+	Format Retry_Conf_RateLimitedBackOff_ResetHeader_Format `protobuf:"varint,2,opt,name=format,proto3,enum=kuma.mesh.v1alpha1.Retry_Conf_RateLimitedBackOff_ResetHeader_Format" json:"format,omitempty"`
+}
+
+func (x *Retry_Conf_RateLimitedBackOff_ResetHeader) Reset() {
+	*x = Retry_Conf_RateLimitedBackOff_ResetHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_retry_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retry_Conf_RateLimitedBackOff_ResetHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retry_Conf_RateLimitedBackOff_ResetHeader) ProtoMessage() {}
+
+func (x *Retry_Conf_RateLimitedBackOff_ResetHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_retry_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retry_Conf_RateLimitedBackOff_ResetHeader.ProtoReflect.Descriptor instead.
+func (*Retry_Conf_RateLimitedBackOff_ResetHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_retry_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+func (x *Retry_Conf_RateLimitedBackOff_ResetHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Retry_Conf_RateLimitedBackOff_ResetHeader) GetFormat() Retry_Conf_RateLimitedBackOff_ResetHeader_Format {
+	if x != nil {
+		return x.Format
+	}
+	return Retry_Conf_RateLimitedBackOff_ResetHeader_seconds
+}
+
 var File_mesh_v1alpha1_retry_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_retry_proto_rawDesc = []byte{
@@ -489,102 +866,183 @@ var file_mesh_v1alpha1_retry_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x0b, 0x0a, 0x05, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01,
-	0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f, 0x6e,
-	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63,
-	0x6f, 0x6e, 0x66, 0x1a, 0xc6, 0x08, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x37, 0x0a, 0x04,
-	0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d,
-	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x34, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x37, 0x0a, 0x04, 0x67,
-	0x72, 0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x04,
-	0x67, 0x72, 0x70, 0x63, 0x1a, 0x8d, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66,
-	0x12, 0x44, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x14, 0x0a, 0x05, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f,
+	0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a,
+	0xf3, 0x11, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x37, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74,
+	0x70, 0x12, 0x34, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54,
+	0x63, 0x70, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x37, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63,
+	0x12, 0x3d, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x1a,
+	0x8d, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x12, 0x44, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x1a, 0xce, 0x02, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x3d, 0x0a,
-	0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f,
-	0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x41, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x4f,
-	0x66, 0x66, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x14, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x73, 0x1a, 0x37, 0x0a, 0x03, 0x54, 0x63, 0x70, 0x12, 0x30, 0x0a, 0x14,
-	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x1a, 0xfb,
-	0x02, 0x0a, 0x04, 0x47, 0x72, 0x70, 0x63, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79,
-	0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12,
-	0x3d, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a,
+	0xef, 0x02, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42,
+	0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x12, 0x68, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0xb0,
+	0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x29, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x0b, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x10,
+	0x01, 0x1a, 0x9f, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x41,
-	0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x41, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x07, 0x62, 0x61, 0x63,
-	0x6b, 0x4f, 0x66, 0x66, 0x22, 0x66, 0x0a, 0x07, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12,
-	0x0d, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x00, 0x12, 0x15,
-	0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x75,
-	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x04, 0x3a, 0x60, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x07, 0x12, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x09, 0x3a, 0x07, 0x0a, 0x05,
-	0x72, 0x65, 0x74, 0x72, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0b, 0x3a, 0x09, 0x12, 0x07, 0x72,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x40,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x12, 0x50, 0x01,
-	0xa2, 0x01, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0xf2, 0x01, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0d, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x50, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x1a, 0xe0, 0x04, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x3d, 0x0a, 0x0b,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x70,
+	0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41,
+	0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x66,
+	0x66, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x14, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x4f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x64, 0x0a, 0x15, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66,
+	0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x12, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x12, 0x36,
+	0x0a, 0x18, 0x68, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x68, 0x65, 0x64, 0x67, 0x65, 0x4f, 0x6e, 0x50, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x37, 0x0a, 0x03, 0x54, 0x63, 0x70, 0x12, 0x30, 0x0a,
+	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x1a,
+	0x99, 0x04, 0x0a, 0x04, 0x47, 0x72, 0x70, 0x63, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e,
+	0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x4f, 0x66, 0x66, 0x12, 0x64, 0x0a, 0x15, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x52, 0x12, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x12, 0x36, 0x0a, 0x18, 0x68,
+	0x65, 0x64, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68,
+	0x65, 0x64, 0x67, 0x65, 0x4f, 0x6e, 0x50, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0x66, 0x0a, 0x07, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x0d,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65,
+	0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x04, 0x22, 0xa7, 0x01, 0x0a, 0x0b,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x61,
+	0x6c, 0x6c, 0x5f, 0x35, 0x78, 0x78, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x34, 0x78, 0x78, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x10, 0x06, 0x12, 0x15,
+	0x0a, 0x11, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x10, 0x07, 0x3a, 0x60, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x0a, 0x0d, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x07, 0x12, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22,
+	0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x09, 0x3a, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x0b, 0x3a, 0x09, 0x12, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x40, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x12, 0x50, 0x01, 0xa2, 0x01, 0x05, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0xf2, 0x01, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -599,43 +1057,60 @@ func file_mesh_v1alpha1_retry_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_retry_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_retry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mesh_v1alpha1_retry_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mesh_v1alpha1_retry_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mesh_v1alpha1_retry_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_mesh_v1alpha1_retry_proto_goTypes = []interface{}{
-	(Retry_Conf_Grpc_RetryOn)(0),   // 0: kuma.mesh.v1alpha1.Retry.Conf.Grpc.RetryOn
-	(*Retry)(nil),                  // 1: kuma.mesh.v1alpha1.Retry
-	(*Retry_Conf)(nil),             // 2: kuma.mesh.v1alpha1.Retry.Conf
-	(*Retry_Conf_BackOff)(nil),     // 3: kuma.mesh.v1alpha1.Retry.Conf.BackOff
-	(*Retry_Conf_Http)(nil),        // 4: kuma.mesh.v1alpha1.Retry.Conf.Http
-	(*Retry_Conf_Tcp)(nil),         // 5: kuma.mesh.v1alpha1.Retry.Conf.Tcp
-	(*Retry_Conf_Grpc)(nil),        // 6: kuma.mesh.v1alpha1.Retry.Conf.Grpc
-	(*Selector)(nil),               // 7: kuma.mesh.v1alpha1.Selector
-	(*durationpb.Duration)(nil),    // 8: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil), // 9: google.protobuf.UInt32Value
-	(HttpMethod)(0),                // 10: kuma.mesh.v1alpha1.HttpMethod
+	(Retry_Conf_HttpRetryOn)(0),                           // 0: kuma.mesh.v1alpha1.Retry.Conf.HttpRetryOn
+	(Retry_Conf_RateLimitedBackOff_ResetHeader_Format)(0), // 1: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.ResetHeader.Format
+	(Retry_Conf_Grpc_RetryOn)(0),                          // 2: kuma.mesh.v1alpha1.Retry.Conf.Grpc.RetryOn
+	(*Retry)(nil),                                         // 3: kuma.mesh.v1alpha1.Retry
+	(*Retry_Conf)(nil),                                    // 4: kuma.mesh.v1alpha1.Retry.Conf
+	(*Retry_Conf_BackOff)(nil),                            // 5: kuma.mesh.v1alpha1.Retry.Conf.BackOff
+	(*Retry_Conf_RateLimitedBackOff)(nil),                 // 6: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff
+	(*Retry_Conf_Budget)(nil),                             // 7: kuma.mesh.v1alpha1.Retry.Conf.Budget
+	(*Retry_Conf_Http)(nil),                               // 8: kuma.mesh.v1alpha1.Retry.Conf.Http
+	(*Retry_Conf_Tcp)(nil),                                // 9: kuma.mesh.v1alpha1.Retry.Conf.Tcp
+	(*Retry_Conf_Grpc)(nil),                               // 10: kuma.mesh.v1alpha1.Retry.Conf.Grpc
+	(*Retry_Conf_RateLimitedBackOff_ResetHeader)(nil),     // 11: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.ResetHeader
+	(*Selector)(nil),                                      // 12: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),                         // 13: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                           // 14: google.protobuf.Duration
+	(*wrapperspb.DoubleValue)(nil),                        // 15: google.protobuf.DoubleValue
+	(*wrapperspb.UInt32Value)(nil),                        // 16: google.protobuf.UInt32Value
+	(HttpMethod)(0),                                       // 17: kuma.mesh.v1alpha1.HttpMethod
 }
 var file_mesh_v1alpha1_retry_proto_depIdxs = []int32{
-	7,  // 0: kuma.mesh.v1alpha1.Retry.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	7,  // 1: kuma.mesh.v1alpha1.Retry.destinations:type_name -> kuma.mesh.v1alpha1.Selector
-	2,  // 2: kuma.mesh.v1alpha1.Retry.conf:type_name -> kuma.mesh.v1alpha1.Retry.Conf
-	4,  // 3: kuma.mesh.v1alpha1.Retry.Conf.http:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Http
-	5,  // 4: kuma.mesh.v1alpha1.Retry.Conf.tcp:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Tcp
-	6,  // 5: kuma.mesh.v1alpha1.Retry.Conf.grpc:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Grpc
-	8,  // 6: kuma.mesh.v1alpha1.Retry.Conf.BackOff.base_interval:type_name -> google.protobuf.Duration
-	8,  // 7: kuma.mesh.v1alpha1.Retry.Conf.BackOff.max_interval:type_name -> google.protobuf.Duration
-	9,  // 8: kuma.mesh.v1alpha1.Retry.Conf.Http.num_retries:type_name -> google.protobuf.UInt32Value
-	8,  // 9: kuma.mesh.v1alpha1.Retry.Conf.Http.per_try_timeout:type_name -> google.protobuf.Duration
-	3,  // 10: kuma.mesh.v1alpha1.Retry.Conf.Http.back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.BackOff
-	10, // 11: kuma.mesh.v1alpha1.Retry.Conf.Http.retriable_methods:type_name -> kuma.mesh.v1alpha1.HttpMethod
-	0,  // 12: kuma.mesh.v1alpha1.Retry.Conf.Grpc.retry_on:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Grpc.RetryOn
-	9,  // 13: kuma.mesh.v1alpha1.Retry.Conf.Grpc.num_retries:type_name -> google.protobuf.UInt32Value
-	8,  // 14: kuma.mesh.v1alpha1.Retry.Conf.Grpc.per_try_timeout:type_name -> google.protobuf.Duration
-	3,  // 15: kuma.mesh.v1alpha1.Retry.Conf.Grpc.back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.BackOff
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	12, // 0: kuma.mesh.v1alpha1.Retry.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	12, // 1: kuma.mesh.v1alpha1.Retry.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	4,  // 2: kuma.mesh.v1alpha1.Retry.conf:type_name -> kuma.mesh.v1alpha1.Retry.Conf
+	13, // 3: kuma.mesh.v1alpha1.Retry.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: kuma.mesh.v1alpha1.Retry.Conf.http:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Http
+	9,  // 5: kuma.mesh.v1alpha1.Retry.Conf.tcp:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Tcp
+	10, // 6: kuma.mesh.v1alpha1.Retry.Conf.grpc:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Grpc
+	7,  // 7: kuma.mesh.v1alpha1.Retry.Conf.budget:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Budget
+	14, // 8: kuma.mesh.v1alpha1.Retry.Conf.BackOff.base_interval:type_name -> google.protobuf.Duration
+	14, // 9: kuma.mesh.v1alpha1.Retry.Conf.BackOff.max_interval:type_name -> google.protobuf.Duration
+	11, // 10: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.reset_headers:type_name -> kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.ResetHeader
+	14, // 11: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.max_interval:type_name -> google.protobuf.Duration
+	15, // 12: kuma.mesh.v1alpha1.Retry.Conf.Budget.budget_percent:type_name -> google.protobuf.DoubleValue
+	16, // 13: kuma.mesh.v1alpha1.Retry.Conf.Budget.min_retry_concurrency:type_name -> google.protobuf.UInt32Value
+	16, // 14: kuma.mesh.v1alpha1.Retry.Conf.Http.num_retries:type_name -> google.protobuf.UInt32Value
+	14, // 15: kuma.mesh.v1alpha1.Retry.Conf.Http.per_try_timeout:type_name -> google.protobuf.Duration
+	5,  // 16: kuma.mesh.v1alpha1.Retry.Conf.Http.back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.BackOff
+	17, // 17: kuma.mesh.v1alpha1.Retry.Conf.Http.retriable_methods:type_name -> kuma.mesh.v1alpha1.HttpMethod
+	0,  // 18: kuma.mesh.v1alpha1.Retry.Conf.Http.retry_on:type_name -> kuma.mesh.v1alpha1.Retry.Conf.HttpRetryOn
+	6,  // 19: kuma.mesh.v1alpha1.Retry.Conf.Http.rate_limited_back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff
+	2,  // 20: kuma.mesh.v1alpha1.Retry.Conf.Grpc.retry_on:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Grpc.RetryOn
+	16, // 21: kuma.mesh.v1alpha1.Retry.Conf.Grpc.num_retries:type_name -> google.protobuf.UInt32Value
+	14, // 22: kuma.mesh.v1alpha1.Retry.Conf.Grpc.per_try_timeout:type_name -> google.protobuf.Duration
+	5,  // 23: kuma.mesh.v1alpha1.Retry.Conf.Grpc.back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.BackOff
+	6,  // 24: kuma.mesh.v1alpha1.Retry.Conf.Grpc.rate_limited_back_off:type_name -> kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff
+	1,  // 25: kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.ResetHeader.format:type_name -> kuma.mesh.v1alpha1.Retry.Conf.RateLimitedBackOff.ResetHeader.Format
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_retry_proto_init() }
@@ -683,7 +1158,7 @@ func file_mesh_v1alpha1_retry_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_retry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Retry_Conf_Http); i {
+			switch v := v.(*Retry_Conf_RateLimitedBackOff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -695,7 +1170,7 @@ func file_mesh_v1alpha1_retry_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_retry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Retry_Conf_Tcp); i {
+			switch v := v.(*Retry_Conf_Budget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -707,6 +1182,30 @@ func file_mesh_v1alpha1_retry_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_retry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retry_Conf_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_retry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retry_Conf_Tcp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_retry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Retry_Conf_Grpc); i {
 			case 0:
 				return &v.state
@@ -718,14 +1217,26 @@ func file_mesh_v1alpha1_retry_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_retry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retry_Conf_RateLimitedBackOff_ResetHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_retry_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
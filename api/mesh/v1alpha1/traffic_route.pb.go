@@ -12,6 +12,8 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -41,6 +43,9 @@ type TrafficRoute struct {
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// Configuration for the route.
 	Conf *TrafficRoute_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Time after which the policy is no longer applied and is deleted by the
+	// control plane. Empty means the policy never expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *TrafficRoute) Reset() {
@@ -96,6 +101,13 @@ func (x *TrafficRoute) GetConf() *TrafficRoute_Conf {
 	return nil
 }
 
+func (x *TrafficRoute) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // Split defines a destination with a weight assigned to it.
 type TrafficRoute_Split struct {
 	state         protoimpl.MessageState
@@ -174,6 +186,9 @@ type TrafficRoute_LoadBalancer struct {
 	//	*TrafficRoute_LoadBalancer_Random_
 	//	*TrafficRoute_LoadBalancer_Maglev_
 	LbType isTrafficRoute_LoadBalancer_LbType `protobuf_oneof:"lb_type"`
+	// Hash policies of the HTTP requests. The hashes of all the policies are
+	// combined. Requests without any hash are load balanced randomly.
+	HashPolicies []*TrafficRoute_LoadBalancer_HashPolicy `protobuf:"bytes,6,rep,name=hash_policies,json=hashPolicies,proto3" json:"hash_policies,omitempty"`
 }
 
 func (x *TrafficRoute_LoadBalancer) Reset() {
@@ -250,6 +265,13 @@ func (x *TrafficRoute_LoadBalancer) GetMaglev() *TrafficRoute_LoadBalancer_Magle
 	return nil
 }
 
+func (x *TrafficRoute_LoadBalancer) GetHashPolicies() []*TrafficRoute_LoadBalancer_HashPolicy {
+	if x != nil {
+		return x.HashPolicies
+	}
+	return nil
+}
+
 type isTrafficRoute_LoadBalancer_LbType interface {
 	isTrafficRoute_LoadBalancer_LbType()
 }
@@ -302,6 +324,9 @@ type TrafficRoute_Conf struct {
 	// order defined in the list. If the request does not match any criteria
 	// then "split" or "destination" outside of "http" section is executed.
 	Http []*TrafficRoute_Http `protobuf:"bytes,4,rep,name=http,proto3" json:"http,omitempty"`
+	// Progressive shift of the traffic to the canary destination of the
+	// "split". When used, "split" has to have exactly two destinations.
+	Canary *TrafficRoute_Canary `protobuf:"bytes,5,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
 func (x *TrafficRoute_Conf) Reset() {
@@ -364,6 +389,96 @@ func (x *TrafficRoute_Conf) GetHttp() []*TrafficRoute_Http {
 	return nil
 }
 
+func (x *TrafficRoute_Conf) GetCanary() *TrafficRoute_Canary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+// Canary defines a progressive delivery of the traffic to the canary
+// destination. The control plane steps the weights of the "split" on a
+// schedule and rolls back when the canary breaches the analysis thresholds.
+// The state of the delivery is reported in the TrafficRouteInsight of the
+// same name.
+type TrafficRoute_Canary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Destination of the "split" that receives the canary traffic.
+	Destination map[string]string `protobuf:"bytes,1,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Weights of the canary destination (in the range 0 - 100, inclusive)
+	// applied one by one. The other destination of the "split" receives the
+	// rest of the traffic.
+	Steps []uint32 `protobuf:"varint,2,rep,packed,name=steps,proto3" json:"steps,omitempty"`
+	// Interval between the steps.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Analysis of the canary destination run before each step. When not
+	// defined, steps are applied unconditionally.
+	Analysis *TrafficRoute_Canary_Analysis `protobuf:"bytes,4,opt,name=analysis,proto3" json:"analysis,omitempty"`
+}
+
+func (x *TrafficRoute_Canary) Reset() {
+	*x = TrafficRoute_Canary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_Canary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_Canary) ProtoMessage() {}
+
+func (x *TrafficRoute_Canary) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_Canary.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Canary) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *TrafficRoute_Canary) GetDestination() map[string]string {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Canary) GetSteps() []uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Canary) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Canary) GetAnalysis() *TrafficRoute_Canary_Analysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
 // Http defines configuration for HTTP traffic.
 type TrafficRoute_Http struct {
 	state         protoimpl.MessageState
@@ -381,12 +496,20 @@ type TrafficRoute_Http struct {
 	// One destination that the traffic will be redirected to.
 	// When used, "split" is not allowed.
 	Destination map[string]string `protobuf:"bytes,4,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Mirroring of the matched traffic.
+	Mirror *TrafficRoute_Http_Mirror `protobuf:"bytes,5,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	// Timeouts of the matched requests. When defined, they take precedence
+	// over the HTTP timeouts of the Timeout policy.
+	Timeout *TrafficRoute_Http_Timeout `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Retry policy of the matched requests. When defined, it takes precedence
+	// over the HTTP configuration of the Retry policy.
+	Retry *Retry_Conf_Http `protobuf:"bytes,7,opt,name=retry,proto3" json:"retry,omitempty"`
 }
 
 func (x *TrafficRoute_Http) Reset() {
 	*x = TrafficRoute_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[4]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +522,7 @@ func (x *TrafficRoute_Http) String() string {
 func (*TrafficRoute_Http) ProtoMessage() {}
 
 func (x *TrafficRoute_Http) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[4]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +535,7 @@ func (x *TrafficRoute_Http) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficRoute_Http.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4}
 }
 
 func (x *TrafficRoute_Http) GetMatch() *TrafficRoute_Http_Match {
@@ -443,6 +566,27 @@ func (x *TrafficRoute_Http) GetDestination() map[string]string {
 	return nil
 }
 
+func (x *TrafficRoute_Http) GetMirror() *TrafficRoute_Http_Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http) GetTimeout() *TrafficRoute_Http_Timeout {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http) GetRetry() *Retry_Conf_Http {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
 // RoundRobin is a simple policy in which each available upstream host is
 // selected in round robin order.
 type TrafficRoute_LoadBalancer_RoundRobin struct {
@@ -454,7 +598,7 @@ type TrafficRoute_LoadBalancer_RoundRobin struct {
 func (x *TrafficRoute_LoadBalancer_RoundRobin) Reset() {
 	*x = TrafficRoute_LoadBalancer_RoundRobin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[6]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +611,7 @@ func (x *TrafficRoute_LoadBalancer_RoundRobin) String() string {
 func (*TrafficRoute_LoadBalancer_RoundRobin) ProtoMessage() {}
 
 func (x *TrafficRoute_LoadBalancer_RoundRobin) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[6]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +643,7 @@ type TrafficRoute_LoadBalancer_LeastRequest struct {
 func (x *TrafficRoute_LoadBalancer_LeastRequest) Reset() {
 	*x = TrafficRoute_LoadBalancer_LeastRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[7]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +656,7 @@ func (x *TrafficRoute_LoadBalancer_LeastRequest) String() string {
 func (*TrafficRoute_LoadBalancer_LeastRequest) ProtoMessage() {}
 
 func (x *TrafficRoute_LoadBalancer_LeastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[7]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +697,7 @@ type TrafficRoute_LoadBalancer_RingHash struct {
 func (x *TrafficRoute_LoadBalancer_RingHash) Reset() {
 	*x = TrafficRoute_LoadBalancer_RingHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[8]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +710,7 @@ func (x *TrafficRoute_LoadBalancer_RingHash) String() string {
 func (*TrafficRoute_LoadBalancer_RingHash) ProtoMessage() {}
 
 func (x *TrafficRoute_LoadBalancer_RingHash) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[8]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +757,7 @@ type TrafficRoute_LoadBalancer_Random struct {
 func (x *TrafficRoute_LoadBalancer_Random) Reset() {
 	*x = TrafficRoute_LoadBalancer_Random{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[9]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -626,7 +770,7 @@ func (x *TrafficRoute_LoadBalancer_Random) String() string {
 func (*TrafficRoute_LoadBalancer_Random) ProtoMessage() {}
 
 func (x *TrafficRoute_LoadBalancer_Random) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[9]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +796,7 @@ type TrafficRoute_LoadBalancer_Maglev struct {
 func (x *TrafficRoute_LoadBalancer_Maglev) Reset() {
 	*x = TrafficRoute_LoadBalancer_Maglev{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[10]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +809,329 @@ func (x *TrafficRoute_LoadBalancer_Maglev) String() string {
 func (*TrafficRoute_LoadBalancer_Maglev) ProtoMessage() {}
 
 func (x *TrafficRoute_LoadBalancer_Maglev) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[10]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_Maglev.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_Maglev) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 4}
+}
+
+// HashPolicy defines which part of the request is hashed by the
+// consistent hashing load balancers ("ring_hash" and "maglev").
+type TrafficRoute_LoadBalancer_HashPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Header_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Cookie_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_
+	Type isTrafficRoute_LoadBalancer_HashPolicy_Type `protobuf_oneof:"type"`
+	// If true and the policy produced a hash, the remaining hash policies
+	// are not evaluated.
+	Terminal bool `protobuf:"varint,5,opt,name=terminal,proto3" json:"terminal,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetType() isTrafficRoute_LoadBalancer_HashPolicy_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetHeader() *TrafficRoute_LoadBalancer_HashPolicy_Header {
+	if x, ok := x.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetCookie() *TrafficRoute_LoadBalancer_HashPolicy_Cookie {
+	if x, ok := x.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetSourceIp() *TrafficRoute_LoadBalancer_HashPolicy_SourceIp {
+	if x, ok := x.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_); ok {
+		return x.SourceIp
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetQueryParameter() *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter {
+	if x, ok := x.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_); ok {
+		return x.QueryParameter
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+type isTrafficRoute_LoadBalancer_HashPolicy_Type interface {
+	isTrafficRoute_LoadBalancer_HashPolicy_Type()
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Header_ struct {
+	Header *TrafficRoute_LoadBalancer_HashPolicy_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie_ struct {
+	Cookie *TrafficRoute_LoadBalancer_HashPolicy_Cookie `protobuf:"bytes,2,opt,name=cookie,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp_ struct {
+	SourceIp *TrafficRoute_LoadBalancer_HashPolicy_SourceIp `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_ struct {
+	QueryParameter *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter `protobuf:"bytes,4,opt,name=query_parameter,json=queryParameter,proto3,oneof"`
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {
+}
+
+// Header hashes the value of the HTTP request header.
+type TrafficRoute_LoadBalancer_HashPolicy_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the request header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_Header.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 0}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Cookie hashes the value of the HTTP cookie. If the cookie is not
+// present and the ttl is set, Envoy generates the cookie, so the
+// subsequent requests of the client are sent to the same host.
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Lifetime of the generated cookie. If not specified, the cookie is
+	// not generated.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Path of the generated cookie.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_Cookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_Cookie.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 1}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// SourceIp hashes the IP address of the downstream connection.
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_SourceIp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_SourceIp.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 2}
+}
+
+// QueryParameter hashes the value of the URL query parameter.
+type TrafficRoute_LoadBalancer_HashPolicy_QueryParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the query parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_QueryParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,46 +1139,267 @@ func (x *TrafficRoute_LoadBalancer_Maglev) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_QueryParameter.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 3}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_QueryParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Analysis defines thresholds that the canary destination has to meet to
+// be promoted to the next step.
+type TrafficRoute_Canary_Analysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the Prometheus server that scrapes the data plane proxies
+	// of the mesh, e.g. "http://prometheus-server.kuma-metrics:80".
+	PrometheusAddress string `protobuf:"bytes,1,opt,name=prometheus_address,json=prometheusAddress,proto3" json:"prometheus_address,omitempty"`
+	// Minimal percentage of non 5xx responses of the canary destination (in
+	// the range 0.0 - 100.0, inclusive).
+	MinSuccessRate *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=min_success_rate,json=minSuccessRate,proto3" json:"min_success_rate,omitempty"`
+	// Maximal 99th percentile of the request duration of the canary
+	// destination.
+	MaxLatency *durationpb.Duration `protobuf:"bytes,3,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
+}
+
+func (x *TrafficRoute_Canary_Analysis) Reset() {
+	*x = TrafficRoute_Canary_Analysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_Canary_Analysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_Canary_Analysis) ProtoMessage() {}
+
+func (x *TrafficRoute_Canary_Analysis) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_Canary_Analysis.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Canary_Analysis) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+func (x *TrafficRoute_Canary_Analysis) GetPrometheusAddress() string {
+	if x != nil {
+		return x.PrometheusAddress
+	}
+	return ""
+}
+
+func (x *TrafficRoute_Canary_Analysis) GetMinSuccessRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MinSuccessRate
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Canary_Analysis) GetMaxLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return nil
+}
+
+// Match defines a series of matching criteria to apply modification and
+// reroute the traffic.
+type TrafficRoute_Http_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Method matches method of HTTP request.
+	Method *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Path matches HTTP path.
+	Path *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Headers match HTTP request headers.
+	Headers map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TrafficRoute_Http_Match) Reset() {
+	*x = TrafficRoute_Http_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_Http_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_Http_Match) ProtoMessage() {}
+
+func (x *TrafficRoute_Http_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_Http_Match.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Http_Match) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+func (x *TrafficRoute_Http_Match) GetMethod() *TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http_Match) GetPath() *TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http_Match) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Modify defines modifications of matched HTTP messages.
+type TrafficRoute_Http_Modify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path modifications.
+	Path *TrafficRoute_Http_Modify_Path `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Host modifications.
+	Host *TrafficRoute_Http_Modify_Host `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// Request headers modifications.
+	RequestHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,3,opt,name=requestHeaders,proto3" json:"requestHeaders,omitempty"`
+	// Response headers modifications.
+	ResponseHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,4,opt,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
+}
+
+func (x *TrafficRoute_Http_Modify) Reset() {
+	*x = TrafficRoute_Http_Modify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_Http_Modify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_Http_Modify) ProtoMessage() {}
+
+func (x *TrafficRoute_Http_Modify) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_Http_Modify.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Http_Modify) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1}
+}
+
+func (x *TrafficRoute_Http_Modify) GetPath() *TrafficRoute_Http_Modify_Path {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http_Modify) GetHost() *TrafficRoute_Http_Modify_Host {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http_Modify) GetRequestHeaders() *TrafficRoute_Http_Modify_Headers {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
 }
 
-// Deprecated: Use TrafficRoute_LoadBalancer_Maglev.ProtoReflect.Descriptor instead.
-func (*TrafficRoute_LoadBalancer_Maglev) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 4}
+func (x *TrafficRoute_Http_Modify) GetResponseHeaders() *TrafficRoute_Http_Modify_Headers {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
 }
 
-// Match defines a series of matching criteria to apply modification and
-// reroute the traffic.
-type TrafficRoute_Http_Match struct {
+// Mirror defines a way of shadowing the matched traffic to another
+// destination. Responses to the mirrored requests are ignored.
+type TrafficRoute_Http_Mirror struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Method matches method of HTTP request.
-	Method *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	// Path matches HTTP path.
-	Path *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// Headers match HTTP request headers.
-	Headers map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Destination to which the matched requests are mirrored.
+	Destination map[string]string `protobuf:"bytes,1,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Percentage of the matched requests to mirror (in the range 0.0 -
+	// 100.0, inclusive). If not specified, all requests are mirrored.
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
 }
 
-func (x *TrafficRoute_Http_Match) Reset() {
-	*x = TrafficRoute_Http_Match{}
+func (x *TrafficRoute_Http_Mirror) Reset() {
+	*x = TrafficRoute_Http_Mirror{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficRoute_Http_Match) String() string {
+func (x *TrafficRoute_Http_Mirror) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficRoute_Http_Match) ProtoMessage() {}
+func (*TrafficRoute_Http_Mirror) ProtoMessage() {}
 
-func (x *TrafficRoute_Http_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+func (x *TrafficRoute_Http_Mirror) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,65 +1410,57 @@ func (x *TrafficRoute_Http_Match) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficRoute_Http_Match.ProtoReflect.Descriptor instead.
-func (*TrafficRoute_Http_Match) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 0}
-}
-
-func (x *TrafficRoute_Http_Match) GetMethod() *TrafficRoute_Http_Match_StringMatcher {
-	if x != nil {
-		return x.Method
-	}
-	return nil
+// Deprecated: Use TrafficRoute_Http_Mirror.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Http_Mirror) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 2}
 }
 
-func (x *TrafficRoute_Http_Match) GetPath() *TrafficRoute_Http_Match_StringMatcher {
+func (x *TrafficRoute_Http_Mirror) GetDestination() map[string]string {
 	if x != nil {
-		return x.Path
+		return x.Destination
 	}
 	return nil
 }
 
-func (x *TrafficRoute_Http_Match) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+func (x *TrafficRoute_Http_Mirror) GetPercentage() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.Headers
+		return x.Percentage
 	}
 	return nil
 }
 
-// Modify defines modifications of matched HTTP messages.
-type TrafficRoute_Http_Modify struct {
+// Timeout defines the timeouts of the matched requests.
+type TrafficRoute_Http_Timeout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Path modifications.
-	Path *TrafficRoute_Http_Modify_Path `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// Host modifications.
-	Host *TrafficRoute_Http_Modify_Host `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	// Request headers modifications.
-	RequestHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,3,opt,name=requestHeaders,proto3" json:"requestHeaders,omitempty"`
-	// Response headers modifications.
-	ResponseHeaders *TrafficRoute_Http_Modify_Headers `protobuf:"bytes,4,opt,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
+	// RequestTimeout is a span between the point at which the entire
+	// downstream request (i.e. end-of-stream) has been processed and when the
+	// upstream response has been completely processed
+	RequestTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=requestTimeout,proto3" json:"requestTimeout,omitempty"`
+	// StreamIdleTimeout is the amount of time that the request's stream can
+	// exist with no upstream or downstream activity
+	StreamIdleTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=streamIdleTimeout,proto3" json:"streamIdleTimeout,omitempty"`
 }
 
-func (x *TrafficRoute_Http_Modify) Reset() {
-	*x = TrafficRoute_Http_Modify{}
+func (x *TrafficRoute_Http_Timeout) Reset() {
+	*x = TrafficRoute_Http_Timeout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficRoute_Http_Modify) String() string {
+func (x *TrafficRoute_Http_Timeout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficRoute_Http_Modify) ProtoMessage() {}
+func (*TrafficRoute_Http_Timeout) ProtoMessage() {}
 
-func (x *TrafficRoute_Http_Modify) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+func (x *TrafficRoute_Http_Timeout) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,35 +1471,21 @@ func (x *TrafficRoute_Http_Modify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficRoute_Http_Modify.ProtoReflect.Descriptor instead.
-func (*TrafficRoute_Http_Modify) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1}
-}
-
-func (x *TrafficRoute_Http_Modify) GetPath() *TrafficRoute_Http_Modify_Path {
-	if x != nil {
-		return x.Path
-	}
-	return nil
-}
-
-func (x *TrafficRoute_Http_Modify) GetHost() *TrafficRoute_Http_Modify_Host {
-	if x != nil {
-		return x.Host
-	}
-	return nil
+// Deprecated: Use TrafficRoute_Http_Timeout.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Http_Timeout) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 3}
 }
 
-func (x *TrafficRoute_Http_Modify) GetRequestHeaders() *TrafficRoute_Http_Modify_Headers {
+func (x *TrafficRoute_Http_Timeout) GetRequestTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.RequestHeaders
+		return x.RequestTimeout
 	}
 	return nil
 }
 
-func (x *TrafficRoute_Http_Modify) GetResponseHeaders() *TrafficRoute_Http_Modify_Headers {
+func (x *TrafficRoute_Http_Timeout) GetStreamIdleTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.ResponseHeaders
+		return x.StreamIdleTimeout
 	}
 	return nil
 }
@@ -841,7 +1506,7 @@ type TrafficRoute_Http_Match_StringMatcher struct {
 func (x *TrafficRoute_Http_Match_StringMatcher) Reset() {
 	*x = TrafficRoute_Http_Match_StringMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -854,7 +1519,7 @@ func (x *TrafficRoute_Http_Match_StringMatcher) String() string {
 func (*TrafficRoute_Http_Match_StringMatcher) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Match_StringMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1532,7 @@ func (x *TrafficRoute_Http_Match_StringMatcher) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use TrafficRoute_Http_Match_StringMatcher.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Match_StringMatcher) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 0, 0}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 0, 0}
 }
 
 func (m *TrafficRoute_Http_Match_StringMatcher) GetMatcherType() isTrafficRoute_Http_Match_StringMatcher_MatcherType {
@@ -945,7 +1610,7 @@ type TrafficRoute_Http_Modify_RegexReplace struct {
 func (x *TrafficRoute_Http_Modify_RegexReplace) Reset() {
 	*x = TrafficRoute_Http_Modify_RegexReplace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -958,7 +1623,7 @@ func (x *TrafficRoute_Http_Modify_RegexReplace) String() string {
 func (*TrafficRoute_Http_Modify_RegexReplace) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_RegexReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1636,7 @@ func (x *TrafficRoute_Http_Modify_RegexReplace) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use TrafficRoute_Http_Modify_RegexReplace.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_RegexReplace) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 0}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 0}
 }
 
 func (x *TrafficRoute_Http_Modify_RegexReplace) GetPattern() string {
@@ -1003,7 +1668,7 @@ type TrafficRoute_Http_Modify_Path struct {
 func (x *TrafficRoute_Http_Modify_Path) Reset() {
 	*x = TrafficRoute_Http_Modify_Path{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1681,7 @@ func (x *TrafficRoute_Http_Modify_Path) String() string {
 func (*TrafficRoute_Http_Modify_Path) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Path) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1694,7 @@ func (x *TrafficRoute_Http_Modify_Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficRoute_Http_Modify_Path.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_Path) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 1}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 1}
 }
 
 func (m *TrafficRoute_Http_Modify_Path) GetType() isTrafficRoute_Http_Modify_Path_Type {
@@ -1086,7 +1751,7 @@ type TrafficRoute_Http_Modify_Host struct {
 func (x *TrafficRoute_Http_Modify_Host) Reset() {
 	*x = TrafficRoute_Http_Modify_Host{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[19]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1099,7 +1764,7 @@ func (x *TrafficRoute_Http_Modify_Host) String() string {
 func (*TrafficRoute_Http_Modify_Host) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Host) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[19]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1777,7 @@ func (x *TrafficRoute_Http_Modify_Host) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficRoute_Http_Modify_Host.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_Host) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 2}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 2}
 }
 
 func (m *TrafficRoute_Http_Modify_Host) GetType() isTrafficRoute_Http_Modify_Host_Type {
@@ -1169,7 +1834,7 @@ type TrafficRoute_Http_Modify_Headers struct {
 func (x *TrafficRoute_Http_Modify_Headers) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +1847,7 @@ func (x *TrafficRoute_Http_Modify_Headers) String() string {
 func (*TrafficRoute_Http_Modify_Headers) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1860,7 @@ func (x *TrafficRoute_Http_Modify_Headers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficRoute_Http_Modify_Headers.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_Headers) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 3}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 3}
 }
 
 func (x *TrafficRoute_Http_Modify_Headers) GetAdd() []*TrafficRoute_Http_Modify_Headers_Add {
@@ -1230,7 +1895,7 @@ type TrafficRoute_Http_Modify_Headers_Add struct {
 func (x *TrafficRoute_Http_Modify_Headers_Add) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers_Add{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +1908,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Add) String() string {
 func (*TrafficRoute_Http_Modify_Headers_Add) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers_Add) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1921,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Add) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use TrafficRoute_Http_Modify_Headers_Add.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_Headers_Add) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 3, 0}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 3, 0}
 }
 
 func (x *TrafficRoute_Http_Modify_Headers_Add) GetName() string {
@@ -1293,7 +1958,7 @@ type TrafficRoute_Http_Modify_Headers_Remove struct {
 func (x *TrafficRoute_Http_Modify_Headers_Remove) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers_Remove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1971,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Remove) String() string {
 func (*TrafficRoute_Http_Modify_Headers_Remove) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers_Remove) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1984,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Remove) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use TrafficRoute_Http_Modify_Headers_Remove.ProtoReflect.Descriptor instead.
 func (*TrafficRoute_Http_Modify_Headers_Remove) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 1, 3, 1}
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 4, 1, 3, 1}
 }
 
 func (x *TrafficRoute_Http_Modify_Headers_Remove) GetName() string {
@@ -1337,236 +2002,366 @@ var file_mesh_v1alpha1_traffic_route_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x99, 0x1b, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x42, 0x0c, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x0c, 0xfa, 0x42,
-	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66,
-	0x1a, 0xf8, 0x01, 0x0a, 0x05, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x73, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70,
-	0x6c, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x18, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x08, 0x01, 0x22, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x2a, 0x04, 0x72, 0x02, 0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x9e, 0x05, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x0b,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x62, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x2a, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x44, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0xf8, 0x01, 0x0a, 0x05, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x12, 0x3a, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x73, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x18, 0xfa, 0x42, 0x11,
+	0x9a, 0x01, 0x0e, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0xfd, 0x0a, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x62,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62,
+	0x69, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e,
+	0x12, 0x61, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x4e, 0x0a, 0x06, 0x6d, 0x61,
+	0x67, 0x6c, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x67, 0x6c, 0x65, 0x76,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x67, 0x6c, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0d, 0x68, 0x61,
+	0x73, 0x68, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
 	0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
-	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x12, 0x61, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
-	0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
-	0x4c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c,
-	0x6c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52,
-	0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x08, 0x72, 0x69, 0x6e, 0x67, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x6e,
-	0x64, 0x6f, 0x6d, 0x12, 0x4e, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6c, 0x65, 0x76, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x67, 0x6c, 0x65, 0x76, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x67,
-	0x6c, 0x65, 0x76, 0x1a, 0x0c, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69,
-	0x6e, 0x1a, 0x31, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x77, 0x0a, 0x08, 0x52, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69,
-	0x6e, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x08, 0x0a,
-	0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x08, 0x0a, 0x06, 0x4d, 0x61, 0x67, 0x6c, 0x65,
-	0x76, 0x42, 0x09, 0x0a, 0x07, 0x6c, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf7, 0x02, 0x0a,
-	0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x52, 0x0a,
-	0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x72, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x68, 0x61, 0x73,
+	0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x0c, 0x0a, 0x0a, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x1a, 0x31, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x77, 0x0a, 0x08, 0x52, 0x69,
+	0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68,
+	0x61, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x7a, 0x65, 0x1a, 0x08, 0x0a, 0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x08, 0x0a,
+	0x06, 0x4d, 0x61, 0x67, 0x6c, 0x65, 0x76, 0x1a, 0xfd, 0x04, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x59, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
 	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xac, 0x0e, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12,
-	0x41, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x59, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x60, 0x0a, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x70, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x72,
+	0x0a, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52,
-	0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0xde, 0x03, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x1a, 0x22,
+	0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x1a, 0x63, 0x0a, 0x06, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x18, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x70, 0x1a, 0x2a, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6c, 0x62, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x1a, 0xb8, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46, 0x0a, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d,
 	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
-	0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x4d, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x52, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3f, 0x0a, 0x06,
+	0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0x3e, 0x0a,
+	0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x97, 0x04,
+	0x0a, 0x06, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x60, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x1a, 0xc3, 0x01, 0x0a, 0x08, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x33,
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xfe, 0x12, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70,
+	0x12, 0x41, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x39, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x1a, 0xde, 0x03, 0x0a, 0x05,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x4d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x52, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x0d, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x16,
+	0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x75, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe1, 0x07, 0x0a,
+	0x06, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x45, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x45,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x1a, 0x58, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x89, 0x01,
+	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0d, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x51,
+	0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x1a, 0x68, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x05, 0x65,
-	0x78, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x78,
-	0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x75, 0x0a, 0x0c, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75,
+	0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x7f, 0x0a, 0x04, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0xe1, 0x07, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x45, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d,
-	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
-	0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e,
-	0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x58, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x65,
-	0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
-	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x89, 0x01, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0d, 0x72,
-	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x12, 0x51, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61,
+	0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xa3, 0x02, 0x0a, 0x07, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x03, 0x61,
+	0x64, 0x64, 0x12, 0x53, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
 	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x7f,
-	0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x57,
-	0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x53, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x1a, 0x22, 0x0a, 0x06,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x1a, 0xed, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x65, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x66,
-	0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a,
-	0xa3, 0x02, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x03, 0x61,
-	0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x53, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x53, 0x0a, 0x03,
-	0x41, 0x64, 0x64, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
-	0x18, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x1a, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16, 0x0a, 0x14, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d,
-	0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89,
-	0xa6, 0x01, 0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x4f, 0x5a, 0x28,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68,
-	0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2, 0x01,
-	0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xf2, 0x01, 0x0d,
-	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x95, 0x01, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x47, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x6c,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16,
+	0x0a, 0x14, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06,
+	0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42,
+	0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50,
+	0x01, 0xa2, 0x01, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0xf2, 0x01, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1581,70 +2376,105 @@ func file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_route_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_route_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_mesh_v1alpha1_traffic_route_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_mesh_v1alpha1_traffic_route_proto_goTypes = []interface{}{
-	(*TrafficRoute)(nil),                           // 0: kuma.mesh.v1alpha1.TrafficRoute
-	(*TrafficRoute_Split)(nil),                     // 1: kuma.mesh.v1alpha1.TrafficRoute.Split
-	(*TrafficRoute_LoadBalancer)(nil),              // 2: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
-	(*TrafficRoute_Conf)(nil),                      // 3: kuma.mesh.v1alpha1.TrafficRoute.Conf
-	(*TrafficRoute_Http)(nil),                      // 4: kuma.mesh.v1alpha1.TrafficRoute.Http
-	nil,                                            // 5: kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
-	(*TrafficRoute_LoadBalancer_RoundRobin)(nil),   // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
-	(*TrafficRoute_LoadBalancer_LeastRequest)(nil), // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
-	(*TrafficRoute_LoadBalancer_RingHash)(nil),     // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
-	(*TrafficRoute_LoadBalancer_Random)(nil),       // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
-	(*TrafficRoute_LoadBalancer_Maglev)(nil),       // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
-	nil,                                            // 11: kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
-	(*TrafficRoute_Http_Match)(nil),                // 12: kuma.mesh.v1alpha1.TrafficRoute.Http.Match
-	(*TrafficRoute_Http_Modify)(nil),               // 13: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
-	nil,                                            // 14: kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
-	(*TrafficRoute_Http_Match_StringMatcher)(nil),  // 15: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	nil, // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
-	(*TrafficRoute_Http_Modify_RegexReplace)(nil),   // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	(*TrafficRoute_Http_Modify_Path)(nil),           // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
-	(*TrafficRoute_Http_Modify_Host)(nil),           // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
-	(*TrafficRoute_Http_Modify_Headers)(nil),        // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	(*TrafficRoute_Http_Modify_Headers_Add)(nil),    // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
-	(*TrafficRoute_Http_Modify_Headers_Remove)(nil), // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
-	(*Selector)(nil),               // 23: kuma.mesh.v1alpha1.Selector
-	(*wrapperspb.UInt32Value)(nil), // 24: google.protobuf.UInt32Value
+	(*TrafficRoute)(nil),                                        // 0: kuma.mesh.v1alpha1.TrafficRoute
+	(*TrafficRoute_Split)(nil),                                  // 1: kuma.mesh.v1alpha1.TrafficRoute.Split
+	(*TrafficRoute_LoadBalancer)(nil),                           // 2: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	(*TrafficRoute_Conf)(nil),                                   // 3: kuma.mesh.v1alpha1.TrafficRoute.Conf
+	(*TrafficRoute_Canary)(nil),                                 // 4: kuma.mesh.v1alpha1.TrafficRoute.Canary
+	(*TrafficRoute_Http)(nil),                                   // 5: kuma.mesh.v1alpha1.TrafficRoute.Http
+	nil,                                                         // 6: kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
+	(*TrafficRoute_LoadBalancer_RoundRobin)(nil),                // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
+	(*TrafficRoute_LoadBalancer_LeastRequest)(nil),              // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
+	(*TrafficRoute_LoadBalancer_RingHash)(nil),                  // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
+	(*TrafficRoute_LoadBalancer_Random)(nil),                    // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
+	(*TrafficRoute_LoadBalancer_Maglev)(nil),                    // 11: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
+	(*TrafficRoute_LoadBalancer_HashPolicy)(nil),                // 12: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy
+	(*TrafficRoute_LoadBalancer_HashPolicy_Header)(nil),         // 13: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Header
+	(*TrafficRoute_LoadBalancer_HashPolicy_Cookie)(nil),         // 14: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie
+	(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp)(nil),       // 15: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.SourceIp
+	(*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter)(nil), // 16: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.QueryParameter
+	nil,                                  // 17: kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
+	(*TrafficRoute_Canary_Analysis)(nil), // 18: kuma.mesh.v1alpha1.TrafficRoute.Canary.Analysis
+	nil,                                  // 19: kuma.mesh.v1alpha1.TrafficRoute.Canary.DestinationEntry
+	(*TrafficRoute_Http_Match)(nil),      // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	(*TrafficRoute_Http_Modify)(nil),     // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
+	(*TrafficRoute_Http_Mirror)(nil),     // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror
+	(*TrafficRoute_Http_Timeout)(nil),    // 23: kuma.mesh.v1alpha1.TrafficRoute.Http.Timeout
+	nil,                                  // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
+	(*TrafficRoute_Http_Match_StringMatcher)(nil), // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	nil, // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
+	(*TrafficRoute_Http_Modify_RegexReplace)(nil),   // 27: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	(*TrafficRoute_Http_Modify_Path)(nil),           // 28: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
+	(*TrafficRoute_Http_Modify_Host)(nil),           // 29: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
+	(*TrafficRoute_Http_Modify_Headers)(nil),        // 30: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	(*TrafficRoute_Http_Modify_Headers_Add)(nil),    // 31: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
+	(*TrafficRoute_Http_Modify_Headers_Remove)(nil), // 32: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
+	nil,                            // 33: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.DestinationEntry
+	(*Selector)(nil),               // 34: kuma.mesh.v1alpha1.Selector
+	(*timestamppb.Timestamp)(nil),  // 35: google.protobuf.Timestamp
+	(*wrapperspb.UInt32Value)(nil), // 36: google.protobuf.UInt32Value
+	(*durationpb.Duration)(nil),    // 37: google.protobuf.Duration
+	(*Retry_Conf_Http)(nil),        // 38: kuma.mesh.v1alpha1.Retry.Conf.Http
+	(*wrapperspb.DoubleValue)(nil), // 39: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_route_proto_depIdxs = []int32{
-	23, // 0: kuma.mesh.v1alpha1.TrafficRoute.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	23, // 1: kuma.mesh.v1alpha1.TrafficRoute.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	34, // 0: kuma.mesh.v1alpha1.TrafficRoute.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	34, // 1: kuma.mesh.v1alpha1.TrafficRoute.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	3,  // 2: kuma.mesh.v1alpha1.TrafficRoute.conf:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf
-	24, // 3: kuma.mesh.v1alpha1.TrafficRoute.Split.weight:type_name -> google.protobuf.UInt32Value
-	5,  // 4: kuma.mesh.v1alpha1.TrafficRoute.Split.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
-	6,  // 5: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.round_robin:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
-	7,  // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.least_request:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
-	8,  // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.ring_hash:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
-	9,  // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.random:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
-	10, // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.maglev:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
-	1,  // 10: kuma.mesh.v1alpha1.TrafficRoute.Conf.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
-	2,  // 11: kuma.mesh.v1alpha1.TrafficRoute.Conf.load_balancer:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
-	11, // 12: kuma.mesh.v1alpha1.TrafficRoute.Conf.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
-	4,  // 13: kuma.mesh.v1alpha1.TrafficRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http
-	12, // 14: kuma.mesh.v1alpha1.TrafficRoute.Http.match:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match
-	13, // 15: kuma.mesh.v1alpha1.TrafficRoute.Http.modify:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
-	1,  // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
-	14, // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
-	15, // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.method:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	15, // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	16, // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
-	18, // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
-	19, // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.host:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
-	20, // 23: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.requestHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	20, // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.responseHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	15, // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	17, // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path.regex:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	17, // 27: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host.fromPath:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	21, // 28: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.add:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
-	22, // 29: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.remove:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	35, // 3: kuma.mesh.v1alpha1.TrafficRoute.expires_at:type_name -> google.protobuf.Timestamp
+	36, // 4: kuma.mesh.v1alpha1.TrafficRoute.Split.weight:type_name -> google.protobuf.UInt32Value
+	6,  // 5: kuma.mesh.v1alpha1.TrafficRoute.Split.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
+	7,  // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.round_robin:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
+	8,  // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.least_request:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
+	9,  // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.ring_hash:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
+	10, // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.random:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
+	11, // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.maglev:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
+	12, // 11: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.hash_policies:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy
+	1,  // 12: kuma.mesh.v1alpha1.TrafficRoute.Conf.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
+	2,  // 13: kuma.mesh.v1alpha1.TrafficRoute.Conf.load_balancer:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	17, // 14: kuma.mesh.v1alpha1.TrafficRoute.Conf.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
+	5,  // 15: kuma.mesh.v1alpha1.TrafficRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http
+	4,  // 16: kuma.mesh.v1alpha1.TrafficRoute.Conf.canary:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Canary
+	19, // 17: kuma.mesh.v1alpha1.TrafficRoute.Canary.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Canary.DestinationEntry
+	37, // 18: kuma.mesh.v1alpha1.TrafficRoute.Canary.interval:type_name -> google.protobuf.Duration
+	18, // 19: kuma.mesh.v1alpha1.TrafficRoute.Canary.analysis:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Canary.Analysis
+	20, // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.match:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	21, // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.modify:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
+	1,  // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
+	24, // 23: kuma.mesh.v1alpha1.TrafficRoute.Http.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
+	22, // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.mirror:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror
+	23, // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.timeout:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Timeout
+	38, // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.retry:type_name -> kuma.mesh.v1alpha1.Retry.Conf.Http
+	13, // 27: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.header:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Header
+	14, // 28: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.cookie:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie
+	15, // 29: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.source_ip:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.SourceIp
+	16, // 30: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.query_parameter:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.QueryParameter
+	37, // 31: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie.ttl:type_name -> google.protobuf.Duration
+	39, // 32: kuma.mesh.v1alpha1.TrafficRoute.Canary.Analysis.min_success_rate:type_name -> google.protobuf.DoubleValue
+	37, // 33: kuma.mesh.v1alpha1.TrafficRoute.Canary.Analysis.max_latency:type_name -> google.protobuf.Duration
+	25, // 34: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.method:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	25, // 35: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	26, // 36: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
+	28, // 37: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
+	29, // 38: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.host:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
+	30, // 39: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.requestHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	30, // 40: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.responseHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	33, // 41: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.DestinationEntry
+	39, // 42: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.percentage:type_name -> google.protobuf.DoubleValue
+	37, // 43: kuma.mesh.v1alpha1.TrafficRoute.Http.Timeout.requestTimeout:type_name -> google.protobuf.Duration
+	37, // 44: kuma.mesh.v1alpha1.TrafficRoute.Http.Timeout.streamIdleTimeout:type_name -> google.protobuf.Duration
+	25, // 45: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	27, // 46: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path.regex:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	27, // 47: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host.fromPath:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	31, // 48: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.add:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
+	32, // 49: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.remove:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_route_proto_init() }
@@ -1653,6 +2483,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 		return
 	}
 	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_retry_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute); i {
@@ -1703,6 +2534,18 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Canary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http); i {
 			case 0:
 				return &v.state
@@ -1714,7 +2557,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_LoadBalancer_RoundRobin); i {
 			case 0:
 				return &v.state
@@ -1726,7 +2569,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_LoadBalancer_LeastRequest); i {
 			case 0:
 				return &v.state
@@ -1738,7 +2581,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_LoadBalancer_RingHash); i {
 			case 0:
 				return &v.state
@@ -1750,7 +2593,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_LoadBalancer_Random); i {
 			case 0:
 				return &v.state
@@ -1762,7 +2605,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_LoadBalancer_Maglev); i {
 			case 0:
 				return &v.state
@@ -1775,7 +2618,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficRoute_Http_Match); i {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,7 +2630,19 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficRoute_Http_Modify); i {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_Cookie); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1799,6 +2654,90 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Canary_Analysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Modify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Mirror); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Timeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Match_StringMatcher); i {
 			case 0:
 				return &v.state
@@ -1810,7 +2749,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_RegexReplace); i {
 			case 0:
 				return &v.state
@@ -1822,7 +2761,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Path); i {
 			case 0:
 				return &v.state
@@ -1834,7 +2773,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Host); i {
 			case 0:
 				return &v.state
@@ -1846,7 +2785,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers); i {
 			case 0:
 				return &v.state
@@ -1858,7 +2797,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers_Add); i {
 			case 0:
 				return &v.state
@@ -1870,7 +2809,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers_Remove); i {
 			case 0:
 				return &v.state
@@ -1890,16 +2829,22 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 		(*TrafficRoute_LoadBalancer_Random_)(nil),
 		(*TrafficRoute_LoadBalancer_Maglev_)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*TrafficRoute_LoadBalancer_HashPolicy_Header_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_)(nil),
+	}
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Match_StringMatcher_Prefix)(nil),
 		(*TrafficRoute_Http_Match_StringMatcher_Exact)(nil),
 		(*TrafficRoute_Http_Match_StringMatcher_Regex)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Modify_Path_RewritePrefix)(nil),
 		(*TrafficRoute_Http_Modify_Path_Regex)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Modify_Host_Value)(nil),
 		(*TrafficRoute_Http_Modify_Host_FromPath)(nil),
 	}
@@ -1909,7 +2854,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
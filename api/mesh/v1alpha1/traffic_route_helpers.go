@@ -67,3 +67,23 @@ func (d destination) String() string {
 	sort.Strings(pairs)
 	return strings.Join(pairs, "&")
 }
+
+// GetCanarySplits returns the split entry that receives the canary traffic and the one that receives the rest of it.
+// It returns false if "split" does not consist of exactly these two entries.
+func (x *TrafficRoute_Conf) GetCanarySplits() (canary *TrafficRoute_Split, primary *TrafficRoute_Split, ok bool) {
+	if len(x.GetSplit()) != 2 {
+		return nil, nil, false
+	}
+	canaryDestination := destination(x.GetCanary().GetDestination()).String()
+	for _, split := range x.GetSplit() {
+		if destination(split.GetDestination()).String() == canaryDestination {
+			canary = split
+		} else {
+			primary = split
+		}
+	}
+	if canary == nil || primary == nil {
+		return nil, nil, false
+	}
+	return canary, primary, true
+}
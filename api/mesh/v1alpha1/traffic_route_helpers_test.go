@@ -101,4 +101,39 @@ var _ = Describe("TrafficRouteHelper", func() {
 			}
 		})
 	})
+
+	Context("GetCanarySplits", func() {
+		canaryDestination := map[string]string{"kuma.io/service": "backend", "version": "v2"}
+		primaryDestination := map[string]string{"kuma.io/service": "backend", "version": "v1"}
+
+		It("should return canary and primary splits", func() {
+			conf := &TrafficRoute_Conf{
+				Split: []*TrafficRoute_Split{
+					{Weight: util_proto.UInt32(90), Destination: primaryDestination},
+					{Weight: util_proto.UInt32(10), Destination: canaryDestination},
+				},
+				Canary: &TrafficRoute_Canary{Destination: canaryDestination},
+			}
+
+			canary, primary, ok := conf.GetCanarySplits()
+
+			Expect(ok).To(BeTrue())
+			Expect(canary.GetDestination()).To(Equal(canaryDestination))
+			Expect(primary.GetDestination()).To(Equal(primaryDestination))
+		})
+
+		It("should not return splits when canary destination is not in the split", func() {
+			conf := &TrafficRoute_Conf{
+				Split: []*TrafficRoute_Split{
+					{Weight: util_proto.UInt32(90), Destination: primaryDestination},
+					{Weight: util_proto.UInt32(10), Destination: map[string]string{"kuma.io/service": "backend"}},
+				},
+				Canary: &TrafficRoute_Canary{Destination: canaryDestination},
+			}
+
+			_, _, ok := conf.GetCanarySplits()
+
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
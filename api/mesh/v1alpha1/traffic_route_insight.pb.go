@@ -0,0 +1,479 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/traffic_route_insight.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Phase of the canary delivery.
+type TrafficRouteInsight_Canary_Phase int32
+
+const (
+	// Weights of the "split" are being stepped.
+	TrafficRouteInsight_Canary_PROGRESSING TrafficRouteInsight_Canary_Phase = 0
+	// All steps were applied.
+	TrafficRouteInsight_Canary_SUCCEEDED TrafficRouteInsight_Canary_Phase = 1
+	// The canary breached the analysis thresholds and all the traffic was
+	// moved back to the other destination.
+	TrafficRouteInsight_Canary_ROLLED_BACK TrafficRouteInsight_Canary_Phase = 2
+)
+
+// Enum value maps for TrafficRouteInsight_Canary_Phase.
+var (
+	TrafficRouteInsight_Canary_Phase_name = map[int32]string{
+		0: "PROGRESSING",
+		1: "SUCCEEDED",
+		2: "ROLLED_BACK",
+	}
+	TrafficRouteInsight_Canary_Phase_value = map[string]int32{
+		"PROGRESSING": 0,
+		"SUCCEEDED":   1,
+		"ROLLED_BACK": 2,
+	}
+)
+
+func (x TrafficRouteInsight_Canary_Phase) Enum() *TrafficRouteInsight_Canary_Phase {
+	p := new(TrafficRouteInsight_Canary_Phase)
+	*p = x
+	return p
+}
+
+func (x TrafficRouteInsight_Canary_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficRouteInsight_Canary_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_traffic_route_insight_proto_enumTypes[0].Descriptor()
+}
+
+func (TrafficRouteInsight_Canary_Phase) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_traffic_route_insight_proto_enumTypes[0]
+}
+
+func (x TrafficRouteInsight_Canary_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficRouteInsight_Canary_Phase.Descriptor instead.
+func (TrafficRouteInsight_Canary_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_insight_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// TrafficRouteInsight defines the observed state of a TrafficRoute.
+type TrafficRouteInsight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// State of the canary delivery of the TrafficRoute.
+	Canary *TrafficRouteInsight_Canary `protobuf:"bytes,1,opt,name=canary,proto3" json:"canary,omitempty"`
+}
+
+func (x *TrafficRouteInsight) Reset() {
+	*x = TrafficRouteInsight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRouteInsight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRouteInsight) ProtoMessage() {}
+
+func (x *TrafficRouteInsight) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRouteInsight.ProtoReflect.Descriptor instead.
+func (*TrafficRouteInsight) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_insight_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrafficRouteInsight) GetCanary() *TrafficRouteInsight_Canary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+// Canary is the state of the canary delivery.
+type TrafficRouteInsight_Canary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Canary configuration of the TrafficRoute that the delivery was started
+	// with. The delivery restarts when the configuration changes.
+	Conf  *TrafficRoute_Canary             `protobuf:"bytes,1,opt,name=conf,proto3" json:"conf,omitempty"`
+	Phase TrafficRouteInsight_Canary_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=kuma.mesh.v1alpha1.TrafficRouteInsight_Canary_Phase" json:"phase,omitempty"`
+	// Index of the current step in "conf.steps".
+	Step uint32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	// Current weight of the canary destination.
+	CanaryWeight uint32 `protobuf:"varint,4,opt,name=canary_weight,json=canaryWeight,proto3" json:"canary_weight,omitempty"`
+	// Time of the last change of the weights.
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	// Reason of the last change of the weights.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// Observations of the canary delivery. While no requests to the canary
+	// destination are observed, the "TrafficObserved" condition is false and
+	// the delivery doesn't move to the next step.
+	Conditions []*TrafficRouteInsight_Canary_Condition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (x *TrafficRouteInsight_Canary) Reset() {
+	*x = TrafficRouteInsight_Canary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRouteInsight_Canary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRouteInsight_Canary) ProtoMessage() {}
+
+func (x *TrafficRouteInsight_Canary) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRouteInsight_Canary.ProtoReflect.Descriptor instead.
+func (*TrafficRouteInsight_Canary) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_insight_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TrafficRouteInsight_Canary) GetConf() *TrafficRoute_Canary {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *TrafficRouteInsight_Canary) GetPhase() TrafficRouteInsight_Canary_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return TrafficRouteInsight_Canary_PROGRESSING
+}
+
+func (x *TrafficRouteInsight_Canary) GetStep() uint32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *TrafficRouteInsight_Canary) GetCanaryWeight() uint32 {
+	if x != nil {
+		return x.CanaryWeight
+	}
+	return 0
+}
+
+func (x *TrafficRouteInsight_Canary) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+func (x *TrafficRouteInsight_Canary) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TrafficRouteInsight_Canary) GetConditions() []*TrafficRouteInsight_Canary_Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// Condition is an observation of the canary delivery.
+type TrafficRouteInsight_Canary_Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the condition, e.g. "TrafficObserved".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Whether the condition holds.
+	Status bool `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Details of the condition.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Time of the last change of the status.
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) Reset() {
+	*x = TrafficRouteInsight_Canary_Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRouteInsight_Canary_Condition) ProtoMessage() {}
+
+func (x *TrafficRouteInsight_Canary_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRouteInsight_Canary_Condition.ProtoReflect.Descriptor instead.
+func (*TrafficRouteInsight_Canary_Condition) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_insight_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TrafficRouteInsight_Canary_Condition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_traffic_route_insight_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_traffic_route_insight_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a,
+	0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x06, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x46, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52,
+	0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0xe8, 0x04, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12,
+	0x4a, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x58, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b,
+	0x10, 0x02, 0x3a, 0x85, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1d, 0x0a, 0x1b, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x15, 0x12, 0x13,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x02, 0x28, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10,
+	0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x19, 0x3a, 0x17, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x3a, 0x02, 0x18, 0x01, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_traffic_route_insight_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_traffic_route_insight_proto_rawDescData = file_mesh_v1alpha1_traffic_route_insight_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_traffic_route_insight_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_traffic_route_insight_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_traffic_route_insight_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_traffic_route_insight_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_traffic_route_insight_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_traffic_route_insight_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_traffic_route_insight_proto_goTypes = []interface{}{
+	(TrafficRouteInsight_Canary_Phase)(0),        // 0: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.Phase
+	(*TrafficRouteInsight)(nil),                  // 1: kuma.mesh.v1alpha1.TrafficRouteInsight
+	(*TrafficRouteInsight_Canary)(nil),           // 2: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary
+	(*TrafficRouteInsight_Canary_Condition)(nil), // 3: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.Condition
+	(*TrafficRoute_Canary)(nil),                  // 4: kuma.mesh.v1alpha1.TrafficRoute.Canary
+	(*timestamppb.Timestamp)(nil),                // 5: google.protobuf.Timestamp
+}
+var file_mesh_v1alpha1_traffic_route_insight_proto_depIdxs = []int32{
+	2, // 0: kuma.mesh.v1alpha1.TrafficRouteInsight.canary:type_name -> kuma.mesh.v1alpha1.TrafficRouteInsight.Canary
+	4, // 1: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.conf:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Canary
+	0, // 2: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.phase:type_name -> kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.Phase
+	5, // 3: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.last_transition_time:type_name -> google.protobuf.Timestamp
+	3, // 4: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.conditions:type_name -> kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.Condition
+	5, // 5: kuma.mesh.v1alpha1.TrafficRouteInsight.Canary.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_traffic_route_insight_proto_init() }
+func file_mesh_v1alpha1_traffic_route_insight_proto_init() {
+	if File_mesh_v1alpha1_traffic_route_insight_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_traffic_route_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRouteInsight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRouteInsight_Canary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRouteInsight_Canary_Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_traffic_route_insight_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_traffic_route_insight_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_traffic_route_insight_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_traffic_route_insight_proto_enumTypes,
+		MessageInfos:      file_mesh_v1alpha1_traffic_route_insight_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_traffic_route_insight_proto = out.File
+	file_mesh_v1alpha1_traffic_route_insight_proto_rawDesc = nil
+	file_mesh_v1alpha1_traffic_route_insight_proto_goTypes = nil
+	file_mesh_v1alpha1_traffic_route_insight_proto_depIdxs = nil
+}
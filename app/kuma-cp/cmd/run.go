@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/canary"
 	"github.com/kumahq/kuma/pkg/clusterid"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/config"
@@ -135,6 +136,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 				runLog.Error(err, "unable to set up GC")
 				return err
 			}
+			if err := canary.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up Canary controller")
+				return err
+			}
 
 			runLog.Info("starting Control Plane", "version", kuma_version.Build.Version)
 			if err := rt.Start(gracefulCtx.Done()); err != nil {
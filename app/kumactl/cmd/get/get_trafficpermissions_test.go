@@ -19,10 +19,13 @@ import (
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 var _ = Describe("kumactl get traffic-permissions", func() {
 
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+
 	trafficPermissionResources := []*mesh.TrafficPermissionResource{
 		{
 			Spec: &v1alpha1.TrafficPermission{
@@ -66,6 +69,7 @@ var _ = Describe("kumactl get traffic-permissions", func() {
 						},
 					},
 				},
+				ExpiresAt: util_proto.MustTimestampProto(rootTime.Add(2 * time.Hour)),
 			},
 			Meta: &test_model.ResourceMeta{
 				Mesh: "default",
@@ -79,7 +83,6 @@ var _ = Describe("kumactl get traffic-permissions", func() {
 		var rootCmd *cobra.Command
 		var buf *bytes.Buffer
 		var store core_store.ResourceStore
-		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
 		BeforeEach(func() {
 			// setup
 			store = core_store.NewPaginationStore(memory_resources.NewStore())
@@ -9,6 +9,7 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
 )
 
 // CustomTablePrinters are used to define different ways to print entities in table format.
@@ -134,6 +135,22 @@ var BasicResourceTablePrinter = RowPrinter{
 	},
 }
 
+var ExpirableResourceTablePrinter = RowPrinter{
+	Headers: []string{"MESH", "NAME", "EXPIRES", "AGE"},
+	RowFn: func(rootTime time.Time, item model.Resource) []string {
+		var expires *time.Time
+		if expiresAt, ok := model.ExpiresAt(item); ok {
+			expires = &expiresAt
+		}
+		return []string{
+			item.GetMeta().GetMesh(),                                        // MESH
+			item.GetMeta().GetName(),                                        // NAME
+			table.TimeUntil(expires, rootTime),                              // EXPIRES
+			table.TimeSince(item.GetMeta().GetModificationTime(), rootTime), // AGE
+		}
+	},
+}
+
 var BasicGlobalResourceTablePrinter = RowPrinter{
 	Headers: []string{"NAME", "AGE"},
 	RowFn: func(rootTime time.Time, item model.Resource) []string {
@@ -150,6 +167,9 @@ func ResolvePrinter(resourceType model.ResourceType, scope model.ResourceScope)
 		switch scope {
 		case model.ScopeMesh:
 			tablePrinter = BasicResourceTablePrinter
+			if desc, err := registry.Global().DescriptorFor(resourceType); err == nil && model.IsExpirable(desc) {
+				tablePrinter = ExpirableResourceTablePrinter
+			}
 		case model.ScopeGlobal:
 			tablePrinter = BasicGlobalResourceTablePrinter
 		}
@@ -45,6 +45,17 @@ func TimeSince(m time.Time, now time.Time) string {
 	return Duration(d)
 }
 
+// TimeUntil to calculate remaining lifetime of resources
+func TimeUntil(m *time.Time, now time.Time) string {
+	if m == nil {
+		return "never"
+	}
+	if !m.After(now) {
+		return "expired"
+	}
+	return Duration(m.Sub(now))
+}
+
 // Duration returns a readable representation of the provided time
 func Duration(d time.Duration) string {
 	if seconds := int(d.Seconds()); seconds < -1 {
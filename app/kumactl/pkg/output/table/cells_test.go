@@ -59,3 +59,34 @@ var _ = Describe("HumanDuration", func() {
 		}),
 	)
 })
+
+var _ = Describe("TimeUntil", func() {
+	now := time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC)
+	inTwoHours := now.Add(2 * time.Hour)
+	anHourAgo := now.Add(-1 * time.Hour)
+
+	type testCase struct {
+		input  *time.Time
+		output string
+	}
+	DescribeTable("should return the remaining lifetime", func(given testCase) {
+		Expect(table.TimeUntil(given.input, now)).To(Equal(given.output))
+	},
+		Entry("should return never if there is no expiry time", testCase{
+			input:  nil,
+			output: "never",
+		}),
+		Entry("should return expired if expiry time passed", testCase{
+			input:  &anHourAgo,
+			output: "expired",
+		}),
+		Entry("should return expired if expiry time is now", testCase{
+			input:  &now,
+			output: "expired",
+		}),
+		Entry("should return the remaining duration", testCase{
+			input:  &inTwoHours,
+			output: "2h",
+		}),
+	)
+})
@@ -2,10 +2,13 @@ package api_server
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"sort"
+	"strconv"
 
 	"github.com/emicklei/go-restful"
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
@@ -90,6 +93,12 @@ func addInspectEndpoints(
 				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
 		)
+		ws.Route(
+			ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/ejected-endpoints").To(inspectDataplaneEjectedEndpoints(envoyAdminClient, configDumpAccess, rm, cfg.GetEnvoyAdminPort())).
+				Doc("inspect endpoints ejected by outlier detection of dataplane").
+				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
+		)
 		ws.Route(
 			ws.GET("/zoneingresses/{zoneingress}/xds").To(inspectZoneIngressXDS(envoyAdminClient, configDumpAccess, rm, cfg.Multizone.Zone.Name, cfg.GetEnvoyAdminPort())).
 				Doc("inspect zone ingresses XDS configuration").
@@ -112,6 +121,10 @@ func addInspectEndpoints(
 			ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/xds").To(methodNotAllowed).
 				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")))
+		ws.Route(
+			ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/ejected-endpoints").To(methodNotAllowed).
+				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")))
 		ws.Route(
 			ws.GET("/zoneingresses/{zoneingress}/xds").To(methodNotAllowed).
 				Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")))
@@ -389,6 +402,59 @@ func inspectDataplaneXDS(
 	}
 }
 
+func inspectDataplaneEjectedEndpoints(
+	envoyAdminClient admin.EnvoyAdminClient,
+	access access.ConfigDumpAccess,
+	rm manager.ResourceManager,
+	defaultAdminPort uint32,
+) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		meshName := request.PathParameter("mesh")
+		dataplaneName := request.PathParameter("dataplane")
+
+		if err := access.ValidateViewConfigDump(user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Could not get clusters")
+			return
+		}
+
+		dp := core_mesh.NewDataplaneResource()
+		if err := rm.Get(ctx, dp, store.GetByKey(dataplaneName, meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not get dataplane resource")
+			return
+		}
+
+		clusters, err := envoyAdminClient.Clusters(dp, defaultAdminPort)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get clusters")
+			return
+		}
+
+		if err := response.WriteAsJson(newEjectedEndpointsInspectResponse(clusters)); err != nil {
+			rest_errors.HandleError(response, err, "Could not write response")
+			return
+		}
+	}
+}
+
+func newEjectedEndpointsInspectResponse(clusters *envoy_admin_v3.Clusters) *api_server_types.EjectedEndpointsInspectEntryList {
+	result := api_server_types.NewEjectedEndpointsInspectEntryList()
+	for _, cluster := range clusters.GetClusterStatuses() {
+		for _, host := range cluster.GetHostStatuses() {
+			if !host.GetHealthStatus().GetFailedOutlierCheck() {
+				continue
+			}
+			socketAddress := host.GetAddress().GetSocketAddress()
+			result.Items = append(result.Items, api_server_types.EjectedEndpointInspectEntry{
+				Cluster: cluster.GetName(),
+				Address: net.JoinHostPort(socketAddress.GetAddress(), strconv.Itoa(int(socketAddress.GetPortValue()))),
+			})
+		}
+	}
+	result.Total = uint32(len(result.Items))
+	return result
+}
+
 func inspectZoneIngressXDS(
 	envoyAdminClient admin.EnvoyAdminClient,
 	access access.ConfigDumpAccess,
@@ -1103,6 +1103,24 @@ var _ = Describe("Inspect WS", func() {
 					build(),
 			},
 		}),
+		Entry("inspect ejected endpoints for dataplane", testCase{
+			path:       "/meshes/mesh-1/dataplanes/backend-1/ejected-endpoints",
+			goldenFile: "inspect_ejected_endpoints_dataplane.json",
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					outbound8080("httpbin", "192.168.0.2").
+					build(),
+			},
+		}),
+		Entry("inspect ejected endpoints for dataplane on global", testCase{
+			global:     true,
+			path:       "/meshes/default/dataplanes/dp-1/ejected-endpoints",
+			goldenFile: "inspect_ejected_endpoints_global_dataplane.json",
+		}),
 		Entry("inspect xds for zone ingress on global", testCase{
 			global:     true,
 			path:       "/zoneingresses/zi-1/xds",
@@ -211,3 +211,20 @@ func NewDataplaneInspectEntryList() *DataplaneInspectEntryList {
 
 func (*DataplaneInspectEntryList) dataplaneInspectEntry() {
 }
+
+type EjectedEndpointInspectEntry struct {
+	Cluster string `json:"cluster"`
+	Address string `json:"address"`
+}
+
+type EjectedEndpointsInspectEntryList struct {
+	Total uint32                        `json:"total"`
+	Items []EjectedEndpointInspectEntry `json:"items"`
+}
+
+func NewEjectedEndpointsInspectEntryList() *EjectedEndpointsInspectEntryList {
+	return &EjectedEndpointsInspectEntryList{
+		Total: 0,
+		Items: []EjectedEndpointInspectEntry{},
+	}
+}
@@ -0,0 +1,11 @@
+package canary_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCanary(t *testing.T) {
+	test.RunSpecs(t, "Canary Suite")
+}
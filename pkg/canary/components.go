@@ -0,0 +1,18 @@
+package canary
+
+import (
+	"time"
+
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+func Setup(rt runtime.Runtime) error {
+	if rt.Config().Mode == config_core.Zone {
+		// TrafficRoutes are created on Global in multizone deployment, so they are updated there and synced to Zones.
+		return nil
+	}
+	return rt.Add(
+		NewController(rt.ResourceManager(), 10*time.Second, NewPrometheusMetricsProviderFactory(5*time.Second)),
+	)
+}
@@ -0,0 +1,261 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var (
+	log = core.Log.WithName("canary-controller")
+)
+
+// TrafficObservedCondition reports whether requests to the canary destination were observed by the analysis.
+const TrafficObservedCondition = "TrafficObserved"
+
+var errNoTraffic = errors.New("no requests to the canary destination were observed")
+
+// controller steps the weights of TrafficRoutes with canary configuration and rolls them back
+// when the canary destination breaches the analysis thresholds.
+type controller struct {
+	rm                 manager.ResourceManager
+	polling            time.Duration
+	newMetricsProvider MetricsProviderFactory
+}
+
+func NewController(rm manager.ResourceManager, polling time.Duration, newMetricsProvider MetricsProviderFactory) component.Component {
+	return &controller{
+		rm:                 rm,
+		polling:            polling,
+		newMetricsProvider: newMetricsProvider,
+	}
+}
+
+func (c *controller) Start(stop <-chan struct{}) error {
+	// the context interrupts the reconciliation in progress when the component is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(c.polling)
+	defer ticker.Stop()
+	log.Info("started")
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.reconcileAll(ctx); err != nil {
+				log.Error(err, "unable to reconcile canaries")
+			}
+		case <-ctx.Done():
+			log.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (c *controller) reconcileAll(ctx context.Context) error {
+	routes := &core_mesh.TrafficRouteResourceList{}
+	if err := c.rm.List(ctx, routes); err != nil {
+		return err
+	}
+	insights := &core_mesh.TrafficRouteInsightResourceList{}
+	if err := c.rm.List(ctx, insights); err != nil {
+		return err
+	}
+	canaries := map[core_model.ResourceKey]bool{}
+	for _, route := range routes.Items {
+		if route.Spec.GetConf().GetCanary() == nil {
+			continue
+		}
+		canaries[core_model.MetaToResourceKey(route.Meta)] = true
+		if err := c.reconcile(ctx, route); err != nil {
+			log.Error(err, "unable to reconcile canary", "name", route.Meta.GetName(), "mesh", route.Meta.GetMesh())
+		}
+	}
+	// The insight of a TrafficRoute that was deleted or no longer has a canary is not needed anymore.
+	for _, insight := range insights.Items {
+		if canaries[core_model.MetaToResourceKey(insight.Meta)] {
+			continue
+		}
+		if err := c.rm.Delete(ctx, insight, store.DeleteBy(core_model.MetaToResourceKey(insight.Meta))); err != nil && !store.IsResourceNotFound(err) {
+			log.Error(err, "unable to delete canary insight", "name", insight.Meta.GetName(), "mesh", insight.Meta.GetMesh())
+		}
+	}
+	return nil
+}
+
+func (c *controller) reconcile(ctx context.Context, route *core_mesh.TrafficRouteResource) error {
+	canary := route.Spec.GetConf().GetCanary()
+	key := core_model.MetaToResourceKey(route.Meta)
+	insight := core_mesh.NewTrafficRouteInsightResource()
+	if err := c.rm.Get(ctx, insight, store.GetByKey(key.Name, key.Mesh)); err != nil && !store.IsResourceNotFound(err) {
+		return err
+	}
+	status := insight.Spec.GetCanary()
+	now := core.Now()
+
+	var updateInsight bool
+	switch {
+	case status == nil || !proto.Equal(status.GetConf(), canary):
+		status = stepStatus(canary, 0, "canary delivery started", now)
+		updateInsight = true
+	case status.GetPhase() != mesh_proto.TrafficRouteInsight_Canary_PROGRESSING:
+	case now.Sub(status.GetLastTransitionTime().AsTime()) < canary.GetInterval().AsDuration():
+	default:
+		breach, err := c.analyze(ctx, route)
+		if err != nil && err != errNoTraffic {
+			return err
+		}
+		if canary.GetAnalysis() != nil {
+			message := ""
+			if err != nil {
+				message = err.Error()
+			}
+			updateInsight = setCondition(status, TrafficObservedCondition, err == nil, message, now)
+		}
+		next := status.GetStep() + 1
+		switch {
+		case err != nil:
+			// Without traffic the canary can be neither promoted nor rolled back, so the step is held.
+		case breach != "":
+			status.Phase = mesh_proto.TrafficRouteInsight_Canary_ROLLED_BACK
+			status.CanaryWeight = 0
+			status.LastTransitionTime = timestamppb.New(now)
+			status.Message = breach
+		case int(next) < len(canary.GetSteps()):
+			conditions := status.GetConditions()
+			status = stepStatus(canary, next, "canary promoted to the next step", now)
+			status.Conditions = conditions
+		default:
+			status.Phase = mesh_proto.TrafficRouteInsight_Canary_SUCCEEDED
+			status.LastTransitionTime = timestamppb.New(now)
+			status.Message = "all steps were applied"
+		}
+		updateInsight = updateInsight || err == nil
+	}
+
+	// The insight is the source of truth of the delivery, so it is stored before the weights are updated.
+	if updateInsight {
+		log.Info("updating canary", "name", key.Name, "mesh", key.Mesh,
+			"phase", status.GetPhase(), "weight", status.GetCanaryWeight())
+		if err := manager.Upsert(c.rm, key, core_mesh.NewTrafficRouteInsightResource(), func(resource core_model.Resource) error {
+			resource.(*core_mesh.TrafficRouteInsightResource).Spec.Canary = status
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "could not store canary insight")
+		}
+	}
+
+	// The weights are set on every reconciliation, so that applying the TrafficRoute again
+	// doesn't lose the progress of the delivery.
+	changed, err := setCanaryWeight(route, status.GetCanaryWeight())
+	if err != nil || !changed {
+		return err
+	}
+	return c.rm.Update(ctx, route)
+}
+
+// analyze returns the reason why the canary destination is not healthy or an empty string if it is.
+func (c *controller) analyze(ctx context.Context, route *core_mesh.TrafficRouteResource) (string, error) {
+	canary := route.Spec.GetConf().GetCanary()
+	conf := canary.GetAnalysis()
+	if conf == nil {
+		return "", nil
+	}
+	provider, err := c.newMetricsProvider(conf.GetPrometheusAddress())
+	if err != nil {
+		return "", err
+	}
+	analysis, err := provider.Analyze(ctx, route.Meta.GetMesh(), canary.GetDestination(), canary.GetInterval().AsDuration())
+	if err != nil {
+		return "", errors.Wrap(err, "could not analyze canary destination")
+	}
+	if minSuccessRate := conf.GetMinSuccessRate(); minSuccessRate != nil {
+		if analysis.SuccessRate == nil {
+			return "", errNoTraffic
+		}
+		if *analysis.SuccessRate < minSuccessRate.GetValue() {
+			return fmt.Sprintf("success rate %.2f%% is below %.2f%%", *analysis.SuccessRate, minSuccessRate.GetValue()), nil
+		}
+	}
+	if maxLatency := conf.GetMaxLatency(); maxLatency != nil {
+		if analysis.Latency == nil {
+			return "", errNoTraffic
+		}
+		if *analysis.Latency > maxLatency.AsDuration() {
+			return fmt.Sprintf("latency %s is above %s", *analysis.Latency, maxLatency.AsDuration()), nil
+		}
+	}
+	return "", nil
+}
+
+func stepStatus(canary *mesh_proto.TrafficRoute_Canary, step uint32, message string, now time.Time) *mesh_proto.TrafficRouteInsight_Canary {
+	return &mesh_proto.TrafficRouteInsight_Canary{
+		Conf:               canary,
+		Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+		Step:               step,
+		CanaryWeight:       canary.GetSteps()[step],
+		LastTransitionTime: timestamppb.New(now),
+		Message:            message,
+	}
+}
+
+// setCondition sets the status of the condition of the given type and returns whether it changed.
+func setCondition(status *mesh_proto.TrafficRouteInsight_Canary, conditionType string, value bool, message string, now time.Time) bool {
+	for _, condition := range status.Conditions {
+		if condition.GetType() != conditionType {
+			continue
+		}
+		if condition.GetStatus() == value && condition.GetMessage() == message {
+			return false
+		}
+		if condition.GetStatus() != value {
+			condition.LastTransitionTime = timestamppb.New(now)
+		}
+		condition.Status = value
+		condition.Message = message
+		return true
+	}
+	status.Conditions = append(status.Conditions, &mesh_proto.TrafficRouteInsight_Canary_Condition{
+		Type:               conditionType,
+		Status:             value,
+		Message:            message,
+		LastTransitionTime: timestamppb.New(now),
+	})
+	return true
+}
+
+// setCanaryWeight sets the weights of the split and returns whether they changed.
+func setCanaryWeight(route *core_mesh.TrafficRouteResource, weight uint32) (bool, error) {
+	canary, primary, ok := route.Spec.GetConf().GetCanarySplits()
+	if !ok {
+		return false, errors.New(`"split" does not consist of the canary destination and one other destination`)
+	}
+	if canary.GetWeight().GetValue() == weight && primary.GetWeight().GetValue() == 100-weight {
+		return false, nil
+	}
+	canary.Weight = util_proto.UInt32(weight)
+	primary.Weight = util_proto.UInt32(100 - weight)
+	return true, nil
+}
+
+func (c *controller) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,391 @@
+package canary_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/canary"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type staticMetricsProvider struct {
+	analysis canary.Analysis
+}
+
+func (s *staticMetricsProvider) Analyze(context.Context, string, map[string]string, time.Duration) (canary.Analysis, error) {
+	return s.analysis, nil
+}
+
+// blockingMetricsProvider blocks the analysis until the context is canceled.
+type blockingMetricsProvider struct {
+	started chan struct{}
+}
+
+func (b *blockingMetricsProvider) Analyze(ctx context.Context, _ string, _ map[string]string, _ time.Duration) (canary.Analysis, error) {
+	close(b.started)
+	<-ctx.Done()
+	return canary.Analysis{}, ctx.Err()
+}
+
+var _ = Describe("Canary Controller", func() {
+	var rm manager.ResourceManager
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	successRate := func(value float64) *float64 {
+		return &value
+	}
+
+	canaryConf := func() *mesh_proto.TrafficRoute_Canary {
+		return &mesh_proto.TrafficRoute_Canary{
+			Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v2"},
+			Steps:       []uint32{10, 50, 100},
+			Interval:    durationpb.New(time.Hour),
+			Analysis: &mesh_proto.TrafficRoute_Canary_Analysis{
+				PrometheusAddress: "http://prometheus:9090",
+				MinSuccessRate:    util_proto.Double(99),
+				MaxLatency:        durationpb.New(500 * time.Millisecond),
+			},
+		}
+	}
+
+	type testCase struct {
+		status          *mesh_proto.TrafficRouteInsight_Canary
+		routeWeight     *uint32
+		analysis        canary.Analysis
+		expectedPhase   mesh_proto.TrafficRouteInsight_Canary_Phase
+		expectedStep    uint32
+		expectedWeights []uint32
+		// expectedConditions maps types of the expected conditions to their status.
+		expectedConditions map[string]bool
+	}
+
+	DescribeTable("should reconcile canary",
+		func(given testCase) {
+			// given
+			canaryWeight := given.status.GetCanaryWeight()
+			if given.routeWeight != nil {
+				canaryWeight = *given.routeWeight
+			}
+			route := core_mesh.NewTrafficRouteResource()
+			route.Spec = &mesh_proto.TrafficRoute{
+				Sources: []*mesh_proto.Selector{{
+					Match: mesh_proto.MatchAnyService(),
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: mesh_proto.MatchService("backend"),
+				}},
+				Conf: &mesh_proto.TrafficRoute_Conf{
+					Split: []*mesh_proto.TrafficRoute_Split{
+						{
+							Weight:      util_proto.UInt32(100 - canaryWeight),
+							Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v1"},
+						},
+						{
+							Weight:      util_proto.UInt32(canaryWeight),
+							Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v2"},
+						},
+					},
+					Canary: canaryConf(),
+				},
+			}
+			err := rm.Create(context.Background(), route, store.CreateByKey("route", core_model.DefaultMesh))
+			Expect(err).ToNot(HaveOccurred())
+			if given.status != nil {
+				if given.status.Conf == nil {
+					given.status.Conf = canaryConf()
+				}
+				insight := core_mesh.NewTrafficRouteInsightResource()
+				insight.Spec.Canary = given.status
+				err := rm.Create(context.Background(), insight, store.CreateByKey("route", core_model.DefaultMesh))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			controller := canary.NewController(rm, 50*time.Millisecond, func(string) (canary.MetricsProvider, error) {
+				return &staticMetricsProvider{analysis: given.analysis}, nil
+			})
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				_ = controller.Start(stop)
+			}()
+
+			// then
+			Eventually(func(g Gomega) {
+				insight := core_mesh.NewTrafficRouteInsightResource()
+				g.Expect(rm.Get(context.Background(), insight, store.GetByKey("route", core_model.DefaultMesh))).To(Succeed())
+				status := insight.Spec.GetCanary()
+				g.Expect(status).ToNot(BeNil())
+				g.Expect(status.GetPhase()).To(Equal(given.expectedPhase))
+				g.Expect(status.GetStep()).To(Equal(given.expectedStep))
+				g.Expect(status.GetCanaryWeight()).To(Equal(given.expectedWeights[1]))
+				conditions := map[string]bool{}
+				for _, condition := range status.GetConditions() {
+					conditions[condition.GetType()] = condition.GetStatus()
+				}
+				if given.expectedConditions == nil {
+					g.Expect(conditions).To(BeEmpty())
+				} else {
+					g.Expect(conditions).To(Equal(given.expectedConditions))
+				}
+				actual := core_mesh.NewTrafficRouteResource()
+				g.Expect(rm.Get(context.Background(), actual, store.GetByKey("route", core_model.DefaultMesh))).To(Succeed())
+				var weights []uint32
+				for _, split := range actual.Spec.GetConf().GetSplit() {
+					weights = append(weights, split.GetWeight().GetValue())
+				}
+				g.Expect(weights).To(Equal(given.expectedWeights))
+			}).Should(Succeed())
+		},
+		Entry("should start delivery with the first step", testCase{
+			status:          nil,
+			expectedPhase:   mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:    0,
+			expectedWeights: []uint32{90, 10},
+		}),
+		Entry("should restart delivery when the canary configuration changes", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Conf: &mesh_proto.TrafficRoute_Canary{
+					Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v2"},
+					Steps:       []uint32{20, 100},
+					Interval:    durationpb.New(time.Hour),
+				},
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_SUCCEEDED,
+				Step:               1,
+				CanaryWeight:       100,
+				LastTransitionTime: timestamppb.New(time.Now()),
+			},
+			expectedPhase:   mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:    0,
+			expectedWeights: []uint32{90, 10},
+		}),
+		Entry("should keep the progress when the route is applied again", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               1,
+				CanaryWeight:       50,
+				LastTransitionTime: timestamppb.New(time.Now()),
+			},
+			routeWeight:     uint32Ptr(0),
+			expectedPhase:   mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:    1,
+			expectedWeights: []uint32{50, 50},
+		}),
+		Entry("should promote healthy canary to the next step", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               0,
+				CanaryWeight:       10,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(99.9),
+				Latency:     durationPtr(100 * time.Millisecond),
+			},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:       1,
+			expectedWeights:    []uint32{50, 50},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: true},
+		}),
+		Entry("should hold canary without traffic", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               0,
+				CanaryWeight:       10,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+			},
+			analysis:           canary.Analysis{},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:       0,
+			expectedWeights:    []uint32{90, 10},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: false},
+		}),
+		Entry("should promote canary once the traffic is observed", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               0,
+				CanaryWeight:       10,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+				Conditions: []*mesh_proto.TrafficRouteInsight_Canary_Condition{{
+					Type:               canary.TrafficObservedCondition,
+					Status:             false,
+					LastTransitionTime: timestamppb.New(time.Now().Add(-time.Hour)),
+				}},
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(99.9),
+				Latency:     durationPtr(100 * time.Millisecond),
+			},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:       1,
+			expectedWeights:    []uint32{50, 50},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: true},
+		}),
+		Entry("should not promote canary before the interval passes", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               0,
+				CanaryWeight:       10,
+				LastTransitionTime: timestamppb.New(time.Now()),
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(99.9),
+				Latency:     durationPtr(100 * time.Millisecond),
+			},
+			expectedPhase:   mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			expectedStep:    0,
+			expectedWeights: []uint32{90, 10},
+		}),
+		Entry("should roll back canary with too low success rate", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               1,
+				CanaryWeight:       50,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(95),
+				Latency:     durationPtr(100 * time.Millisecond),
+			},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_ROLLED_BACK,
+			expectedStep:       1,
+			expectedWeights:    []uint32{100, 0},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: true},
+		}),
+		Entry("should roll back canary with too high latency", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               1,
+				CanaryWeight:       50,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(100),
+				Latency:     durationPtr(time.Second),
+			},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_ROLLED_BACK,
+			expectedStep:       1,
+			expectedWeights:    []uint32{100, 0},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: true},
+		}),
+		Entry("should finish delivery after the last step", testCase{
+			status: &mesh_proto.TrafficRouteInsight_Canary{
+				Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+				Step:               2,
+				CanaryWeight:       100,
+				LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+			},
+			analysis: canary.Analysis{
+				SuccessRate: successRate(100),
+				Latency:     durationPtr(100 * time.Millisecond),
+			},
+			expectedPhase:      mesh_proto.TrafficRouteInsight_Canary_SUCCEEDED,
+			expectedStep:       2,
+			expectedWeights:    []uint32{0, 100},
+			expectedConditions: map[string]bool{canary.TrafficObservedCondition: true},
+		}),
+	)
+
+	It("should interrupt the analysis in progress when stopped", func() {
+		// given
+		route := core_mesh.NewTrafficRouteResource()
+		route.Spec = &mesh_proto.TrafficRoute{
+			Sources: []*mesh_proto.Selector{{
+				Match: mesh_proto.MatchAnyService(),
+			}},
+			Destinations: []*mesh_proto.Selector{{
+				Match: mesh_proto.MatchService("backend"),
+			}},
+			Conf: &mesh_proto.TrafficRoute_Conf{
+				Split: []*mesh_proto.TrafficRoute_Split{
+					{
+						Weight:      util_proto.UInt32(90),
+						Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v1"},
+					},
+					{
+						Weight:      util_proto.UInt32(10),
+						Destination: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v2"},
+					},
+				},
+				Canary: canaryConf(),
+			},
+		}
+		err := rm.Create(context.Background(), route, store.CreateByKey("route", core_model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+		insight := core_mesh.NewTrafficRouteInsightResource()
+		insight.Spec.Canary = &mesh_proto.TrafficRouteInsight_Canary{
+			Conf:               canaryConf(),
+			Phase:              mesh_proto.TrafficRouteInsight_Canary_PROGRESSING,
+			CanaryWeight:       10,
+			LastTransitionTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+		}
+		err = rm.Create(context.Background(), insight, store.CreateByKey("route", core_model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		provider := &blockingMetricsProvider{started: make(chan struct{})}
+		controller := canary.NewController(rm, 50*time.Millisecond, func(string) (canary.MetricsProvider, error) {
+			return provider, nil
+		})
+		stop := make(chan struct{})
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			_ = controller.Start(stop)
+		}()
+		Eventually(provider.started).Should(BeClosed())
+
+		// when
+		close(stop)
+
+		// then
+		Eventually(stopped).Should(BeClosed())
+	})
+
+	It("should delete the insight of a route without a canary", func() {
+		// given
+		insight := core_mesh.NewTrafficRouteInsightResource()
+		insight.Spec.Canary = &mesh_proto.TrafficRouteInsight_Canary{
+			Phase: mesh_proto.TrafficRouteInsight_Canary_SUCCEEDED,
+		}
+		err := rm.Create(context.Background(), insight, store.CreateByKey("deleted-route", core_model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		controller := canary.NewController(rm, 50*time.Millisecond, nil)
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			_ = controller.Start(stop)
+		}()
+
+		// then
+		Eventually(func() bool {
+			err := rm.Get(context.Background(), core_mesh.NewTrafficRouteInsightResource(), store.GetByKey("deleted-route", core_model.DefaultMesh))
+			return store.IsResourceNotFound(err)
+		}).Should(BeTrue())
+	})
+})
+
+func uint32Ptr(v uint32) *uint32 {
+	return &v
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
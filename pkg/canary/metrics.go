@@ -0,0 +1,118 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	prom_api "github.com/prometheus/client_golang/api"
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prom_model "github.com/prometheus/common/model"
+	prom_util "github.com/prometheus/prometheus/util/strutil"
+)
+
+// Analysis is the state of the canary destination measured over the interval between steps.
+type Analysis struct {
+	// SuccessRate is a percentage of non 5xx responses. It is nil when there were no requests.
+	SuccessRate *float64
+	// Latency is the 99th percentile of the request duration. It is nil when there were no requests.
+	Latency *time.Duration
+}
+
+type MetricsProvider interface {
+	Analyze(ctx context.Context, mesh string, destination map[string]string, window time.Duration) (Analysis, error)
+}
+
+type MetricsProviderFactory func(address string) (MetricsProvider, error)
+
+const (
+	// Requests handled by the data plane proxies of the destination are reported by the clusters of their inbounds.
+	inboundClusters = `envoy_cluster_name=~"localhost_.*"`
+
+	successRateQuery = `sum(rate(envoy_cluster_upstream_rq_xx{%s,envoy_response_code_class!="5"}[%s])) / sum(rate(envoy_cluster_upstream_rq_xx{%s}[%s])) * 100`
+	latencyQuery     = `histogram_quantile(0.99, sum(rate(envoy_cluster_upstream_rq_time_bucket{%s}[%s])) by (le))`
+)
+
+type prometheusMetricsProvider struct {
+	api          prom_v1.API
+	queryTimeout time.Duration
+}
+
+var _ MetricsProvider = &prometheusMetricsProvider{}
+
+// NewPrometheusMetricsProviderFactory returns a factory of MetricsProviders that query Prometheus which scrapes
+// the data plane proxies using labels of the Kuma service discovery. Every query is cancelled after queryTimeout,
+// so an unresponsive Prometheus doesn't block the reconciliation of canaries.
+func NewPrometheusMetricsProviderFactory(queryTimeout time.Duration) MetricsProviderFactory {
+	return func(address string) (MetricsProvider, error) {
+		client, err := prom_api.NewClient(prom_api.Config{Address: address})
+		if err != nil {
+			return nil, err
+		}
+		return &prometheusMetricsProvider{
+			api:          prom_v1.NewAPI(client),
+			queryTimeout: queryTimeout,
+		}, nil
+	}
+}
+
+func (p *prometheusMetricsProvider) Analyze(ctx context.Context, mesh string, destination map[string]string, window time.Duration) (Analysis, error) {
+	selector := labelSelector(mesh, destination)
+	rangeWindow := prom_model.Duration(window).String()
+	analysis := Analysis{}
+
+	successRate, err := p.query(ctx, fmt.Sprintf(successRateQuery, selector, rangeWindow, selector, rangeWindow))
+	if err != nil {
+		return Analysis{}, err
+	}
+	analysis.SuccessRate = successRate
+
+	latencyMs, err := p.query(ctx, fmt.Sprintf(latencyQuery, selector, rangeWindow))
+	if err != nil {
+		return Analysis{}, err
+	}
+	if latencyMs != nil {
+		latency := time.Duration(*latencyMs * float64(time.Millisecond))
+		analysis.Latency = &latency
+	}
+	return analysis, nil
+}
+
+// query returns the value of a query that results in a single sample or nil if there is no value.
+func (p *prometheusMetricsProvider) query(ctx context.Context, query string) (*float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.queryTimeout)
+	defer cancel()
+	result, _, err := p.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not execute query %q", query)
+	}
+	vector, ok := result.(prom_model.Vector)
+	if !ok {
+		return nil, errors.Errorf("query %q returned %s instead of a vector", query, result.Type())
+	}
+	if len(vector) == 0 {
+		return nil, nil
+	}
+	value := float64(vector[0].Value)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+func labelSelector(mesh string, destination map[string]string) string {
+	labels := []string{inboundClusters, fmt.Sprintf("mesh=%q", mesh)}
+	var keys []string
+	for key := range destination {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		labels = append(labels, fmt.Sprintf("%s=%q", prom_util.SanitizeLabelName(key), destination[key]))
+	}
+	return strings.Join(labels, ",")
+}
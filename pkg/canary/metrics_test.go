@@ -0,0 +1,107 @@
+package canary_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/canary"
+)
+
+var _ = Describe("Prometheus Metrics Provider", func() {
+	var server *httptest.Server
+	var queries []string
+	var results map[string]string
+	var delay time.Duration
+
+	BeforeEach(func() {
+		queries = nil
+		results = map[string]string{}
+		delay = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.ParseForm()).To(Succeed())
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+			query := r.Form.Get("query")
+			queries = append(queries, query)
+			result := `[]`
+			for prefix, value := range results {
+				if strings.HasPrefix(query, prefix) {
+					result = fmt.Sprintf(`[{"metric":{},"value":[1650000000,"%s"]}]`, value)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, err := fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":%s}}`, result)
+			Expect(err).ToNot(HaveOccurred())
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should query success rate and latency of the destination", func() {
+		// given
+		results["sum("] = "99.5"
+		results["histogram_quantile("] = "250"
+		provider, err := canary.NewPrometheusMetricsProviderFactory(time.Second)(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		analysis, err := provider.Analyze(context.Background(), "default", map[string]string{
+			"kuma.io/service": "backend",
+			"version":         "v2",
+		}, time.Minute)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*analysis.SuccessRate).To(Equal(99.5))
+		Expect(*analysis.Latency).To(Equal(250 * time.Millisecond))
+		Expect(queries).To(Equal([]string{
+			`sum(rate(envoy_cluster_upstream_rq_xx{envoy_cluster_name=~"localhost_.*",mesh="default",kuma_io_service="backend",version="v2",envoy_response_code_class!="5"}[1m])) / sum(rate(envoy_cluster_upstream_rq_xx{envoy_cluster_name=~"localhost_.*",mesh="default",kuma_io_service="backend",version="v2"}[1m])) * 100`,
+			`histogram_quantile(0.99, sum(rate(envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name=~"localhost_.*",mesh="default",kuma_io_service="backend",version="v2"}[1m])) by (le))`,
+		}))
+	})
+
+	It("should return empty analysis when there were no requests", func() {
+		// given
+		results["sum("] = "NaN"
+		provider, err := canary.NewPrometheusMetricsProviderFactory(time.Second)(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		analysis, err := provider.Analyze(context.Background(), "default", map[string]string{
+			"kuma.io/service": "backend",
+		}, time.Minute)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(analysis.SuccessRate).To(BeNil())
+		Expect(analysis.Latency).To(BeNil())
+	})
+
+	It("should time out when Prometheus does not respond", func() {
+		// given
+		delay = time.Minute
+		provider, err := canary.NewPrometheusMetricsProviderFactory(50 * time.Millisecond)(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = provider.Analyze(context.Background(), "default", map[string]string{
+			"kuma.io/service": "backend",
+		}, time.Minute)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("context deadline exceeded")))
+	})
+})
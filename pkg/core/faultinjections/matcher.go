@@ -53,6 +53,33 @@ func BuildFaultInjectionMap(
 	return result
 }
 
+// BuildTcpFaultInjectionMap selects the most specific FaultInjection with TCP faults
+// for each outbound of the dataplane. TCP faults are injected on the source side,
+// because the destination side cannot tell the source of a TCP connection.
+func BuildTcpFaultInjectionMap(
+	dataplane *core_mesh.DataplaneResource,
+	faultInjections []*core_mesh.FaultInjectionResource,
+) core_xds.TcpFaultInjectionMap {
+	var policies []policy.ConnectionPolicy
+	for _, faultInjection := range faultInjections {
+		if faultInjection.HasFaultTcp() {
+			policies = append(policies, faultInjection)
+		}
+	}
+
+	outboundMap := policy.SelectOutboundConnectionPolicies(dataplane, policies)
+
+	result := core_xds.TcpFaultInjectionMap{}
+	for _, outbound := range dataplane.Spec.GetNetworking().GetOutbound() {
+		serviceName := outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]
+		if connectionPolicy, exists := outboundMap[serviceName]; exists {
+			oface := dataplane.Spec.GetNetworking().ToOutboundInterface(outbound)
+			result[oface] = connectionPolicy.(*core_mesh.FaultInjectionResource)
+		}
+	}
+	return result
+}
+
 // BuildExternalServiceFaultInjectionMapForZoneEgress creates mapping between Service's name and the list of FaultInjections.
 // todo(lobkovilya): that's not really correct way to build a policy map for External Services. Policies such as
 // Fault Injections, Rate Limit and Traffic Permissions support arbitrary tags in Destination, but we lose this
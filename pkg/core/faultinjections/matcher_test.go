@@ -270,3 +270,81 @@ var _ = Describe("Match", func() {
 		}),
 	)
 })
+
+var _ = Describe("BuildTcpFaultInjectionMap", func() {
+	It("should select the most specific policy with TCP faults for each outbound", func() {
+		// given
+		dataplane := &mesh.DataplaneResource{
+			Meta: &model.ResourceMeta{
+				Mesh: "default",
+				Name: "dp1",
+			},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "web",
+							},
+						},
+					},
+					Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+						{
+							Port: 5432,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "postgres",
+							},
+						},
+						{
+							Port: 9092,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "kafka",
+							},
+						},
+					},
+				},
+			},
+		}
+		policy := func(name string, destination string, conf *mesh_proto.FaultInjection_Conf) *mesh.FaultInjectionResource {
+			return &mesh.FaultInjectionResource{
+				Meta: &model.ResourceMeta{
+					Name: name,
+				},
+				Spec: &mesh_proto.FaultInjection{
+					Sources: []*mesh_proto.Selector{{
+						Match: map[string]string{mesh_proto.ServiceTag: "web"},
+					}},
+					Destinations: []*mesh_proto.Selector{{
+						Match: map[string]string{mesh_proto.ServiceTag: destination},
+					}},
+					Conf: conf,
+				},
+			}
+		}
+		tcpAbort := &mesh_proto.FaultInjection_Conf{
+			Tcp: &mesh_proto.FaultInjection_Conf_Tcp{
+				Abort: &mesh_proto.FaultInjection_Conf_Tcp_ConnectionAbort{
+					Percentage: util_proto.Double(10),
+				},
+			},
+		}
+		httpDelay := &mesh_proto.FaultInjection_Conf{
+			Delay: &mesh_proto.FaultInjection_Conf_Delay{
+				Percentage: util_proto.Double(50),
+				Value:      util_proto.Duration(time.Second),
+			},
+		}
+		wildcard := policy("fi-wildcard", "*", tcpAbort)
+		http := policy("fi-http", "postgres", httpDelay)
+
+		// when
+		result := BuildTcpFaultInjectionMap(dataplane, []*mesh.FaultInjectionResource{wildcard, http})
+
+		// then the HTTP only policy does not shadow the wildcard one
+		Expect(result).To(HaveLen(2))
+		Expect(result[mesh_proto.OutboundInterface{DataplaneIP: "127.0.0.1", DataplanePort: 5432}]).To(Equal(wildcard))
+		Expect(result[mesh_proto.OutboundInterface{DataplaneIP: "127.0.0.1", DataplanePort: 9092}]).To(Equal(wildcard))
+	})
+})
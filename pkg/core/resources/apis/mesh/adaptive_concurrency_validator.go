@@ -0,0 +1,102 @@
+package mesh
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (a *AdaptiveConcurrencyResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(a.validateSources())
+	err.Add(a.validateDestinations())
+	err.Add(a.validateConf())
+
+	return err.OrNil()
+}
+
+func (a *AdaptiveConcurrencyResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), a.Spec.GetSources(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (a *AdaptiveConcurrencyResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), a.Spec.GetDestinations(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+			ExtraTagsValidators: []TagsValidatorFunc{
+				ProtocolValidator(ProtocolHTTP, ProtocolHTTP2, ProtocolGRPC),
+			},
+		},
+	})
+}
+
+func (a *AdaptiveConcurrencyResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	conf := a.Spec.GetConf()
+
+	if conf == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+
+	err.Add(validateGradientController(path.Field("gradientController"), conf.GetGradientController()))
+
+	return
+}
+
+func validateGradientController(
+	path validators.PathBuilder,
+	gradientController *mesh_proto.AdaptiveConcurrency_Conf_GradientController,
+) (err validators.ValidationError) {
+	if gradientController == nil {
+		return
+	}
+
+	err.Add(validatePercentOrNil(path.Field("sampleAggregatePercentile"), gradientController.GetSampleAggregatePercentile()))
+
+	if concurrencyLimit := gradientController.GetConcurrencyLimit(); concurrencyLimit != nil {
+		concurrencyLimitPath := path.Field("concurrencyLimit")
+		if concurrencyLimit.GetMax() != nil {
+			err.Add(ValidateThreshold(concurrencyLimitPath.Field("max"), concurrencyLimit.GetMax().GetValue()))
+		}
+		if concurrencyLimit.GetUpdateInterval() != nil {
+			err.Add(ValidateDuration(concurrencyLimitPath.Field("updateInterval"), concurrencyLimit.GetUpdateInterval()))
+		}
+	}
+
+	if minRtt := gradientController.GetMinRtt(); minRtt != nil {
+		minRttPath := path.Field("minRtt")
+		if minRtt.GetInterval() != nil {
+			err.Add(ValidateDuration(minRttPath.Field("interval"), minRtt.GetInterval()))
+		}
+		if minRtt.GetRequestCount() != nil {
+			err.Add(ValidateThreshold(minRttPath.Field("requestCount"), minRtt.GetRequestCount().GetValue()))
+		}
+		if minRtt.GetMinConcurrency() != nil {
+			err.Add(ValidateThreshold(minRttPath.Field("minConcurrency"), minRtt.GetMinConcurrency().GetValue()))
+		}
+		err.Add(validatePercentOrNil(minRttPath.Field("jitter"), minRtt.GetJitter()))
+		err.Add(validatePercentOrNil(minRttPath.Field("buffer"), minRtt.GetBuffer()))
+	}
+
+	return
+}
+
+func validatePercentOrNil(path validators.PathBuilder, percent *wrapperspb.DoubleValue) (err validators.ValidationError) {
+	if percent == nil {
+		return
+	}
+
+	if percent.GetValue() < 0.0 || percent.GetValue() > 100.0 {
+		err.AddViolationAt(path, "has to be in [0.0 - 100.0] range")
+	}
+	return
+}
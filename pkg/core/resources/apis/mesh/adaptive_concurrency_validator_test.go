@@ -0,0 +1,137 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("AdaptiveConcurrency", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(adaptiveConcurrencyYAML string) {
+				// setup
+				adaptiveConcurrency := NewAdaptiveConcurrencyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(adaptiveConcurrencyYAML), adaptiveConcurrency.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := adaptiveConcurrency.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full policy", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: http
+                conf:
+                  gradientController:
+                    sampleAggregatePercentile: 90
+                    concurrencyLimit:
+                      max: 500
+                      updateInterval: 200ms
+                    minRtt:
+                      interval: 30s
+                      requestCount: 100
+                      jitter: 10
+                      minConcurrency: 5
+                      buffer: 20`),
+			Entry("empty conf", `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: grpc
+                conf: {}`),
+		)
+
+		type testCase struct {
+			adaptiveConcurrency string
+			expected            string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				adaptiveConcurrency := NewAdaptiveConcurrencyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.adaptiveConcurrency), adaptiveConcurrency.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := adaptiveConcurrency.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				adaptiveConcurrency: ``,
+				expected: `
+               violations:
+               - field: sources
+                 message: must have at least one element
+               - field: destinations
+                 message: must have at least one element
+               - field: conf
+                 message: has to be defined`}),
+			Entry("invalid protocol and conf", testCase{
+				adaptiveConcurrency: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: tcp
+                conf:
+                  gradientController:
+                    sampleAggregatePercentile: 101
+                    concurrencyLimit:
+                      max: 0
+                      updateInterval: 0s
+                    minRtt:
+                      interval: 0s
+                      requestCount: 0
+                      jitter: -1
+                      minConcurrency: 0
+                      buffer: 200`,
+				expected: `
+               violations:
+               - field: destinations[0].match["kuma.io/protocol"]
+                 message: must be one of the [http, http2, grpc]
+               - field: conf.gradientController.sampleAggregatePercentile
+                 message: has to be in [0.0 - 100.0] range
+               - field: conf.gradientController.concurrencyLimit.max
+                 message: must have a positive value
+               - field: conf.gradientController.concurrencyLimit.updateInterval
+                 message: must have a positive value
+               - field: conf.gradientController.minRtt.interval
+                 message: must have a positive value
+               - field: conf.gradientController.minRtt.requestCount
+                 message: must have a positive value
+               - field: conf.gradientController.minRtt.minConcurrency
+                 message: must have a positive value
+               - field: conf.gradientController.minRtt.jitter
+                 message: has to be in [0.0 - 100.0] range
+               - field: conf.gradientController.minRtt.buffer
+                 message: has to be in [0.0 - 100.0] range`}),
+		)
+	})
+})
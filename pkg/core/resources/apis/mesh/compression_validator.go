@@ -0,0 +1,64 @@
+package mesh
+
+import (
+	"fmt"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (c *CompressionResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(c.validateSources())
+	err.Add(c.validateDestinations())
+	err.Add(c.validateConf())
+
+	return err.OrNil()
+}
+
+func (c *CompressionResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), c.Spec.GetSources(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (c *CompressionResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), c.Spec.GetDestinations(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+			ExtraTagsValidators: []TagsValidatorFunc{
+				ProtocolValidator(ProtocolHTTP, ProtocolHTTP2, ProtocolGRPC),
+			},
+		},
+	})
+}
+
+func (c *CompressionResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	conf := c.Spec.GetConf()
+
+	if conf == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+
+	for i, contentType := range conf.GetContentTypes() {
+		if contentType == "" {
+			err.AddViolationAt(path.Field("contentTypes").Index(i), "cannot be empty")
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, algorithm := range conf.GetAlgorithms() {
+		if seen[algorithm.String()] {
+			err.AddViolationAt(path.Field("algorithms").Index(i), fmt.Sprintf("%q is already defined", algorithm))
+		}
+		seen[algorithm.String()] = true
+	}
+
+	return
+}
@@ -0,0 +1,118 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Compression", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(compressionYAML string) {
+				// setup
+				compression := NewCompressionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(compressionYAML), compression.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := compression.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full policy", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: http
+                conf:
+                  minContentLength: 100
+                  contentTypes:
+                  - application/json
+                  - text/html
+                  algorithms:
+                  - brotli
+                  - gzip
+                  disableOnEtagHeader: true`),
+			Entry("empty conf", `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: http2
+                conf: {}`),
+		)
+
+		type testCase struct {
+			compression string
+			expected    string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				compression := NewCompressionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.compression), compression.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := compression.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				compression: ``,
+				expected: `
+               violations:
+               - field: sources
+                 message: must have at least one element
+               - field: destinations
+                 message: must have at least one element
+               - field: conf
+                 message: has to be defined`}),
+			Entry("invalid protocol and conf", testCase{
+				compression: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: tcp
+                conf:
+                  contentTypes:
+                  - application/json
+                  - ""
+                  algorithms:
+                  - gzip
+                  - brotli
+                  - gzip`,
+				expected: `
+               violations:
+               - field: destinations[0].match["kuma.io/protocol"]
+                 message: must be one of the [http, http2, grpc]
+               - field: conf.contentTypes[1]
+                 message: cannot be empty
+               - field: conf.algorithms[2]
+                 message: '"gzip" is already defined'`}),
+		)
+	})
+})
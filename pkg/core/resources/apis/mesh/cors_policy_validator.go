@@ -0,0 +1,104 @@
+package mesh
+
+import (
+	"fmt"
+	"regexp"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (c *CorsPolicyResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(c.validateSources())
+	err.Add(c.validateDestinations())
+	err.Add(c.validateConf())
+
+	return err.OrNil()
+}
+
+func (c *CorsPolicyResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), c.Spec.GetSources(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (c *CorsPolicyResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), c.Spec.GetDestinations(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+			ExtraTagsValidators: []TagsValidatorFunc{
+				ProtocolValidator(ProtocolHTTP, ProtocolHTTP2, ProtocolGRPC),
+			},
+		},
+	})
+}
+
+func (c *CorsPolicyResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	conf := c.Spec.GetConf()
+
+	if conf == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+
+	if len(conf.GetAllowOrigins()) == 0 {
+		err.AddViolationAt(path.Field("allowOrigins"), "must have at least one element")
+	}
+	for i, origin := range conf.GetAllowOrigins() {
+		err.Add(validateCorsOrigin(path.Field("allowOrigins").Index(i), origin))
+	}
+
+	seen := map[mesh_proto.HttpMethod]bool{}
+	for i, method := range conf.GetAllowMethods() {
+		switch {
+		case method == mesh_proto.HttpMethod_NONE:
+			err.AddViolationAt(path.Field("allowMethods").Index(i), EmptyFieldViolation)
+		case seen[method]:
+			err.AddViolationAt(path.Field("allowMethods").Index(i), fmt.Sprintf("%q is already defined", method))
+		}
+		seen[method] = true
+	}
+
+	for i, header := range conf.GetAllowHeaders() {
+		if header == "" {
+			err.AddViolationAt(path.Field("allowHeaders").Index(i), "cannot be empty")
+		}
+	}
+
+	for i, header := range conf.GetExposeHeaders() {
+		if header == "" {
+			err.AddViolationAt(path.Field("exposeHeaders").Index(i), "cannot be empty")
+		}
+	}
+
+	if maxAge := conf.GetMaxAge(); maxAge != nil && maxAge.AsDuration() < 0 {
+		err.AddViolationAt(path.Field("maxAge"), "cannot be negative")
+	}
+
+	return
+}
+
+func validateCorsOrigin(path validators.PathBuilder, origin *mesh_proto.CorsPolicy_Conf_Origin) (err validators.ValidationError) {
+	switch origin.GetMatcherType().(type) {
+	case *mesh_proto.CorsPolicy_Conf_Origin_Exact:
+		if origin.GetExact() == "" {
+			err.AddViolationAt(path.Field("exact"), "cannot be empty")
+		}
+	case *mesh_proto.CorsPolicy_Conf_Origin_Regex:
+		if origin.GetRegex() == "" {
+			err.AddViolationAt(path.Field("regex"), "cannot be empty")
+		} else if _, e := regexp.Compile(origin.GetRegex()); e != nil {
+			err.AddViolationAt(path.Field("regex"), fmt.Sprintf("invalid regex: %s", e))
+		}
+	default:
+		err.AddViolationAt(path, `cannot be empty. Available options: "exact" or "regex"`)
+	}
+	return
+}
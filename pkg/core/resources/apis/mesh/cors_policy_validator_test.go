@@ -0,0 +1,156 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("CorsPolicy", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(corsYAML string) {
+				// setup
+				cors := NewCorsPolicyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(corsYAML), cors.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := cors.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full policy", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: http
+                conf:
+                  allowOrigins:
+                  - exact: https://example.com
+                  - regex: https://.*\.example\.com
+                  allowMethods:
+                  - GET
+                  - POST
+                  allowHeaders:
+                  - x-custom-header
+                  exposeHeaders:
+                  - x-request-id
+                  maxAge: 1h
+                  allowCredentials: true`),
+			Entry("only origins", `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: grpc
+                conf:
+                  allowOrigins:
+                  - exact: '*'`),
+		)
+
+		type testCase struct {
+			cors     string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				cors := NewCorsPolicyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.cors), cors.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := cors.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				cors: ``,
+				expected: `
+               violations:
+               - field: sources
+                 message: must have at least one element
+               - field: destinations
+                 message: must have at least one element
+               - field: conf
+                 message: has to be defined`}),
+			Entry("empty conf", testCase{
+				cors: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: http
+                conf: {}`,
+				expected: `
+               violations:
+               - field: conf.allowOrigins
+                 message: must have at least one element`}),
+			Entry("invalid protocol and conf", testCase{
+				cors: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   kuma.io/protocol: tcp
+                conf:
+                  allowOrigins:
+                  - exact: ""
+                  - regex: "("
+                  - {}
+                  allowMethods:
+                  - NONE
+                  - GET
+                  - GET
+                  allowHeaders:
+                  - ""
+                  exposeHeaders:
+                  - ""
+                  maxAge: -1s`,
+				expected: `
+               violations:
+               - field: destinations[0].match["kuma.io/protocol"]
+                 message: must be one of the [http, http2, grpc]
+               - field: conf.allowOrigins[0].exact
+                 message: cannot be empty
+               - field: conf.allowOrigins[1].regex
+                 message: 'invalid regex: error parsing regexp: missing closing ): ` + "`(`" + `'
+               - field: conf.allowOrigins[2]
+                 message: 'cannot be empty. Available options: "exact" or "regex"'
+               - field: conf.allowMethods[0]
+                 message: field cannot be empty
+               - field: conf.allowMethods[2]
+                 message: '"GET" is already defined'
+               - field: conf.allowHeaders[0]
+                 message: cannot be empty
+               - field: conf.exposeHeaders[0]
+                 message: cannot be empty
+               - field: conf.maxAge
+                 message: cannot be negative`}),
+		)
+	})
+})
@@ -34,6 +34,11 @@ func (f *FaultInjectionResource) HasFaultResponseBandwidth() bool {
 	return faultResponseBandwidth != nil && !proto.Equal(faultResponseBandwidth, &v1alpha1.FaultInjection_Conf_ResponseBandwidth{})
 }
 
+func (f *FaultInjectionResource) HasFaultTcp() bool {
+	faultTcp := f.Spec.Conf.GetTcp()
+	return faultTcp != nil && !proto.Equal(faultTcp, &v1alpha1.FaultInjection_Conf_Tcp{})
+}
+
 func (f *FaultInjectionResource) validateSources() validators.ValidationError {
 	return ValidateSelectors(validators.RootedAt("sources"), f.Spec.GetSources(), ValidateSelectorsOpts{
 		RequireAtLeastOneSelector: true,
@@ -44,12 +49,16 @@ func (f *FaultInjectionResource) validateSources() validators.ValidationError {
 }
 
 func (f *FaultInjectionResource) validateDestinations() validators.ValidationError {
+	protocols := []string{ProtocolHTTP, ProtocolHTTP2, ProtocolGRPC}
+	if f.HasFaultTcp() {
+		protocols = append(protocols, ProtocolTCP, ProtocolKafka)
+	}
 	return ValidateSelectors(validators.RootedAt("destinations"), f.Spec.GetDestinations(), ValidateSelectorsOpts{
 		RequireAtLeastOneSelector: true,
 		ValidateTagsOpts: ValidateTagsOpts{
 			RequireAtLeastOneTag: true,
 			ExtraTagsValidators: []TagsValidatorFunc{
-				ProtocolValidator(ProtocolHTTP, ProtocolHTTP2, ProtocolGRPC),
+				ProtocolValidator(protocols...),
 			},
 		},
 	})
@@ -57,7 +66,7 @@ func (f *FaultInjectionResource) validateDestinations() validators.ValidationErr
 
 func (f *FaultInjectionResource) validateConf() (err validators.ValidationError) {
 	root := validators.RootedAt("conf")
-	if !f.HasFaultDelay() && !f.HasFaultAbort() && !f.HasFaultResponseBandwidth() {
+	if !f.HasFaultDelay() && !f.HasFaultAbort() && !f.HasFaultResponseBandwidth() && !f.HasFaultTcp() {
 		err.AddViolationAt(root, "must have at least one of the faults configured")
 	}
 	if f.HasFaultDelay() {
@@ -69,6 +78,48 @@ func (f *FaultInjectionResource) validateConf() (err validators.ValidationError)
 	if f.HasFaultResponseBandwidth() {
 		err.Add(validateResponseBandwidth(root.Field("responseBandwidth"), f.Spec.GetConf().GetResponseBandwidth()))
 	}
+	if f.HasFaultTcp() {
+		err.Add(validateTcp(root.Field("tcp"), f.Spec.GetConf().GetTcp()))
+	}
+	if f.HasFaultTcp() && f.hasTcpDestination() {
+		// Envoy has no network filter which delays or throttles the TCP connections,
+		// so the connections of the TCP destinations can only be aborted.
+		if f.HasFaultDelay() {
+			err.AddViolationAt(root.Field("delay"), "cannot be injected into tcp and kafka connections, only tcp.abort is supported")
+		}
+		if f.HasFaultAbort() {
+			err.AddViolationAt(root.Field("abort"), "cannot be injected into tcp and kafka connections, use tcp.abort instead")
+		}
+		if f.HasFaultResponseBandwidth() {
+			err.AddViolationAt(root.Field("responseBandwidth"), "cannot be injected into tcp and kafka connections, only tcp.abort is supported")
+		}
+	}
+	for name, matcher := range f.Spec.GetConf().GetHeaders() {
+		path := root.Field("headers").Key(name)
+		if len(name) == 0 {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+		err.Add(validateStringMatcher(path, matcher))
+	}
+	return
+}
+
+func (f *FaultInjectionResource) hasTcpDestination() bool {
+	for _, destination := range f.Spec.GetDestinations() {
+		switch destination.GetMatch()[v1alpha1.ProtocolTag] {
+		case ProtocolTCP, ProtocolKafka:
+			return true
+		}
+	}
+	return false
+}
+
+func validateTcp(path validators.PathBuilder, tcp *v1alpha1.FaultInjection_Conf_Tcp) (err validators.ValidationError) {
+	if tcp.GetAbort() == nil {
+		err.AddViolationAt(path.Field("abort"), "cannot be empty")
+		return
+	}
+	err.Add(validatePercentage(path.Field("abort"), tcp.GetAbort().GetPercentage()))
 	return
 }
 
@@ -82,7 +133,23 @@ func validateDelay(path validators.PathBuilder, delay *v1alpha1.FaultInjection_C
 
 func validateAbort(path validators.PathBuilder, abort *v1alpha1.FaultInjection_Conf_Abort) (err validators.ValidationError) {
 	err.Add(validatePercentage(path, abort.GetPercentage()))
-	err.Add(validateHttpStatus(path, abort.GetHttpStatus()))
+	switch {
+	case abort.GetHttpStatus() != nil && abort.GetGrpcStatus() != nil:
+		err.AddViolationAt(path, "httpStatus and grpcStatus cannot be defined at the same time")
+	case abort.GetGrpcStatus() != nil:
+		err.Add(validateGrpcStatus(path, abort.GetGrpcStatus()))
+	default:
+		err.Add(validateHttpStatus(path, abort.GetHttpStatus()))
+	}
+	return
+}
+
+func validateGrpcStatus(path validators.PathBuilder, grpcStatus *wrapperspb.UInt32Value) (err validators.ValidationError) {
+	// gRPC status codes are defined in the [0 - 16] range, see
+	// https://github.com/grpc/grpc/blob/master/doc/statuscodes.md
+	if grpcStatus.GetValue() > 16 {
+		err.AddViolationAt(path.Field("grpcStatus"), "has to be in [0 - 16] range")
+	}
 	return
 }
 
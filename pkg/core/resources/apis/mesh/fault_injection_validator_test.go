@@ -79,6 +79,33 @@ var _ = Describe("FaultInjection", func() {
                   responseBandwidth:
                     percentage: 40
                     limit: 50kbps`),
+			Entry("grpc abort with headers", `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    percentage: 100
+                    grpcStatus: 14
+                  headers:
+                    x-chaos:
+                      exact: "true"`),
+			Entry("tcp", `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: postgres
+                    kuma.io/protocol: tcp
+                conf:
+                  tcp:
+                    abort:
+                      percentage: 25`),
 		)
 
 		type testCase struct {
@@ -261,6 +288,98 @@ var _ = Describe("FaultInjection", func() {
                violations:
                - field: destinations[0].match["kuma.io/protocol"]
                  message: must be one of the [http, http2, grpc]`}),
+			Entry("conf.abort: invalid grpc status and headers", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: grpc
+                - match:
+                    service: web
+                    kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    percentage: 100
+                    grpcStatus: 17
+                  headers:
+                    x-chaos: {}`,
+				expected: `
+               violations:
+               - field: conf.abort.grpcStatus
+                 message: has to be in [0 - 16] range
+               - field: conf.headers["x-chaos"]
+                 message: 'cannot be empty. Available options: "exact", "split" or "regex"'`}),
+			Entry("conf.abort: both http and grpc status", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    percentage: 100
+                    httpStatus: 500
+                    grpcStatus: 14`,
+				expected: `
+               violations:
+               - field: conf.abort
+                 message: httpStatus and grpcStatus cannot be defined at the same time`}),
+			Entry("conf.tcp: invalid", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: kafka
+                - match:
+                    service: web
+                    kuma.io/protocol: udp
+                conf:
+                  tcp:
+                    abort:
+                      percentage: 120`,
+				expected: `
+               violations:
+               - field: destinations[1].match["kuma.io/protocol"]
+                 message: must be one of the [http, http2, grpc, tcp, kafka]
+               - field: conf.tcp.abort.percentage
+                 message: has to be in [0.0 - 100.0] range`}),
+			Entry("conf.tcp: http faults for tcp destinations", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: http
+                - match:
+                    service: postgres
+                    kuma.io/protocol: tcp
+                conf:
+                  delay:
+                    percentage: 50
+                    value: 5s
+                  responseBandwidth:
+                    percentage: 50
+                    limit: 50kbps
+                  tcp:
+                    abort:
+                      percentage: 25`,
+				expected: `
+               violations:
+               - field: conf.delay
+                 message: cannot be injected into tcp and kafka connections, only tcp.abort is supported
+               - field: conf.responseBandwidth
+                 message: cannot be injected into tcp and kafka connections, only tcp.abort is supported`}),
 			Entry("tag value: invalid character set", testCase{
 				faultInjection: `
                 sources:
@@ -1,12 +1,18 @@
 package mesh
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
+// maxDirectResponseBodySize is the largest body that a direct response
+// filter can return. This matches the Envoy default.
+const maxDirectResponseBodySize = 4096
+
 // Validate checks MeshGatewayRouteResource semantic constraints.
 func (g *MeshGatewayRouteResource) Validate() error {
 	var err validators.ValidationError
@@ -50,33 +56,119 @@ func validateMeshGatewayRouteTLS(
 	path validators.PathBuilder,
 	conf *mesh_proto.MeshGatewayRoute_TlsRoute,
 ) validators.ValidationError {
-	if conf != nil {
-		return validators.MakeUnimplementedFieldErr(path)
+	if conf == nil {
+		return validators.OK()
+	}
+
+	var err validators.ValidationError
+
+	for i, h := range conf.GetHostnames() {
+		err.Add(ValidateHostname(path.Field("hostnames").Index(i), h))
+	}
+
+	if len(conf.GetRules()) < 1 {
+		err.Add(validators.MakeRequiredFieldErr(path.Field("rules")))
+		return err
+	}
+
+	for i, rule := range conf.GetRules() {
+		err.Add(validateMeshGatewayRouteTLSRule(path.Field("rules").Index(i), rule))
+	}
+
+	return err
+}
+
+func validateMeshGatewayRouteTLSRule(
+	path validators.PathBuilder,
+	conf *mesh_proto.MeshGatewayRoute_TlsRoute_Rule,
+) validators.ValidationError {
+	if len(conf.GetBackends()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("backends"))
+	}
+
+	var err validators.ValidationError
+
+	for i, b := range conf.GetBackends() {
+		err.Add(validateMeshGatewayRouteBackend(path.Field("backends").Index(i), b))
 	}
 
-	return validators.OK()
+	return err
 }
 
 func validateMeshGatewayRouteTCP(
 	path validators.PathBuilder,
 	conf *mesh_proto.MeshGatewayRoute_TcpRoute,
 ) validators.ValidationError {
-	if conf != nil {
-		return validators.MakeUnimplementedFieldErr(path)
+	if conf == nil {
+		return validators.OK()
 	}
 
-	return validators.OK()
+	if len(conf.GetRules()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("rules"))
+	}
+
+	var err validators.ValidationError
+
+	for i, rule := range conf.GetRules() {
+		err.Add(validateMeshGatewayRouteTCPRule(path.Field("rules").Index(i), rule))
+	}
+
+	return err
+}
+
+func validateMeshGatewayRouteTCPRule(
+	path validators.PathBuilder,
+	conf *mesh_proto.MeshGatewayRoute_TcpRoute_Rule,
+) validators.ValidationError {
+	if len(conf.GetBackends()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("backends"))
+	}
+
+	var err validators.ValidationError
+
+	for i, b := range conf.GetBackends() {
+		err.Add(validateMeshGatewayRouteBackend(path.Field("backends").Index(i), b))
+	}
+
+	return err
 }
 
 func validateMeshGatewayRouteUDP(
 	path validators.PathBuilder,
 	conf *mesh_proto.MeshGatewayRoute_UdpRoute,
 ) validators.ValidationError {
-	if conf != nil {
-		return validators.MakeUnimplementedFieldErr(path)
+	if conf == nil {
+		return validators.OK()
 	}
 
-	return validators.OK()
+	if len(conf.GetRules()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("rules"))
+	}
+
+	var err validators.ValidationError
+
+	for i, rule := range conf.GetRules() {
+		err.Add(validateMeshGatewayRouteUDPRule(path.Field("rules").Index(i), rule))
+	}
+
+	return err
+}
+
+func validateMeshGatewayRouteUDPRule(
+	path validators.PathBuilder,
+	conf *mesh_proto.MeshGatewayRoute_UdpRoute_Rule,
+) validators.ValidationError {
+	if len(conf.GetBackends()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("backends"))
+	}
+
+	var err validators.ValidationError
+
+	for i, b := range conf.GetBackends() {
+		err.Add(validateMeshGatewayRouteBackend(path.Field("backends").Index(i), b))
+	}
+
+	return err
 }
 
 func validateMeshGatewayRouteHTTP(
@@ -105,6 +197,7 @@ func validateMeshGatewayRouteHTTPRule(
 	conf *mesh_proto.MeshGatewayRoute_HttpRoute_Rule,
 ) validators.ValidationError {
 	var hasRedirect bool
+	var hasDirectResponse bool
 
 	if len(conf.GetMatches()) < 1 {
 		return validators.MakeRequiredFieldErr(path.Field("matches"))
@@ -116,14 +209,39 @@ func validateMeshGatewayRouteHTTPRule(
 		err.Add(validateMeshGatewayRouteHTTPMatch(path.Field("matches").Index(i), m))
 	}
 
+	var rewrites int
+
 	for i, f := range conf.GetFilters() {
 		if f.GetRedirect() != nil {
 			hasRedirect = true
 		}
 
+		if f.GetDirectResponse() != nil {
+			hasDirectResponse = true
+		}
+
+		if r := f.GetRewrite(); r != nil {
+			rewrites++
+
+			// Prefix replacement is only defined for prefix path matches.
+			if _, ok := r.GetPath().(*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch); ok {
+				for _, m := range conf.GetMatches() {
+					if m.GetPath().GetMatch() != mesh_proto.MeshGatewayRoute_HttpRoute_Match_Path_PREFIX || m.GetPath().GetValue() == "" {
+						err.AddViolationAt(path.Field("filters").Index(i).Field("rewrite").Field("replace_prefix_match"),
+							"requires all matches to be PREFIX path matches")
+						break
+					}
+				}
+			}
+		}
+
 		err.Add(validateMeshGatewayRouteHTTPFilter(path.Field("filters").Index(i), f))
 	}
 
+	if rewrites > 1 {
+		err.AddViolationAt(path.Field("filters"), "cannot contain more than one rewrite filter")
+	}
+
 	// It doesn't make sense to redirect and also mirror or rewrite request headers.
 	if hasRedirect && len(conf.GetFilters()) != 1 {
 		err.AddViolationAt(path.Field("filters"), "redirects cannot be used with other filters")
@@ -132,22 +250,50 @@ func validateMeshGatewayRouteHTTPRule(
 		return err
 	}
 
+	// A direct response can still have its response headers modified,
+	// but all the other filters only make sense when forwarding.
+	if hasDirectResponse {
+		var directResponses int
+
+		for _, f := range conf.GetFilters() {
+			switch {
+			case f.GetDirectResponse() != nil:
+				directResponses++
+			case f.GetResponseHeader() != nil:
+			default:
+				err.AddViolationAt(path.Field("filters"), "direct responses can only be used with response header filters")
+				return err
+			}
+		}
+
+		if directResponses > 1 {
+			err.AddViolationAt(path.Field("filters"), "cannot contain more than one direct response filter")
+		}
+	}
+
 	switch len(conf.GetBackends()) {
 	case 0:
-		// Redirection doesn't forward, so there must not be any backend.
-		if !hasRedirect {
+		// Redirection and direct responses don't forward, so there must
+		// not be any backend.
+		if !hasRedirect && !hasDirectResponse {
 			err.AddViolationAt(path.Field("backends"), "cannot be empty")
 		}
 	default:
 		if hasRedirect {
 			err.AddViolationAt(path.Field("backends"), "must be empty when using redirect filters")
 		}
+
+		if hasDirectResponse {
+			err.AddViolationAt(path.Field("backends"), "must be empty when using direct response filters")
+		}
 	}
 
 	for i, b := range conf.GetBackends() {
 		err.Add(validateMeshGatewayRouteBackend(path.Field("backends").Index(i), b))
 	}
 
+	err.Add(validateLoadBalancer(path.Field("load_balancer"), conf.GetLoadBalancer(), protoFieldName))
+
 	return err
 }
 
@@ -206,7 +352,12 @@ func validateMeshGatewayRouteHTTPFilter(
 ) validators.ValidationError {
 	var err validators.ValidationError
 
-	if r := conf.GetRequestHeader(); r != nil {
+	headers := func(
+		path validators.PathBuilder,
+		set []*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+		add []*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+		remove []string,
+	) validators.ValidationError {
 		header := func(
 			path validators.PathBuilder,
 			headers []*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header,
@@ -224,21 +375,46 @@ func validateMeshGatewayRouteHTTPFilter(
 			return err
 		}
 
-		path := path.Field("request_header")
-		if len(r.GetSet()) < 1 &&
-			len(r.GetAdd()) < 1 &&
-			len(r.GetRemove()) < 1 {
+		var err validators.ValidationError
+
+		if len(set) < 1 &&
+			len(add) < 1 &&
+			len(remove) < 1 {
 			err.AddViolationAt(path, "cannot be empty")
 		}
 
-		err.Add(header(path.Field("set"), r.GetSet()))
-		err.Add(header(path.Field("add"), r.GetAdd()))
+		err.Add(header(path.Field("set"), set))
+		err.Add(header(path.Field("add"), add))
 
-		for i, h := range r.GetRemove() {
+		for i, h := range remove {
 			if h == "" {
 				err.AddViolationAt(path.Field("remove").Index(i), "cannot be empty")
 			}
 		}
+
+		return err
+	}
+
+	if r := conf.GetRequestHeader(); r != nil {
+		err.Add(headers(path.Field("request_header"), r.GetSet(), r.GetAdd(), r.GetRemove()))
+	}
+
+	if r := conf.GetResponseHeader(); r != nil {
+		err.Add(headers(path.Field("response_header"), r.GetSet(), r.GetAdd(), r.GetRemove()))
+	}
+
+	if r := conf.GetDirectResponse(); r != nil {
+		path := path.Field("direct_response")
+
+		if r.GetStatusCode() < 200 || r.GetStatusCode() > 599 {
+			err.AddViolationAt(path.Field("status_code"), "must be in the range [200, 599]")
+		}
+
+		// Envoy rejects larger bodies unless the route configuration
+		// raises the limit.
+		if len(r.GetBody()) > maxDirectResponseBodySize {
+			err.AddViolationAt(path.Field("body"), fmt.Sprintf("must not be longer than %d bytes", maxDirectResponseBodySize))
+		}
 	}
 
 	if r := conf.GetRedirect(); r != nil {
@@ -261,6 +437,45 @@ func validateMeshGatewayRouteHTTPFilter(
 		}
 	}
 
+	if r := conf.GetRewrite(); r != nil {
+		path := path.Field("rewrite")
+
+		if r.GetPath() == nil && r.GetHost() == nil {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+
+		switch p := r.GetPath().(type) {
+		case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull:
+			if !strings.HasPrefix(p.ReplaceFull, "/") {
+				err.AddViolationAt(path.Field("replace_full"), "must be an absolute path")
+			}
+		case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch:
+			if p.ReplacePrefixMatch != "" && !strings.HasPrefix(p.ReplacePrefixMatch, "/") {
+				err.AddViolationAt(path.Field("replace_prefix_match"), "must be an absolute path")
+			}
+		case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex:
+			path := path.Field("replace_regex")
+			if p.ReplaceRegex.GetPattern() == "" {
+				err.AddViolationAt(path.Field("pattern"), "cannot be empty")
+			} else if _, e := regexp.Compile(p.ReplaceRegex.GetPattern()); e != nil {
+				err.AddViolationAt(path.Field("pattern"), "must be a valid regular expression")
+			}
+		}
+
+		switch h := r.GetHost().(type) {
+		case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname:
+			if h.Hostname == "" || strings.HasPrefix(h.Hostname, "*") {
+				err.AddViolationAt(path.Field("hostname"), "must be a valid DNS name")
+			} else {
+				err.Add(ValidateHostname(path.Field("hostname"), h.Hostname))
+			}
+		case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_HostToBackendHostname:
+			if !h.HostToBackendHostname {
+				err.AddViolationAt(path.Field("host_to_backend_hostname"), "must be true if set")
+			}
+		}
+	}
+
 	if m := conf.GetMirror(); m != nil {
 		path := path.Field("mirror")
 
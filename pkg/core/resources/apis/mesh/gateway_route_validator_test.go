@@ -77,6 +77,30 @@ conf:
       - weight: 5
         destination:
           kuma.io/service: target-2
+`),
+		Entry("HTTP route with sticky sessions", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: target-1
+      load_balancer:
+        ring_hash:
+          hash_function: XX_HASH
+        hash_policies:
+        - cookie:
+            name: session
+            ttl: 1h
 `),
 		Entry("HTTP redirect", `
 type: MeshGatewayRoute
@@ -112,6 +136,133 @@ conf:
          hostname: foo.example.com
          port: 80
          status_code: 307
+`),
+		Entry("HTTP rewrite", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api/v1
+      filters:
+      - rewrite:
+          replace_prefix_match: /v2
+          host_to_backend_hostname: true
+      backends:
+      - destination:
+          kuma.io/service: target-1
+    - matches:
+      - path:
+          match: EXACT
+          value: /status
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: "^/(.*)$"
+            substitution: "/health/\\1"
+          hostname: status.example.com
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP direct response", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /robots.txt
+      filters:
+      - direct_response:
+          status_code: 200
+          body: |
+            User-agent: *
+            Disallow: /
+      - response_header:
+          set:
+          - name: Content-Type
+            value: text/plain
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - response_header:
+          add:
+          - name: Cache-Control
+            value: no-store
+          remove:
+          - Server
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("TCP route", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
+    rules:
+    - backends:
+      - weight: 90
+        destination:
+          kuma.io/service: postgres-v1
+      - weight: 10
+        destination:
+          kuma.io/service: postgres-v2
+`),
+		Entry("UDP route", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  udp:
+    rules:
+    - backends:
+      - weight: 80
+        destination:
+          kuma.io/service: dns
+      - weight: 20
+        destination:
+          kuma.io/service: dns-canary
+`),
+		Entry("TLS route", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+    - "*.mqtt.example.com"
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: mqtt-broker
 `),
 	)
 
@@ -126,6 +277,133 @@ mesh: default
 selectors:
 - match:
     kuma.io/service: gateway
+`),
+		ErrorCase("missing TCP rules", validators.Violation{
+			Field:   "conf.tcp.rules",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
+    rules: []
+`),
+		ErrorCase("missing TCP rule backends", validators.Violation{
+			Field:   "conf.tcp.rules[0].backends",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
+    rules:
+    - matches:
+      - {}
+`),
+		ErrorCase("TCP backend with no service", validators.Violation{
+			Field:   "conf.tcp.rules[0].backends[0]",
+			Message: `mandatory tag "kuma.io/service" is missing`,
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          phoney: postgres
+`),
+		ErrorCase("missing UDP rules", validators.Violation{
+			Field:   "conf.udp.rules",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  udp:
+    rules: []
+`),
+		ErrorCase("missing UDP rule backends", validators.Violation{
+			Field:   "conf.udp.rules[0].backends",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  udp:
+    rules:
+    - matches:
+      - {}
+`),
+		ErrorCase("missing TLS rules", validators.Violation{
+			Field:   "conf.tls.rules",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+`),
+		ErrorCase("invalid TLS hostname", validators.Violation{
+			Field:   "conf.tls.hostnames[0]",
+			Message: "invalid hostname",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - mqtt.example$.com
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: mqtt-broker
+`),
+		ErrorCase("missing TLS rule backends", validators.Violation{
+			Field:   "conf.tls.rules[0].backends",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    rules:
+    - matches:
+      - {}
 `),
 		ErrorCase("missing HTTP rules", validators.Violation{
 			Field:   "conf.http.rules",
@@ -649,6 +927,286 @@ conf:
         - weight: 5
           destination:
             kuma.io/service: target-2
+`),
+		ErrorCase("empty rewrite filter", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - rewrite: {}
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("rewrite filter with relative path", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.replace_full",
+			Message: "must be an absolute path",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - rewrite:
+          replace_full: api
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("rewrite filter with invalid regex", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.replace_regex.pattern",
+			Message: "must be a valid regular expression",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: "^/(api"
+            substitution: /
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("rewrite filter with wildcard hostname", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.hostname",
+			Message: "must be a valid DNS name",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - rewrite:
+          hostname: "*.example.com"
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("prefix rewrite without prefix match", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.replace_prefix_match",
+			Message: "requires all matches to be PREFIX path matches",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      - path:
+          match: EXACT
+          value: /v1
+      filters:
+      - rewrite:
+          replace_prefix_match: /v2
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("multiple rewrite filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters",
+			Message: "cannot contain more than one rewrite filter",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - rewrite:
+          replace_full: /api
+      - rewrite:
+          hostname: example.com
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty response header filter", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].response_header",
+			Message: "cannot be empty",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - response_header: {}
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("direct response filter with invalid status", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].direct_response.status_code",
+			Message: "must be in the range [200, 599]",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 100
+`),
+		ErrorCase("direct response filter with backend routes", validators.Violation{
+			Field:   "conf.http.rules[0].backends",
+			Message: "must be empty when using direct response filters",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("direct response prevents request filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters",
+			Message: "direct responses can only be used with response header filters",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+      - request_header:
+          remove:
+          - x-foo
+`),
+		ErrorCase("hash policy without query parameter name", validators.Violation{
+			Field:   "conf.http.rules[0].load_balancer.hash_policies[0].query_parameter.name",
+			Message: `cannot be empty`,
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: target-2
+      load_balancer:
+        maglev: {}
+        hash_policies:
+        - query_parameter: {}
+`),
+		ErrorCase("hash policies without consistent hashing", validators.Violation{
+			Field:   "conf.http.rules[0].load_balancer.hash_policies",
+			Message: `can only be used with "ring_hash" or "maglev" load balancer`,
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: target-2
+      load_balancer:
+        hash_policies:
+        - source_ip: {}
 `),
 	)
 })
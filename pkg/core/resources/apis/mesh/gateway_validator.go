@@ -1,8 +1,15 @@
 package mesh
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 // Validate checks MeshGatewayResource semantic constraints.
@@ -69,18 +76,41 @@ func validateMeshGatewayConf(path validators.PathBuilder, conf *mesh_proto.MeshG
 		// Port is required, and must not be 0.
 		err.Add(ValidatePort(path.Index(i).Field("port"), l.GetPort()))
 
-		// For now, only support HTTP and HTTPS.
 		switch l.GetProtocol() {
 		case mesh_proto.MeshGateway_Listener_NONE:
 			err.AddViolationAt(path.Index(i).Field("protocol"), "cannot be empty")
-		case mesh_proto.MeshGateway_Listener_UDP,
-			mesh_proto.MeshGateway_Listener_TCP,
-			mesh_proto.MeshGateway_Listener_TLS:
-			err.AddViolationAt(path.Index(i).Field("protocol"), "protocol type is not supported")
+		case mesh_proto.MeshGateway_Listener_TLS:
+			// The gateway only routes TLS connections on the SNI
+			// hostname, so it can't terminate them.
+			switch l.GetTls().GetMode() {
+			case mesh_proto.MeshGateway_TLS_NONE:
+				if l.GetTls() == nil {
+					err.AddViolationAt(path.Index(i).Field("tls"), "cannot be empty")
+				}
+			case mesh_proto.MeshGateway_TLS_TERMINATE:
+				err.AddViolationAt(path.Index(i).Field("tls").Field("mode"), "must be PASSTHROUGH for TLS listeners")
+			}
+		case mesh_proto.MeshGateway_Listener_TCP,
+			mesh_proto.MeshGateway_Listener_UDP:
+			// TCP connections and UDP datagrams carry no
+			// hostname, so there is nothing to match a
+			// hostname against.
+			if h := l.GetHostname(); h != "" && h != "*" {
+				err.AddViolationAt(path.Index(i).Field("hostname"),
+					fmt.Sprintf("must be empty for %s listeners", l.GetProtocol()))
+			}
+			if l.GetTls() != nil {
+				err.AddViolationAt(path.Index(i).Field("tls"),
+					fmt.Sprintf("must be empty for %s listeners", l.GetProtocol()))
+			}
 		case mesh_proto.MeshGateway_Listener_HTTPS:
 			if l.GetTls() == nil {
 				err.AddViolationAt(path.Index(i).Field("tls"), "cannot be empty")
 			}
+			// HTTP routing needs the decrypted requests.
+			if l.GetTls().GetMode() == mesh_proto.MeshGateway_TLS_PASSTHROUGH {
+				err.AddViolationAt(path.Index(i).Field("tls").Field("mode"), "must be TERMINATE for HTTPS listeners")
+			}
 		}
 
 		if tls := l.GetTls(); tls != nil {
@@ -95,7 +125,33 @@ func validateMeshGatewayConf(path validators.PathBuilder, conf *mesh_proto.MeshG
 						path.Index(i).Field("tls").Field("certificates"),
 						"must be empty in TLS passthrough mode")
 				}
+				if tls.GetAcme() != nil {
+					err.AddViolationAt(
+						path.Index(i).Field("tls").Field("acme"),
+						"must be empty in TLS passthrough mode")
+				}
 			case mesh_proto.MeshGateway_TLS_TERMINATE:
+				if acme := tls.GetAcme(); acme != nil {
+					if len(tls.GetCertificates()) > 0 {
+						err.AddViolationAt(
+							path.Index(i).Field("tls").Field("certificates"),
+							"must be empty when ACME is configured")
+					}
+
+					// The HTTP-01 challenge can only validate
+					// exact hostnames.
+					if h := l.GetHostname(); h == "" || strings.Contains(h, "*") {
+						err.AddViolationAt(
+							path.Index(i).Field("hostname"),
+							"must be an exact hostname when ACME is configured")
+					}
+
+					err.Add(validateMeshGatewayEndpointURL(
+						path.Index(i).Field("tls").Field("acme").Field("directoryUrl"),
+						acme.GetDirectoryUrl()))
+					break
+				}
+
 				switch len(tls.GetCertificates()) {
 				case 0:
 					err.AddViolationAt(
@@ -111,6 +167,43 @@ func validateMeshGatewayConf(path validators.PathBuilder, conf *mesh_proto.MeshG
 			}
 		}
 
+		if auth := l.GetAuthentication(); auth != nil {
+			switch l.GetProtocol() {
+			case mesh_proto.MeshGateway_Listener_HTTP,
+				mesh_proto.MeshGateway_Listener_HTTPS:
+				err.Add(validateMeshGatewayAuthentication(path.Index(i).Field("authentication"), auth))
+			default:
+				err.AddViolationAt(path.Index(i).Field("authentication"),
+					fmt.Sprintf("must be empty for %s listeners", l.GetProtocol()))
+			}
+		}
+
+		if logging := l.GetLogging(); logging != nil {
+			switch l.GetProtocol() {
+			case mesh_proto.MeshGateway_Listener_UDP:
+				err.AddViolationAt(path.Index(i).Field("logging"),
+					fmt.Sprintf("must be empty for %s listeners", l.GetProtocol()))
+			default:
+				if e := accesslog.ValidateFormat(logging.GetFormat()); e != nil {
+					err.AddViolationAt(path.Index(i).Field("logging").Field("format"), e.Error())
+				}
+			}
+		}
+
+		if tracing := l.GetTracing(); tracing != nil {
+			switch l.GetProtocol() {
+			case mesh_proto.MeshGateway_Listener_HTTP,
+				mesh_proto.MeshGateway_Listener_HTTPS:
+				if s := tracing.GetSampling().GetValue(); s < 0.0 || s > 100.0 {
+					err.AddViolationAt(path.Index(i).Field("tracing").Field("sampling"),
+						"has to be in [0.0 - 100.0] range")
+				}
+			default:
+				err.AddViolationAt(path.Index(i).Field("tracing"),
+					fmt.Sprintf("must be empty for %s listeners", l.GetProtocol()))
+			}
+		}
+
 		// Listener tags are optional, but if given, must not contain
 		// various tags that are well-known properties of Dataplanes.
 		err.Add(ValidateSelector(
@@ -128,5 +221,127 @@ func validateMeshGatewayConf(path validators.PathBuilder, conf *mesh_proto.MeshG
 			}))
 	}
 
+	// Listeners that share a port are served by the same HTTP
+	// connection manager, so they must authenticate, log and trace
+	// requests in the same way. UDP listeners bind a different socket
+	// than the other protocols, so they are only compared with each
+	// other.
+	type listenerKey struct {
+		port uint32
+		udp  bool
+	}
+
+	listenersByPort := map[listenerKey]*mesh_proto.MeshGateway_Listener{}
+	for i, l := range conf.GetListeners() {
+		key := listenerKey{
+			port: l.GetPort(),
+			udp:  l.GetProtocol() == mesh_proto.MeshGateway_Listener_UDP,
+		}
+
+		first, ok := listenersByPort[key]
+		if !ok {
+			listenersByPort[key] = l
+			continue
+		}
+
+		if !proto.Equal(first.GetAuthentication(), l.GetAuthentication()) {
+			err.AddViolationAt(path.Index(i).Field("authentication"),
+				fmt.Sprintf("must be the same for all listeners on port %d", l.GetPort()))
+		}
+
+		if !proto.Equal(first.GetLogging(), l.GetLogging()) {
+			err.AddViolationAt(path.Index(i).Field("logging"),
+				fmt.Sprintf("must be the same for all listeners on port %d", l.GetPort()))
+		}
+
+		if !proto.Equal(first.GetTracing(), l.GetTracing()) {
+			err.AddViolationAt(path.Index(i).Field("tracing"),
+				fmt.Sprintf("must be the same for all listeners on port %d", l.GetPort()))
+		}
+	}
+
+	return err
+}
+
+func validateMeshGatewayAuthentication(
+	path validators.PathBuilder,
+	auth *mesh_proto.MeshGateway_Authentication,
+) validators.ValidationError {
+	err := validators.ValidationError{}
+
+	if auth.GetJwt() == nil && auth.GetOidc() == nil {
+		err.AddViolationAt(path, "cannot be empty")
+		return err
+	}
+
+	if jwt := auth.GetJwt(); jwt != nil {
+		path := path.Field("jwt").Field("providers")
+
+		if len(jwt.GetProviders()) == 0 {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+
+		names := map[string]bool{}
+		for i, p := range jwt.GetProviders() {
+			switch {
+			case p.GetName() == "":
+				err.AddViolationAt(path.Index(i).Field("name"), "cannot be empty")
+			case names[p.GetName()]:
+				err.AddViolationAt(path.Index(i).Field("name"), "must be unique")
+			}
+			names[p.GetName()] = true
+
+			if p.GetJwks() == nil {
+				err.AddViolationAt(path.Index(i).Field("jwks"), "cannot be empty")
+			}
+		}
+	}
+
+	if oidc := auth.GetOidc(); oidc != nil {
+		path := path.Field("oidc")
+
+		err.Add(validateMeshGatewayEndpointURL(path.Field("authorizationEndpoint"), oidc.GetAuthorizationEndpoint()))
+		err.Add(validateMeshGatewayEndpointURL(path.Field("tokenEndpoint"), oidc.GetTokenEndpoint()))
+
+		// The client secret and the authorization codes are sent to
+		// the token endpoint, so it must not be reached in plaintext.
+		if u, parseErr := url.ParseRequestURI(oidc.GetTokenEndpoint()); parseErr == nil && u.Scheme == "http" {
+			err.AddViolationAt(path.Field("tokenEndpoint"), "must be an HTTPS URL")
+		}
+
+		if oidc.GetClientId() == "" {
+			err.AddViolationAt(path.Field("clientId"), "cannot be empty")
+		}
+		if oidc.GetClientSecret() == nil {
+			err.AddViolationAt(path.Field("clientSecret"), "cannot be empty")
+		}
+		if oidc.GetHmacSecret() == nil {
+			err.AddViolationAt(path.Field("hmacSecret"), "cannot be empty")
+		}
+
+		if p := oidc.GetRedirectPath(); p != "" && !strings.HasPrefix(p, "/") {
+			err.AddViolationAt(path.Field("redirectPath"), "must be an absolute path")
+		}
+		if p := oidc.GetSignoutPath(); p != "" && !strings.HasPrefix(p, "/") {
+			err.AddViolationAt(path.Field("signoutPath"), "must be an absolute path")
+		}
+	}
+
+	return err
+}
+
+func validateMeshGatewayEndpointURL(path validators.PathBuilder, endpoint string) validators.ValidationError {
+	err := validators.ValidationError{}
+
+	if endpoint == "" {
+		err.AddViolationAt(path, "cannot be empty")
+		return err
+	}
+
+	u, parseErr := url.ParseRequestURI(endpoint)
+	if parseErr != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		err.AddViolationAt(path, "must be a valid HTTP or HTTPS URL")
+	}
+
 	return err
 }
@@ -55,6 +55,159 @@ conf:
     port: 443
     protocol: HTTP`,
 		),
+		Entry("HTTP listener with authentication", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 80
+    protocol: HTTP
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          issuer: https://auth.example.com
+          jwks:
+            inline: e30=
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: gateway
+        clientSecret:
+          secret: client-secret
+        hmacSecret:
+          secret: hmac-secret
+        redirectPath: /callback
+  - hostname: api.example.com
+    port: 80
+    protocol: HTTP
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          issuer: https://auth.example.com
+          jwks:
+            inline: e30=
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: gateway
+        clientSecret:
+          secret: client-secret
+        hmacSecret:
+          secret: hmac-secret
+        redirectPath: /callback
+`,
+		),
+		Entry("TCP listener", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 5432
+    protocol: TCP
+    tags:
+      name: postgres`,
+		),
+		Entry("UDP listener", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 53
+    protocol: UDP
+    tags:
+      name: dns`,
+		),
+		Entry("TLS passthrough listener", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: "*.example.com"
+    port: 443
+    protocol: TLS
+    tls:
+      mode: PASSTHROUGH`,
+		),
+		Entry("HTTPS listener with ACME certificates", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: https://acme-v02.api.letsencrypt.org/directory
+        email: admin@example.com
+  - hostname: www.example.com
+    port: 80
+    protocol: HTTP`,
+		),
+		Entry("listeners with logging and tracing", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 80
+    protocol: HTTP
+    logging:
+      backend: file
+      format: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT% %REQUESTED_SERVER_NAME%'
+    tracing:
+      backend: zipkin
+      sampling: 10.0
+  - port: 9000
+    protocol: TCP
+    logging:
+      backend: file`,
+		),
+		Entry("UDP and HTTP listeners with different logging on a shared port", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 53
+    protocol: HTTP
+    logging:
+      backend: file
+  - port: 53
+    protocol: UDP`,
+		),
 	)
 
 	DescribeErrorCases(
@@ -73,7 +226,7 @@ tags:
 conf:
   listeners:
   - port: 443
-    protocol: HTTPS
+    protocol: TLS
     tls:
       mode: PASSTHROUGH
     tags:
@@ -196,12 +349,52 @@ tags:
   product: edge
 conf:
   listeners:
-  - protocol: HTTPS
+  - protocol: TLS
     port: 99
     tags:
-      name: https
+      name: tls
+    tls:
+      mode: PASSTHROUGH
+      certificates:
+      - secret: foo
+`),
+
+		ErrorCase("has a passthrough HTTPS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.mode",
+				Message: "must be TERMINATE for HTTPS listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 443
     tls:
       mode: PASSTHROUGH
+`),
+
+		ErrorCase("has a terminating TLS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.mode",
+				Message: "must be PASSTHROUGH for TLS listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    tls:
+      mode: TERMINATE
       certificates:
       - secret: foo
 `),
@@ -229,6 +422,74 @@ conf:
       mode: TERMINATE
 `),
 
+		ErrorCase("has ACME and certificates",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.certificates",
+				Message: "must be empty when ACME is configured",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    protocol: HTTPS
+    port: 443
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: www-example-com
+      acme:
+        directoryUrl: https://acme.example.com/directory
+`),
+
+		ErrorCase("has ACME on a wildcard hostname",
+			validators.Violation{
+				Field:   "conf.listeners[0].hostname",
+				Message: "must be an exact hostname when ACME is configured",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: "*.example.com"
+    protocol: HTTPS
+    port: 443
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: https://acme.example.com/directory
+`),
+
+		ErrorCase("has an invalid ACME directory URL",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.acme.directoryUrl",
+				Message: "must be a valid HTTP or HTTPS URL",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    protocol: HTTPS
+    port: 443
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: acme.example.com/directory
+`),
+
 		ErrorCase("has an invalid wildcard",
 			validators.Violation{
 				Field:   "conf.listeners[0].hostname",
@@ -272,5 +533,359 @@ conf:
     tags:
       name: https
 `),
+
+		ErrorCase("has a TCP listener hostname",
+			validators.Violation{
+				Field:   "conf.listeners[0].hostname",
+				Message: "must be empty for TCP listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: db.example.com
+    protocol: TCP
+    port: 5432
+`),
+
+		ErrorCase("has a UDP listener TLS configuration",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls",
+				Message: "must be empty for UDP listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: UDP
+    port: 53
+    tls:
+      mode: PASSTHROUGH
+`),
+
+		ErrorCase("has TCP listener authentication",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication",
+				Message: "must be empty for TCP listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          jwks:
+            inline: e30=
+`),
+
+		ErrorCase("has empty authentication",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication",
+				Message: "cannot be empty",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication: {}
+`),
+
+		ErrorCase("has a JWT provider without JWKS",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.jwt.providers[0].jwks",
+				Message: "cannot be empty",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      jwt:
+        providers:
+        - name: example
+`),
+
+		ErrorCase("has duplicate JWT provider names",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.jwt.providers[1].name",
+				Message: "must be unique",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          jwks:
+            inline: e30=
+        - name: example
+          jwks:
+            inline: e30=
+`),
+
+		ErrorCase("has an invalid OIDC authorization endpoint",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.oidc.authorizationEndpoint",
+				Message: "must be a valid HTTP or HTTPS URL",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      oidc:
+        authorizationEndpoint: auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: gateway
+        clientSecret:
+          secret: client-secret
+        hmacSecret:
+          secret: hmac-secret
+`),
+
+		ErrorCase("has an OIDC configuration without a client secret",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.oidc.clientSecret",
+				Message: "cannot be empty",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: gateway
+        hmacSecret:
+          secret: hmac-secret
+`),
+
+		ErrorCase("has a plaintext OIDC token endpoint",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.oidc.tokenEndpoint",
+				Message: "must be an HTTPS URL",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: http://auth.example.com/token
+        clientId: gateway
+        clientSecret:
+          secret: client-secret
+        hmacSecret:
+          secret: hmac-secret
+`),
+
+		ErrorCase("has a relative OIDC redirect path",
+			validators.Violation{
+				Field:   "conf.listeners[0].authentication.oidc.redirectPath",
+				Message: "must be an absolute path",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    authentication:
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: gateway
+        clientSecret:
+          secret: client-secret
+        hmacSecret:
+          secret: hmac-secret
+        redirectPath: callback
+`),
+
+		ErrorCase("has different authentication on a shared port",
+			validators.Violation{
+				Field:   "conf.listeners[1].authentication",
+				Message: "must be the same for all listeners on port 80",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    protocol: HTTP
+    port: 80
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          jwks:
+            inline: e30=
+  - hostname: api.example.com
+    protocol: HTTP
+    port: 80
+`),
+
+		ErrorCase("has logging on a UDP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].logging",
+				Message: "must be empty for UDP listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: UDP
+    port: 53
+    logging:
+      backend: file
+`),
+
+		ErrorCase("has an invalid logging format",
+			validators.Violation{
+				Field:   "conf.listeners[0].logging.format",
+				Message: `format string is not valid: expected a command operator to start at position 1, instead got: "%UNCLOSED"`,
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    logging:
+      format: '%UNCLOSED'
+`),
+
+		ErrorCase("has tracing on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].tracing",
+				Message: "must be empty for TCP listeners",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 9000
+    tracing:
+      backend: zipkin
+`),
+
+		ErrorCase("has an out of range tracing sampling",
+			validators.Violation{
+				Field:   "conf.listeners[0].tracing.sampling",
+				Message: "has to be in [0.0 - 100.0] range",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tracing:
+      sampling: 101.0
+`),
+
+		ErrorCase("has different logging on a shared port",
+			validators.Violation{
+				Field:   "conf.listeners[1].logging",
+				Message: "must be the same for all listeners on port 80",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    protocol: HTTP
+    port: 80
+    logging:
+      backend: file
+  - hostname: api.example.com
+    protocol: HTTP
+    port: 80
+`),
 	)
 })
@@ -0,0 +1,92 @@
+package mesh
+
+import (
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (h *HeaderModificationResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(h.validateSources())
+	err.Add(h.validateDestinations())
+	err.Add(h.validateConf())
+
+	return err.OrNil()
+}
+
+func (h *HeaderModificationResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), h.Spec.GetSources(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (h *HeaderModificationResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), h.Spec.GetDestinations(), OnlyServiceTagAllowed)
+}
+
+func (h *HeaderModificationResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	conf := h.Spec.GetConf()
+
+	if conf == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+
+	if conf.GetOutbound() == nil && conf.GetInbound() == nil {
+		err.AddViolationAt(path, `either "outbound" or "inbound" has to be defined`)
+		return
+	}
+
+	err.Add(validateHeaderModificationHeaders(path.Field("outbound"), conf.GetOutbound(), true))
+	err.Add(validateHeaderModificationHeaders(path.Field("inbound"), conf.GetInbound(), false))
+
+	return
+}
+
+func validateHeaderModificationHeaders(
+	path validators.PathBuilder,
+	headers *mesh_proto.HeaderModification_Conf_Headers,
+	sourceServiceAllowed bool,
+) (err validators.ValidationError) {
+	err.Add(validateHeaderModificationOps(path.Field("requestHeaders"), headers.GetRequestHeaders(), sourceServiceAllowed))
+	err.Add(validateHeaderModificationOps(path.Field("responseHeaders"), headers.GetResponseHeaders(), sourceServiceAllowed))
+	return
+}
+
+func validateHeaderModificationOps(
+	path validators.PathBuilder,
+	headers *mesh_proto.TrafficRoute_Http_Modify_Headers,
+	sourceServiceAllowed bool,
+) (err validators.ValidationError) {
+	for i, add := range headers.GetAdd() {
+		addPath := path.Field("add").Index(i)
+		err.Add(validateHeaderModificationName(addPath.Field("name"), add.GetName()))
+		if add.GetValue() == "" {
+			err.AddViolationAt(addPath.Field("value"), "cannot be empty")
+		}
+		if !sourceServiceAllowed && strings.Contains(add.GetValue(), "%KUMA_SOURCE_SERVICE%") {
+			err.AddViolationAt(addPath.Field("value"), "%KUMA_SOURCE_SERVICE% is not available for the inbound traffic")
+		}
+	}
+	for i, remove := range headers.GetRemove() {
+		err.Add(validateHeaderModificationName(path.Field("remove").Index(i).Field("name"), remove.GetName()))
+	}
+	return
+}
+
+func validateHeaderModificationName(path validators.PathBuilder, name string) (err validators.ValidationError) {
+	switch {
+	case name == "":
+		err.AddViolationAt(path, "cannot be empty")
+	case strings.HasPrefix(name, ":"), strings.EqualFold(name, "host"):
+		err.AddViolationAt(path, "pseudo-headers and the host header cannot be modified")
+	}
+	return
+}
@@ -0,0 +1,158 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("HeaderModification", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(headerModificationYAML string) {
+				// setup
+				headerModification := NewHeaderModificationResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(headerModificationYAML), headerModification.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := headerModification.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full policy", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf:
+                  outbound:
+                    requestHeaders:
+                      add:
+                      - name: x-source
+                        value: '%KUMA_SOURCE_SERVICE%'
+                      remove:
+                      - name: x-internal
+                    responseHeaders:
+                      add:
+                      - name: x-mesh
+                        value: '%KUMA_MESH%'
+                        append: true
+                  inbound:
+                    responseHeaders:
+                      add:
+                      - name: x-served-by
+                        value: '%KUMA_DESTINATION_SERVICE%'`),
+			Entry("only inbound", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf:
+                  inbound:
+                    requestHeaders:
+                      remove:
+                      - name: x-debug`),
+		)
+
+		type testCase struct {
+			headerModification string
+			expected           string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				headerModification := NewHeaderModificationResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.headerModification), headerModification.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := headerModification.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				headerModification: ``,
+				expected: `
+               violations:
+               - field: sources
+                 message: must have at least one element
+               - field: destinations
+                 message: must have at least one element
+               - field: conf
+                 message: has to be defined`}),
+			Entry("empty conf", testCase{
+				headerModification: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf: {}`,
+				expected: `
+               violations:
+               - field: conf
+                 message: either "outbound" or "inbound" has to be defined`}),
+			Entry("invalid headers", testCase{
+				headerModification: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                   version: v1
+                conf:
+                  outbound:
+                    requestHeaders:
+                      add:
+                      - name: ""
+                        value: ""
+                      - name: ":authority"
+                        value: example.com
+                  inbound:
+                    requestHeaders:
+                      add:
+                      - name: x-source
+                        value: '%KUMA_SOURCE_SERVICE%'
+                    responseHeaders:
+                      remove:
+                      - name: Host`,
+				expected: `
+               violations:
+               - field: destinations[0].match
+                 message: must consist of exactly one tag "kuma.io/service"
+               - field: destinations[0].match["version"]
+                 message: tag "version" is not allowed
+               - field: conf.outbound.requestHeaders.add[0].name
+                 message: cannot be empty
+               - field: conf.outbound.requestHeaders.add[0].value
+                 message: cannot be empty
+               - field: conf.outbound.requestHeaders.add[1].name
+                 message: pseudo-headers and the host header cannot be modified
+               - field: conf.inbound.requestHeaders.add[0].value
+                 message: '%KUMA_SOURCE_SERVICE% is not available for the inbound traffic'
+               - field: conf.inbound.responseHeaders.remove[0].name
+                 message: pseudo-headers and the host header cannot be modified`}),
+		)
+	})
+})
@@ -115,6 +115,9 @@ func (d *HealthCheckResource) validateConf() (err validators.ValidationError) {
 	if d.Spec.Conf.GetTcp() != nil && d.Spec.Conf.GetHttp() != nil {
 		err.AddViolationAt(path, "http and tcp cannot be defined at the same time")
 	}
+	if d.Spec.Conf.GetGrpc() != nil && (d.Spec.Conf.GetTcp() != nil || d.Spec.Conf.GetHttp() != nil) {
+		err.AddViolationAt(path, "grpc cannot be defined at the same time as http or tcp")
+	}
 	return
 }
 
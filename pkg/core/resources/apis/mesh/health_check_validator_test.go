@@ -11,6 +11,34 @@ import (
 
 var _ = Describe("HealthCheck", func() {
 	Describe("Validate()", func() {
+		It("should pass validation of the gRPC health check", func() {
+			// given
+			healthCheck := NewHealthCheckResource()
+			err := util_proto.FromYAML([]byte(`
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  interval: 3s
+                  timeout: 10s
+                  unhealthyThreshold: 3
+                  healthyThreshold: 1
+                  grpc:
+                    serviceName: backend.Health
+                    authority: backend.mesh
+`), healthCheck.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := healthCheck.Validate()
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+
 		type testCase struct {
 			healthCheck string
 			expected    string
@@ -199,6 +227,30 @@ var _ = Describe("HealthCheck", func() {
                   message: has to be defined and cannot be empty
                 - field: conf
                   message: http and tcp cannot be defined at the same time
+`,
+			}),
+			Entry("grpc and http configuration", testCase{
+				healthCheck: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                    region: eu
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  interval: 3s
+                  timeout: 10s
+                  unhealthyThreshold: 3
+                  healthyThreshold: 1
+                  http:
+                    path: /health
+                  grpc: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: grpc cannot be defined at the same time as http or tcp
 `,
 			}),
 		)
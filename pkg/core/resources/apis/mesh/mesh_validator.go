@@ -23,6 +23,7 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
 	verr.AddError("constraints", validateConstraints(m.Spec.Constraints))
 	verr.AddError("", validateZoneEgress(m.Spec.Routing, m.Spec.Mtls))
+	verr.AddError("routing", validateRouting(m.Spec.Routing))
 	return verr.OrNil()
 }
 
@@ -267,3 +268,63 @@ func validateZoneEgress(routing *mesh_proto.Routing, mtls *mesh_proto.Mesh_Mtls)
 	}
 	return verr
 }
+
+func validateRouting(routing *mesh_proto.Routing) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, rule := range routing.GetZoneFailover() {
+		verr.AddErrorAt(validators.RootedAt("zoneFailover").Index(i), validateZoneFailover(rule))
+	}
+	return verr
+}
+
+func validateZoneFailover(rule *mesh_proto.ZoneFailover) validators.ValidationError {
+	var verr validators.ValidationError
+	if len(rule.GetFromZones()) == 0 {
+		verr.AddViolation("fromZones", "must have at least one element")
+	}
+	if len(rule.GetServices()) == 0 {
+		verr.AddViolation("services", "must have at least one element")
+	}
+	if len(rule.GetPriorities()) == 0 {
+		verr.AddViolation("priorities", "must have at least one element")
+	}
+	usedZones := map[string]bool{}
+	usedRegions := map[string]bool{}
+	for i, priority := range rule.GetPriorities() {
+		path := validators.RootedAt("priorities").Index(i)
+		if len(priority.GetZones()) == 0 && len(priority.GetRegions()) == 0 {
+			verr.AddViolationAt(path, "must have at least one zone or region")
+		}
+		for j, zone := range priority.GetZones() {
+			namePath := path.Field("zones").Index(j).Field("name")
+			// a zone can be listed once as a whole and once for each of its sub-zones
+			key := zone.GetName() + "/" + zone.GetSubZone()
+			switch {
+			case zone.GetName() == "":
+				verr.AddViolationAt(namePath, "cannot be empty")
+			case zone.GetName() == mesh_proto.MatchAllTag:
+				verr.AddViolationAt(namePath, "cannot be a wildcard")
+			case zone.GetSubZone() == mesh_proto.MatchAllTag:
+				verr.AddViolationAt(path.Field("zones").Index(j).Field("subZone"), "cannot be a wildcard")
+			case usedZones[key] && zone.GetSubZone() != "":
+				verr.AddViolationAt(namePath, fmt.Sprintf("%q sub-zone of the %q zone is already used in another priority", zone.GetSubZone(), zone.GetName()))
+			case usedZones[key]:
+				verr.AddViolationAt(namePath, fmt.Sprintf("%q zone is already used in another priority", zone.GetName()))
+			}
+			usedZones[key] = true
+		}
+		for j, region := range priority.GetRegions() {
+			regionPath := path.Field("regions").Index(j)
+			switch {
+			case region == "":
+				verr.AddViolationAt(regionPath, "cannot be empty")
+			case region == mesh_proto.MatchAllTag:
+				verr.AddViolationAt(regionPath, "cannot be a wildcard")
+			case usedRegions[region]:
+				verr.AddViolationAt(regionPath, fmt.Sprintf("%q region is already used in another priority", region))
+			}
+			usedRegions[region] = true
+		}
+	}
+	return verr
+}
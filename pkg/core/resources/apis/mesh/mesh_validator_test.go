@@ -81,6 +81,21 @@ var _ = Describe("Mesh", func() {
                     kuma.io/zone: west
             routing:
               zoneEgress: true
+              localityAwareLoadBalancing: true
+              zoneFailover:
+              - fromZones: ["*"]
+                services: ["backend"]
+                priorities:
+                - zones:
+                  - name: east
+                    weight: 2
+                  - name: west
+                - zones:
+                  - name: north
+                    subZone: north-1
+                - zones:
+                  - name: north
+                  regions: ["europe"]
 `
 			mesh := NewMeshResource()
 
@@ -539,6 +554,55 @@ var _ = Describe("Mesh", func() {
                 - field: mtls
                   message: has to be set when zoneEgress enabled`,
 			}),
+			Entry("invalid zone failover rules", testCase{
+				mesh: `
+                routing:
+                  localityAwareLoadBalancing: true
+                  zoneFailover:
+                  - {}
+                  - fromZones: ["*"]
+                    services: ["*"]
+                    priorities:
+                    - zones:
+                      - name: east
+                      - name: ""
+                    - zones: []
+                    - zones:
+                      - name: east
+                      - name: "*"
+                    - zones:
+                      - name: west
+                        subZone: west-1
+                      - name: west
+                        subZone: west-1
+                      - name: north
+                        subZone: "*"
+                      regions: ["europe", "", "europe"]`,
+				expected: `
+                violations:
+                - field: routing.zoneFailover[0].fromZones
+                  message: must have at least one element
+                - field: routing.zoneFailover[0].services
+                  message: must have at least one element
+                - field: routing.zoneFailover[0].priorities
+                  message: must have at least one element
+                - field: routing.zoneFailover[1].priorities[0].zones[1].name
+                  message: cannot be empty
+                - field: routing.zoneFailover[1].priorities[1]
+                  message: must have at least one zone or region
+                - field: routing.zoneFailover[1].priorities[2].zones[0].name
+                  message: '"east" zone is already used in another priority'
+                - field: routing.zoneFailover[1].priorities[2].zones[1].name
+                  message: cannot be a wildcard
+                - field: routing.zoneFailover[1].priorities[3].zones[1].name
+                  message: '"west-1" sub-zone of the "west" zone is already used in another priority'
+                - field: routing.zoneFailover[1].priorities[3].zones[2].subZone
+                  message: cannot be a wildcard
+                - field: routing.zoneFailover[1].priorities[3].regions[1]
+                  message: cannot be empty
+                - field: routing.zoneFailover[1].priorities[3].regions[2]
+                  message: '"europe" region is already used in another priority'`,
+			}),
 		)
 	})
 })
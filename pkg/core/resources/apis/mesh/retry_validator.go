@@ -186,7 +186,9 @@ func validateConfHttp(
 		conf.RetriableStatusCodes, conf.RetriableMethods
 
 	if numRetries == nil && perTryTimeout == nil && backOff == nil &&
-		retriableStatusCodes == nil && retriableMethods == nil {
+		retriableStatusCodes == nil && retriableMethods == nil &&
+		conf.RetryOn == nil && conf.RetriableHeaders == nil &&
+		conf.RateLimitedBackOff == nil && !conf.HedgeOnPerTryTimeout {
 		err.AddViolationAt(path, EmptyFieldViolation)
 	}
 
@@ -208,6 +210,15 @@ func validateConfHttp(
 		}
 	}
 
+	for i, header := range conf.RetriableHeaders {
+		if header == "" {
+			err.AddViolationAt(path.Field("retriableHeaders").Index(i), EmptyFieldViolation)
+		}
+	}
+
+	err.Add(validateConfRateLimitedBackOff(path.Field("rateLimitedBackOff"), conf.RateLimitedBackOff))
+	err.Add(validateConfHedge(path, conf.HedgeOnPerTryTimeout, perTryTimeout))
+
 	return
 }
 
@@ -223,7 +234,8 @@ func validateConfGrpc(
 		conf.NumRetries, conf.PerTryTimeout, conf.BackOff, conf.RetryOn
 
 	if numRetries == nil && perTryTimeout == nil && backOff == nil &&
-		retryOn == nil {
+		retryOn == nil && conf.RateLimitedBackOff == nil &&
+		!conf.HedgeOnPerTryTimeout {
 		err.AddViolationAt(path, EmptyFieldViolation)
 	}
 
@@ -242,6 +254,66 @@ func validateConfGrpc(
 	))
 
 	err.Add(validateConfProtocolBackOff(path.Field("backOff"), backOff))
+	err.Add(validateConfRateLimitedBackOff(path.Field("rateLimitedBackOff"), conf.RateLimitedBackOff))
+	err.Add(validateConfHedge(path, conf.HedgeOnPerTryTimeout, perTryTimeout))
+
+	return
+}
+
+func validateConfRateLimitedBackOff(
+	path validators.PathBuilder,
+	conf *mesh_proto.Retry_Conf_RateLimitedBackOff,
+) (err validators.ValidationError) {
+	if conf == nil {
+		return
+	}
+
+	if len(conf.ResetHeaders) == 0 {
+		err.AddViolationAt(path.Field("resetHeaders"), HasToBeDefinedViolation)
+	}
+
+	for i, header := range conf.ResetHeaders {
+		if header.Name == "" {
+			err.AddViolationAt(path.Field("resetHeaders").Index(i).Field("name"), EmptyFieldViolation)
+		}
+	}
+
+	err.Add(validateDuration_GreaterThan0OrNil(
+		path.Field("maxInterval"),
+		conf.MaxInterval,
+	))
+
+	return
+}
+
+func validateConfHedge(
+	path validators.PathBuilder,
+	hedgeOnPerTryTimeout bool,
+	perTryTimeout *durationpb.Duration,
+) (err validators.ValidationError) {
+	if hedgeOnPerTryTimeout && perTryTimeout == nil {
+		err.AddViolationAt(
+			path.Field("hedgeOnPerTryTimeout"),
+			"requires perTryTimeout to be defined",
+		)
+	}
+
+	return
+}
+
+func validateConfBudget(
+	path validators.PathBuilder,
+	conf *mesh_proto.Retry_Conf_Budget,
+) (err validators.ValidationError) {
+	if conf == nil {
+		return
+	}
+
+	if conf.BudgetPercent != nil {
+		if value := conf.BudgetPercent.GetValue(); value < 0 || value > 100 {
+			err.AddViolationAt(path.Field("budgetPercent"), "has to be in [0.0 - 100.0] range")
+		}
+	}
 
 	return
 }
@@ -284,6 +356,7 @@ func (r *RetryResource) validateConf() (err validators.ValidationError) {
 	err.Add(validateConfHttp(path.Field("http"), conf.GetHttp()))
 	err.Add(validateConfGrpc(path.Field("grpc"), conf.GetGrpc()))
 	err.Add(validateConfTcp(path.Field("tcp"), conf.GetTcp()))
+	err.Add(validateConfBudget(path.Field("budget"), conf.GetBudget()))
 
 	return
 }
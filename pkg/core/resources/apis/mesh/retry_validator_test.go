@@ -280,6 +280,45 @@ var _ = Describe("Retry", func() {
                 violations:
                 - field: conf.tcp.maxConnectAttempts
                   message: has to be greater than 0
+`,
+			}),
+			Entry("invalid budget, rate limited back off and hedging", testCase{
+				retry: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                    region: eu
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                    budget:
+                        budgetPercent: 120
+                    http:
+                        retriableHeaders:
+                        - ""
+                        rateLimitedBackOff:
+                            resetHeaders:
+                            - format: unix_timestamp
+                            maxInterval: 0s
+                        hedgeOnPerTryTimeout: true
+                    grpc:
+                        rateLimitedBackOff: {}
+`,
+				expected: `
+                violations:
+                - field: conf.http.retriableHeaders[0]
+                  message: field cannot be empty
+                - field: conf.http.rateLimitedBackOff.resetHeaders[0].name
+                  message: field cannot be empty
+                - field: conf.http.rateLimitedBackOff.maxInterval
+                  message: has to be greater than 0 when defined
+                - field: conf.http.hedgeOnPerTryTimeout
+                  message: requires perTryTimeout to be defined
+                - field: conf.grpc.rateLimitedBackOff.resetHeaders
+                  message: has to be defined
+                - field: conf.budget.budgetPercent
+                  message: has to be in [0.0 - 100.0] range
 `,
 			}),
 		)
@@ -317,6 +356,22 @@ var _ = Describe("Retry", func() {
                             baseInterval: 30ms
                             maxInterval: 1.2s
                         retriableStatusCodes: [501, 502]
+                        retryOn:
+                        - all_5xx
+                        - reset
+                        - retriable_headers
+                        retriableHeaders:
+                        - x-retry
+                        rateLimitedBackOff:
+                            resetHeaders:
+                            - name: Retry-After
+                            - name: X-RateLimit-Reset
+                              format: unix_timestamp
+                            maxInterval: 5s
+                        hedgeOnPerTryTimeout: true
+                    budget:
+                        budgetPercent: 20
+                        minRetryConcurrency: 3
                     grpc:
                         numRetries: 3
                         perTryTimeout: 200ms
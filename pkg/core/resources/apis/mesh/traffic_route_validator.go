@@ -1,6 +1,10 @@
 package mesh
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
@@ -39,6 +43,48 @@ func (d *TrafficRouteResource) validateConf() (err validators.ValidationError) {
 		err.Add(d.validateHTTP(root.Field("http").Index(i), http))
 	}
 	err.Add(d.validateLb())
+	if d.Spec.GetConf().GetCanary() != nil {
+		err.Add(d.validateCanary(root.Field("canary"), d.Spec.GetConf()))
+	}
+	return
+}
+
+func (d *TrafficRouteResource) validateCanary(pathBuilder validators.PathBuilder, conf *mesh_proto.TrafficRoute_Conf) (err validators.ValidationError) {
+	canary := conf.GetCanary()
+	err.Add(d.validateDestination(pathBuilder.Field("destination"), canary.GetDestination()))
+	if _, _, ok := conf.GetCanarySplits(); !ok {
+		err.AddViolationAt(pathBuilder.Field("destination"), `requires "split" with exactly two destinations, one of them equal to the canary destination`)
+	}
+	if len(canary.GetSteps()) == 0 {
+		err.AddViolationAt(pathBuilder.Field("steps"), "must have at least one element")
+	}
+	for i, step := range canary.GetSteps() {
+		if step > 100 {
+			err.AddViolationAt(pathBuilder.Field("steps").Index(i), "has to be in [0 - 100] range")
+		}
+	}
+	if canary.GetInterval() == nil {
+		err.AddViolationAt(pathBuilder.Field("interval"), "cannot be empty")
+	} else {
+		err.Add(validateDuration_GreaterThan0(pathBuilder.Field("interval"), canary.GetInterval()))
+	}
+	if canary.GetAnalysis() != nil {
+		err.Add(validateCanaryAnalysis(pathBuilder.Field("analysis"), canary.GetAnalysis()))
+	}
+	return
+}
+
+func validateCanaryAnalysis(pathBuilder validators.PathBuilder, analysis *mesh_proto.TrafficRoute_Canary_Analysis) (err validators.ValidationError) {
+	if analysis.GetPrometheusAddress() == "" {
+		err.AddViolationAt(pathBuilder.Field("prometheusAddress"), "cannot be empty")
+	}
+	if analysis.GetMinSuccessRate() == nil && analysis.GetMaxLatency() == nil {
+		err.AddViolationAt(pathBuilder, `requires at least one of "minSuccessRate" or "maxLatency"`)
+	}
+	if rate := analysis.GetMinSuccessRate(); rate != nil && (rate.GetValue() < 0.0 || rate.GetValue() > 100.0) {
+		err.AddViolationAt(pathBuilder.Field("minSuccessRate"), "has to be in [0.0 - 100.0] range")
+	}
+	err.Add(validateDuration_GreaterThan0OrNil(pathBuilder.Field("maxLatency"), analysis.GetMaxLatency()))
 	return
 }
 
@@ -46,6 +92,32 @@ func (d *TrafficRouteResource) validateHTTP(pathBuilder validators.PathBuilder,
 	err.Add(d.validateHTTPMatch(pathBuilder.Field("match"), http.GetMatch()))
 	err.Add(d.validateHTTPModify(pathBuilder.Field("modify"), http.GetModify(), http.GetMatch()))
 	err.Add(d.validateSplitAndDestination(pathBuilder, http.GetSplit(), http.GetDestination()))
+	if http.GetMirror() != nil {
+		err.Add(d.validateHTTPMirror(pathBuilder.Field("mirror"), http.GetMirror()))
+	}
+	if http.GetTimeout() != nil {
+		err.Add(d.validateHTTPTimeout(pathBuilder.Field("timeout"), http.GetTimeout()))
+	}
+	if http.GetRetry() != nil {
+		err.Add(validateConfHttp(pathBuilder.Field("retry"), http.GetRetry()))
+	}
+	return
+}
+
+func (d *TrafficRouteResource) validateHTTPTimeout(pathBuilder validators.PathBuilder, timeout *mesh_proto.TrafficRoute_Http_Timeout) (err validators.ValidationError) {
+	if timeout.GetRequestTimeout() == nil && timeout.GetStreamIdleTimeout() == nil {
+		err.AddViolationAt(pathBuilder, EmptyFieldViolation)
+	}
+	err.Add(validateDuration_GreaterThan0OrNil(pathBuilder.Field("requestTimeout"), timeout.GetRequestTimeout()))
+	err.Add(validateDuration_GreaterThan0OrNil(pathBuilder.Field("streamIdleTimeout"), timeout.GetStreamIdleTimeout()))
+	return
+}
+
+func (d *TrafficRouteResource) validateHTTPMirror(pathBuilder validators.PathBuilder, mirror *mesh_proto.TrafficRoute_Http_Mirror) (err validators.ValidationError) {
+	err.Add(d.validateDestination(pathBuilder.Field("destination"), mirror.GetDestination()))
+	if mirror.GetPercentage() != nil {
+		err.Add(validatePercentage(pathBuilder, mirror.GetPercentage()))
+	}
 	return
 }
 
@@ -167,10 +239,10 @@ func (d *TrafficRouteResource) validateHTTPMatch(pathBuilder validators.PathBuil
 		return
 	}
 	if match.GetMethod() != nil {
-		err.Add(d.validateStringMatcher(pathBuilder.Field("method"), match.GetMethod()))
+		err.Add(validateStringMatcher(pathBuilder.Field("method"), match.GetMethod()))
 	}
 	if match.GetPath() != nil {
-		err.Add(d.validateStringMatcher(pathBuilder.Field("path"), match.GetPath()))
+		err.Add(validateStringMatcher(pathBuilder.Field("path"), match.GetPath()))
 	}
 	if match.GetHeaders() != nil && len(match.GetHeaders()) == 0 {
 		err.AddViolationAt(pathBuilder.Field("headers"), "must contain at least one element")
@@ -180,12 +252,12 @@ func (d *TrafficRouteResource) validateHTTPMatch(pathBuilder validators.PathBuil
 		if len(key) == 0 {
 			err.AddViolationAt(path, "cannot be empty")
 		}
-		err.Add(d.validateStringMatcher(path, matcher))
+		err.Add(validateStringMatcher(path, matcher))
 	}
 	return
 }
 
-func (d *TrafficRouteResource) validateStringMatcher(pathBuilder validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
+func validateStringMatcher(pathBuilder validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
 	switch matcher.GetMatcherType().(type) {
 	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
 	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
@@ -232,7 +304,24 @@ func (d *TrafficRouteResource) validateDestination(pathBuilder validators.PathBu
 }
 
 func (d *TrafficRouteResource) validateLb() (err validators.ValidationError) {
-	lb := d.Spec.GetConf().GetLoadBalancer()
+	return validateLoadBalancer(validators.RootedAt("conf").Field("loadBalancer"), d.Spec.GetConf().GetLoadBalancer(), jsonFieldName)
+}
+
+// jsonFieldName and protoFieldName name the fields of the load balancer in the violations,
+// because TrafficRoute is written with the JSON field names and MeshGatewayRoute with the proto ones.
+func jsonFieldName(name string) string {
+	return name
+}
+
+var upperCaseLetter = regexp.MustCompile(`[A-Z]`)
+
+func protoFieldName(name string) string {
+	return upperCaseLetter.ReplaceAllStringFunc(name, func(letter string) string {
+		return "_" + strings.ToLower(letter)
+	})
+}
+
+func validateLoadBalancer(path validators.PathBuilder, lb *mesh_proto.TrafficRoute_LoadBalancer, fieldName func(string) string) (err validators.ValidationError) {
 	if lb == nil {
 		return
 	}
@@ -245,9 +334,36 @@ func (d *TrafficRouteResource) validateLb() (err validators.ValidationError) {
 		switch lbConfig.HashFunction {
 		case "XX_HASH", "MURMUR_HASH_2":
 		default:
-			root := validators.RootedAt("conf.loadBalancer.ringHash.hashFunction")
-			err.AddViolationAt(root, "must have a valid hash function")
+			err.AddViolationAt(path.Field(fieldName("ringHash")).Field(fieldName("hashFunction")), "must have a valid hash function")
 		}
 	}
+
+	if len(lb.GetHashPolicies()) > 0 && lb.GetRingHash() == nil && lb.GetMaglev() == nil {
+		err.AddViolationAt(path.Field(fieldName("hashPolicies")), fmt.Sprintf("can only be used with %q or %q load balancer", fieldName("ringHash"), "maglev"))
+	}
+	for i, hashPolicy := range lb.GetHashPolicies() {
+		err.Add(validateHashPolicy(path.Field(fieldName("hashPolicies")).Index(i), hashPolicy, fieldName))
+	}
+	return
+}
+
+func validateHashPolicy(path validators.PathBuilder, hashPolicy *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy, fieldName func(string) string) (err validators.ValidationError) {
+	switch hashPolicy.GetType().(type) {
+	case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+		if hashPolicy.GetHeader().GetName() == "" {
+			err.AddViolationAt(path.Field("header").Field("name"), "cannot be empty")
+		}
+	case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+		if hashPolicy.GetCookie().GetName() == "" {
+			err.AddViolationAt(path.Field("cookie").Field("name"), "cannot be empty")
+		}
+	case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_:
+		if hashPolicy.GetQueryParameter().GetName() == "" {
+			err.AddViolationAt(path.Field(fieldName("queryParameter")).Field("name"), "cannot be empty")
+		}
+	case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+	default:
+		err.AddViolationAt(path, fmt.Sprintf("either %q, %q, %q or %q has to be defined", "header", "cookie", fieldName("sourceIp"), fieldName("queryParameter")))
+	}
 	return
 }
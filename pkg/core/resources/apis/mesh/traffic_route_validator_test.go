@@ -129,6 +129,104 @@ var _ = Describe("TrafficRoute", func() {
                       destination:
                         kuma.io/service: backend`,
 			),
+			Entry("example with http mirror", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/offers"
+                    mirror:
+                      destination:
+                        kuma.io/service: offers
+                        version: v2
+                      percentage: 12.5
+                    destination:
+                      kuma.io/service: offers
+                  destination:
+                    kuma.io/service: backend`,
+			),
+			Entry("example with per route timeout and retry", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/reports"
+                    timeout:
+                      requestTimeout: 30s
+                      streamIdleTimeout: 10s
+                    retry:
+                      numRetries: 1
+                      perTryTimeout: 15s
+                    destination:
+                      kuma.io/service: backend
+                  destination:
+                    kuma.io/service: backend`,
+			),
+			Entry("example with hash policies", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  destination:
+                    kuma.io/service: backend
+                  loadBalancer:
+                    ringHash:
+                      hashFunction: XX_HASH
+                    hashPolicies:
+                    - cookie:
+                        name: session
+                        ttl: 1h
+                        path: /
+                      terminal: true
+                    - header:
+                        name: x-user-id
+                    - queryParameter:
+                        name: user
+                    - sourceIp: {}`,
+			),
+			Entry("example with canary", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  split:
+                  - weight: 100
+                    destination:
+                      kuma.io/service: backend
+                      version: v1
+                  - weight: 0
+                    destination:
+                      kuma.io/service: backend
+                      version: v2
+                  canary:
+                    destination:
+                      kuma.io/service: backend
+                      version: v2
+                    steps: [10, 50, 100]
+                    interval: 5m
+                    analysis:
+                      prometheusAddress: http://prometheus-server.kuma-metrics:80
+                      minSuccessRate: 99.5
+                      maxLatency: 500ms`,
+			),
 		)
 
 		type testCase struct {
@@ -299,6 +397,40 @@ var _ = Describe("TrafficRoute", func() {
                 violations:
                 - field: conf.loadBalancer.ringHash.hashFunction
                   message: must have a valid hash function
+`,
+			}),
+			Entry("invalid hash policies in the load balancer", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  destination:
+                    kuma.io/service: 'backend'
+                  loadBalancer:
+                    roundRobin: {}
+                    hashPolicies:
+                    - {}
+                    - header: {}
+                    - cookie:
+                        ttl: 1h
+                    - queryParameter: {}
+`,
+				expected: `
+                violations:
+                - field: conf.loadBalancer.hashPolicies
+                  message: can only be used with "ringHash" or "maglev" load balancer
+                - field: conf.loadBalancer.hashPolicies[0]
+                  message: either "header", "cookie", "sourceIp" or "queryParameter" has to be defined
+                - field: conf.loadBalancer.hashPolicies[1].header.name
+                  message: cannot be empty
+                - field: conf.loadBalancer.hashPolicies[2].cookie.name
+                  message: cannot be empty
+                - field: conf.loadBalancer.hashPolicies[3].queryParameter.name
+                  message: cannot be empty
 `,
 			}),
 			Entry("cannot define both split and destination", testCase{
@@ -645,6 +777,154 @@ var _ = Describe("TrafficRoute", func() {
                 - field: conf.http[0].modify.responseHeaders.remove[0].name
                   message: host header and HTTP/2 pseudo-headers are not allowed to be modified`,
 			}),
+			Entry("http - invalid mirror", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/offers"
+                    mirror:
+                      destination:
+                        version: v2
+                      percentage: 120
+                    destination:
+                      kuma.io/service: offers
+                  - match:
+                      path:
+                        prefix: "/users"
+                    mirror: {}
+                    destination:
+                      kuma.io/service: users
+                  destination:
+                    kuma.io/service: backend`,
+				expected: `
+                violations:
+                - field: conf.http[0].mirror.destination
+                  message: mandatory tag "kuma.io/service" is missing
+                - field: conf.http[0].mirror.percentage
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.http[1].mirror.destination
+                  message: must have at least one tag
+                - field: conf.http[1].mirror.destination
+                  message: mandatory tag "kuma.io/service" is missing`,
+			}),
+			Entry("http - invalid timeout and retry", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/reports"
+                    timeout:
+                      requestTimeout: 0s
+                    retry:
+                      numRetries: 0
+                      hedgeOnPerTryTimeout: true
+                    destination:
+                      kuma.io/service: backend
+                  - match:
+                      path:
+                        prefix: "/health"
+                    timeout: {}
+                    retry: {}
+                    destination:
+                      kuma.io/service: backend
+                  destination:
+                    kuma.io/service: backend`,
+				expected: `
+                violations:
+                - field: conf.http[0].timeout.requestTimeout
+                  message: has to be greater than 0 when defined
+                - field: conf.http[0].retry.numRetries
+                  message: has to be greater than 0 when defined
+                - field: conf.http[0].retry.hedgeOnPerTryTimeout
+                  message: requires perTryTimeout to be defined
+                - field: conf.http[1].timeout
+                  message: field cannot be empty
+                - field: conf.http[1].retry
+                  message: field cannot be empty`,
+			}),
+			Entry("invalid canary", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  split:
+                  - weight: 100
+                    destination:
+                      kuma.io/service: backend
+                      version: v1
+                  - weight: 0
+                    destination:
+                      kuma.io/service: backend
+                      version: v2
+                  canary:
+                    destination:
+                      kuma.io/service: backend
+                      version: v3
+                    steps: [10, 150]
+                    interval: 0s
+                    analysis:
+                      minSuccessRate: 120`,
+				expected: `
+                violations:
+                - field: conf.canary.destination
+                  message: requires "split" with exactly two destinations, one of them equal to the canary destination
+                - field: conf.canary.steps[1]
+                  message: has to be in [0 - 100] range
+                - field: conf.canary.interval
+                  message: has to be greater than 0
+                - field: conf.canary.analysis.prometheusAddress
+                  message: cannot be empty
+                - field: conf.canary.analysis.minSuccessRate
+                  message: has to be in [0.0 - 100.0] range`,
+			}),
+			Entry("empty canary", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  destination:
+                    kuma.io/service: backend
+                  canary:
+                    analysis:
+                      prometheusAddress: http://prometheus-server.kuma-metrics:80`,
+				expected: `
+                violations:
+                - field: conf.canary.destination
+                  message: must have at least one tag
+                - field: conf.canary.destination
+                  message: mandatory tag "kuma.io/service" is missing
+                - field: conf.canary.destination
+                  message: requires "split" with exactly two destinations, one of them equal to the canary destination
+                - field: conf.canary.steps
+                  message: must have at least one element
+                - field: conf.canary.interval
+                  message: cannot be empty
+                - field: conf.canary.analysis
+                  message: requires at least one of "minSuccessRate" or "maxLatency"`,
+			}),
 		)
 	})
 })
@@ -12,6 +12,115 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 )
 
+const (
+	AdaptiveConcurrencyType model.ResourceType = "AdaptiveConcurrency"
+)
+
+var _ model.Resource = &AdaptiveConcurrencyResource{}
+
+type AdaptiveConcurrencyResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.AdaptiveConcurrency
+}
+
+func NewAdaptiveConcurrencyResource() *AdaptiveConcurrencyResource {
+	return &AdaptiveConcurrencyResource{
+		Spec: &mesh_proto.AdaptiveConcurrency{},
+	}
+}
+
+func (t *AdaptiveConcurrencyResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AdaptiveConcurrencyResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AdaptiveConcurrencyResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AdaptiveConcurrencyResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *AdaptiveConcurrencyResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *AdaptiveConcurrencyResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.AdaptiveConcurrency)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.AdaptiveConcurrency{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *AdaptiveConcurrencyResource) Descriptor() model.ResourceTypeDescriptor {
+	return AdaptiveConcurrencyResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &AdaptiveConcurrencyResourceList{}
+
+type AdaptiveConcurrencyResourceList struct {
+	Items      []*AdaptiveConcurrencyResource
+	Pagination model.Pagination
+}
+
+func (l *AdaptiveConcurrencyResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AdaptiveConcurrencyResourceList) GetItemType() model.ResourceType {
+	return AdaptiveConcurrencyType
+}
+
+func (l *AdaptiveConcurrencyResourceList) NewItem() model.Resource {
+	return NewAdaptiveConcurrencyResource()
+}
+
+func (l *AdaptiveConcurrencyResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AdaptiveConcurrencyResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AdaptiveConcurrencyResource)(nil), r)
+	}
+}
+
+func (l *AdaptiveConcurrencyResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var AdaptiveConcurrencyResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           AdaptiveConcurrencyType,
+	Resource:       NewAdaptiveConcurrencyResource(),
+	ResourceList:   &AdaptiveConcurrencyResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "adaptive-concurrencies",
+	KumactlArg:     "adaptive-concurrency",
+	KumactlListArg: "adaptive-concurrencies",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(AdaptiveConcurrencyResourceTypeDescriptor)
+}
+
 const (
 	CircuitBreakerType model.ResourceType = "CircuitBreaker"
 )
@@ -121,6 +230,224 @@ func init() {
 	registry.RegisterType(CircuitBreakerResourceTypeDescriptor)
 }
 
+const (
+	CompressionType model.ResourceType = "Compression"
+)
+
+var _ model.Resource = &CompressionResource{}
+
+type CompressionResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.Compression
+}
+
+func NewCompressionResource() *CompressionResource {
+	return &CompressionResource{
+		Spec: &mesh_proto.Compression{},
+	}
+}
+
+func (t *CompressionResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *CompressionResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *CompressionResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *CompressionResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *CompressionResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *CompressionResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.Compression)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.Compression{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *CompressionResource) Descriptor() model.ResourceTypeDescriptor {
+	return CompressionResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &CompressionResourceList{}
+
+type CompressionResourceList struct {
+	Items      []*CompressionResource
+	Pagination model.Pagination
+}
+
+func (l *CompressionResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *CompressionResourceList) GetItemType() model.ResourceType {
+	return CompressionType
+}
+
+func (l *CompressionResourceList) NewItem() model.Resource {
+	return NewCompressionResource()
+}
+
+func (l *CompressionResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*CompressionResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*CompressionResource)(nil), r)
+	}
+}
+
+func (l *CompressionResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var CompressionResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           CompressionType,
+	Resource:       NewCompressionResource(),
+	ResourceList:   &CompressionResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "compressions",
+	KumactlArg:     "compression",
+	KumactlListArg: "compressions",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(CompressionResourceTypeDescriptor)
+}
+
+const (
+	CorsPolicyType model.ResourceType = "CorsPolicy"
+)
+
+var _ model.Resource = &CorsPolicyResource{}
+
+type CorsPolicyResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.CorsPolicy
+}
+
+func NewCorsPolicyResource() *CorsPolicyResource {
+	return &CorsPolicyResource{
+		Spec: &mesh_proto.CorsPolicy{},
+	}
+}
+
+func (t *CorsPolicyResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *CorsPolicyResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *CorsPolicyResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *CorsPolicyResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *CorsPolicyResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *CorsPolicyResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.CorsPolicy)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.CorsPolicy{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *CorsPolicyResource) Descriptor() model.ResourceTypeDescriptor {
+	return CorsPolicyResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &CorsPolicyResourceList{}
+
+type CorsPolicyResourceList struct {
+	Items      []*CorsPolicyResource
+	Pagination model.Pagination
+}
+
+func (l *CorsPolicyResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *CorsPolicyResourceList) GetItemType() model.ResourceType {
+	return CorsPolicyType
+}
+
+func (l *CorsPolicyResourceList) NewItem() model.Resource {
+	return NewCorsPolicyResource()
+}
+
+func (l *CorsPolicyResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*CorsPolicyResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*CorsPolicyResource)(nil), r)
+	}
+}
+
+func (l *CorsPolicyResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var CorsPolicyResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           CorsPolicyType,
+	Resource:       NewCorsPolicyResource(),
+	ResourceList:   &CorsPolicyResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "cors-policies",
+	KumactlArg:     "cors-policy",
+	KumactlListArg: "cors-policies",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(CorsPolicyResourceTypeDescriptor)
+}
+
 const (
 	DataplaneType model.ResourceType = "Dataplane"
 )
@@ -637,6 +964,115 @@ func init() {
 	registry.RegisterType(FaultInjectionResourceTypeDescriptor)
 }
 
+const (
+	HeaderModificationType model.ResourceType = "HeaderModification"
+)
+
+var _ model.Resource = &HeaderModificationResource{}
+
+type HeaderModificationResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.HeaderModification
+}
+
+func NewHeaderModificationResource() *HeaderModificationResource {
+	return &HeaderModificationResource{
+		Spec: &mesh_proto.HeaderModification{},
+	}
+}
+
+func (t *HeaderModificationResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *HeaderModificationResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *HeaderModificationResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *HeaderModificationResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *HeaderModificationResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *HeaderModificationResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.HeaderModification)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.HeaderModification{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *HeaderModificationResource) Descriptor() model.ResourceTypeDescriptor {
+	return HeaderModificationResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &HeaderModificationResourceList{}
+
+type HeaderModificationResourceList struct {
+	Items      []*HeaderModificationResource
+	Pagination model.Pagination
+}
+
+func (l *HeaderModificationResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *HeaderModificationResourceList) GetItemType() model.ResourceType {
+	return HeaderModificationType
+}
+
+func (l *HeaderModificationResourceList) NewItem() model.Resource {
+	return NewHeaderModificationResource()
+}
+
+func (l *HeaderModificationResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*HeaderModificationResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*HeaderModificationResource)(nil), r)
+	}
+}
+
+func (l *HeaderModificationResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var HeaderModificationResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           HeaderModificationType,
+	Resource:       NewHeaderModificationResource(),
+	ResourceList:   &HeaderModificationResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "header-modifications",
+	KumactlArg:     "header-modification",
+	KumactlListArg: "header-modifications",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(HeaderModificationResourceTypeDescriptor)
+}
+
 const (
 	HealthCheckType model.ResourceType = "HealthCheck"
 )
@@ -2117,6 +2553,111 @@ func init() {
 	registry.RegisterType(TrafficRouteResourceTypeDescriptor)
 }
 
+const (
+	TrafficRouteInsightType model.ResourceType = "TrafficRouteInsight"
+)
+
+var _ model.Resource = &TrafficRouteInsightResource{}
+
+type TrafficRouteInsightResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.TrafficRouteInsight
+}
+
+func NewTrafficRouteInsightResource() *TrafficRouteInsightResource {
+	return &TrafficRouteInsightResource{
+		Spec: &mesh_proto.TrafficRouteInsight{},
+	}
+}
+
+func (t *TrafficRouteInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *TrafficRouteInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *TrafficRouteInsightResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *TrafficRouteInsightResource) Validate() error {
+	return nil
+}
+
+func (t *TrafficRouteInsightResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.TrafficRouteInsight)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.TrafficRouteInsight{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *TrafficRouteInsightResource) Descriptor() model.ResourceTypeDescriptor {
+	return TrafficRouteInsightResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &TrafficRouteInsightResourceList{}
+
+type TrafficRouteInsightResourceList struct {
+	Items      []*TrafficRouteInsightResource
+	Pagination model.Pagination
+}
+
+func (l *TrafficRouteInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *TrafficRouteInsightResourceList) GetItemType() model.ResourceType {
+	return TrafficRouteInsightType
+}
+
+func (l *TrafficRouteInsightResourceList) NewItem() model.Resource {
+	return NewTrafficRouteInsightResource()
+}
+
+func (l *TrafficRouteInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*TrafficRouteInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*TrafficRouteInsightResource)(nil), r)
+	}
+}
+
+func (l *TrafficRouteInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var TrafficRouteInsightResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           TrafficRouteInsightType,
+	Resource:       NewTrafficRouteInsightResource(),
+	ResourceList:   &TrafficRouteInsightResourceList{},
+	ReadOnly:       true,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "traffic-route-insights",
+	KumactlArg:     "",
+	KumactlListArg: "",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(TrafficRouteInsightResourceTypeDescriptor)
+}
+
 const (
 	TrafficTraceType model.ResourceType = "TrafficTrace"
 )
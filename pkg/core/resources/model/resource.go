@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -230,6 +231,34 @@ type ResourceSpec interface {
 	proto.Message
 }
 
+// ExpirableSpec is a ResourceSpec of a policy that can be given an expiry time.
+type ExpirableSpec interface {
+	ResourceSpec
+	GetExpiresAt() *timestamppb.Timestamp
+}
+
+// IsExpirable returns true if resources of a given type can be given an expiry time.
+func IsExpirable(descriptor ResourceTypeDescriptor) bool {
+	_, ok := descriptor.Resource.GetSpec().(ExpirableSpec)
+	return ok
+}
+
+// ExpiresAt returns the time after which a given resource is no longer applied.
+// It returns false if the resource never expires.
+func ExpiresAt(r Resource) (time.Time, bool) {
+	spec, ok := r.GetSpec().(ExpirableSpec)
+	if !ok || spec.GetExpiresAt() == nil {
+		return time.Time{}, false
+	}
+	return spec.GetExpiresAt().AsTime(), true
+}
+
+// IsExpired returns true if a given resource has an expiry time that is not after now.
+func IsExpired(r Resource, now time.Time) bool {
+	expiresAt, ok := ExpiresAt(r)
+	return ok && !expiresAt.After(now)
+}
+
 type ResourceList interface {
 	GetItemType() ResourceType
 	GetItems() []Resource
@@ -11,10 +11,14 @@ import (
 
 type MatchedPolicies struct {
 	// Inbound(Listener) -> Policy
-	TrafficPermissions    TrafficPermissionMap
-	FaultInjections       FaultInjectionMap
-	RateLimitsInbound     InboundRateLimitsMap
-	CustomInboundPolicies []map[mesh_proto.InboundInterface]core_model.Resource
+	TrafficPermissions         TrafficPermissionMap
+	FaultInjections            FaultInjectionMap
+	RateLimitsInbound          InboundRateLimitsMap
+	AdaptiveConcurrencies      AdaptiveConcurrencyMap
+	Compressions               CompressionMap
+	CorsPolicies               CorsPolicyMap
+	HeaderModificationsInbound InboundHeaderModificationMap
+	CustomInboundPolicies      []map[mesh_proto.InboundInterface]core_model.Resource
 
 	// Service(Cluster) -> Policy
 	TrafficLogs     TrafficLogMap
@@ -23,8 +27,10 @@ type MatchedPolicies struct {
 	Retries         RetryMap
 
 	// Outbound(Listener) -> Policy
-	Timeouts           TimeoutMap
-	RateLimitsOutbound OutboundRateLimitsMap
+	Timeouts                    TimeoutMap
+	RateLimitsOutbound          OutboundRateLimitsMap
+	HeaderModificationsOutbound OutboundHeaderModificationMap
+	TcpFaultInjections          TcpFaultInjectionMap
 	// Actual Envoy Configuration is generated without taking this TrafficRoutes into account
 	TrafficRoutes RouteMap
 
@@ -185,6 +191,18 @@ func getInboundMatchedPolicies(matchedPolicies *MatchedPolicies) map[mesh_proto.
 			result[inbound] = append(result[inbound], rl)
 		}
 	}
+	for inbound, adaptiveConcurrency := range matchedPolicies.AdaptiveConcurrencies {
+		result[inbound] = append(result[inbound], adaptiveConcurrency)
+	}
+	for inbound, compression := range matchedPolicies.Compressions {
+		result[inbound] = append(result[inbound], compression)
+	}
+	for inbound, cors := range matchedPolicies.CorsPolicies {
+		result[inbound] = append(result[inbound], cors)
+	}
+	for inbound, hm := range matchedPolicies.HeaderModificationsInbound {
+		result[inbound] = append(result[inbound], hm)
+	}
 	for _, customPolicy := range matchedPolicies.CustomInboundPolicies {
 		for inbound, customList := range customPolicy {
 			result[inbound] = append(result[inbound], customList)
@@ -203,6 +221,12 @@ func getOutboundMatchedPolicies(matchedPolicies *MatchedPolicies) map[mesh_proto
 	for outbound, rl := range matchedPolicies.RateLimitsOutbound {
 		result[outbound] = append(result[outbound], rl)
 	}
+	for outbound, hm := range matchedPolicies.HeaderModificationsOutbound {
+		result[outbound] = append(result[outbound], hm)
+	}
+	for outbound, fi := range matchedPolicies.TcpFaultInjections {
+		result[outbound] = append(result[outbound], fi)
+	}
 	for outboud, tr := range matchedPolicies.TrafficRoutes {
 		result[outboud] = append(result[outboud], tr)
 	}
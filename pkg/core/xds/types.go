@@ -57,11 +57,19 @@ type ExternalService struct {
 	ClientKey          []byte
 	AllowRenegotiation bool
 	ServerName         string
+	// SystemCaPath is the path of a CA bundle on the dataplane that
+	// verifies the upstream when CaCert is empty.
+	SystemCaPath string
 }
 
 type Locality struct {
+	Region   string
 	Zone     string
+	SubZone  string
 	Priority uint32
+	// Weight multiplies the weights of the endpoints in the locality.
+	// Zero means that the weights are not changed.
+	Weight uint32
 }
 
 // Endpoint holds routing-related information about a single endpoint.
@@ -96,9 +104,32 @@ type RetryMap map[ServiceName]*core_mesh.RetryResource
 // FaultInjectionMap holds all matched FaultInjectionResources for each InboundInterface
 type FaultInjectionMap map[mesh_proto.InboundInterface][]*core_mesh.FaultInjectionResource
 
+// TcpFaultInjectionMap holds the most specific FaultInjectionResource with TCP faults for each OutboundInterface
+type TcpFaultInjectionMap map[mesh_proto.OutboundInterface]*core_mesh.FaultInjectionResource
+
 // TrafficPermissionMap holds the most specific TrafficPermissionResource for each InboundInterface
 type TrafficPermissionMap map[mesh_proto.InboundInterface]*core_mesh.TrafficPermissionResource
 
+// AdaptiveConcurrencyMap holds the most specific AdaptiveConcurrencyResource for each InboundInterface
+type AdaptiveConcurrencyMap map[mesh_proto.InboundInterface]*core_mesh.AdaptiveConcurrencyResource
+
+// CompressionMap holds the most specific CompressionResource for each InboundInterface
+type CompressionMap map[mesh_proto.InboundInterface]*core_mesh.CompressionResource
+
+// InboundHeaderModificationMap holds the most specific HeaderModificationResource for each InboundInterface
+type InboundHeaderModificationMap map[mesh_proto.InboundInterface]*core_mesh.HeaderModificationResource
+
+// OutboundHeaderModificationMap holds the most specific HeaderModificationResource for each OutboundInterface
+type OutboundHeaderModificationMap map[mesh_proto.OutboundInterface]*core_mesh.HeaderModificationResource
+
+type HeaderModificationsMap struct {
+	Inbound  InboundHeaderModificationMap
+	Outbound OutboundHeaderModificationMap
+}
+
+// CorsPolicyMap holds the most specific CorsPolicyResource for each InboundInterface
+type CorsPolicyMap map[mesh_proto.InboundInterface]*core_mesh.CorsPolicyResource
+
 // InboundRateLimitsMap holds all RateLimitResources for each InboundInterface
 type InboundRateLimitsMap map[mesh_proto.InboundInterface][]*core_mesh.RateLimitResource
 
@@ -153,6 +184,7 @@ type MeshResources struct {
 	EndpointMap                    EndpointMap
 	ExternalServiceFaultInjections ExternalServiceFaultInjectionMap
 	ExternalServiceRateLimits      ExternalServiceRateLimitMap
+	ExternalServiceCircuitBreakers CircuitBreakerMap
 }
 
 type ZoneEgressProxy struct {
@@ -211,7 +243,10 @@ func (e Endpoint) LocalityString() string {
 	if e.Locality == nil {
 		return ""
 	}
-	return e.Locality.Zone
+	if e.Locality.Region == "" && e.Locality.SubZone == "" {
+		return e.Locality.Zone
+	}
+	return strings.Join([]string{e.Locality.Region, e.Locality.Zone, e.Locality.SubZone}, "/")
 }
 
 func (e Endpoint) HasLocality() bool {
@@ -135,6 +135,14 @@ func BuildVirtualOutboundMeshView(ctx context.Context, rm manager.ReadOnlyResour
 	if err := rm.List(ctx, &virtualOutbounds, store.ListByMesh(mesh)); err != nil {
 		return nil, err
 	}
+	// Expired VirtualOutbounds are ignored before the garbage collector gets to delete them.
+	var activeVirtualOutbounds []*core_mesh.VirtualOutboundResource
+	now := core.Now()
+	for _, vob := range virtualOutbounds.Items {
+		if !model.IsExpired(vob, now) {
+			activeVirtualOutbounds = append(activeVirtualOutbounds, vob)
+		}
+	}
 	dataplanes := core_mesh.DataplaneResourceList{}
 	if err := rm.List(ctx, &dataplanes, store.ListByMesh(mesh)); err != nil {
 		return nil, err
@@ -145,7 +153,7 @@ func BuildVirtualOutboundMeshView(ctx context.Context, rm manager.ReadOnlyResour
 			if serviceVipEnabled {
 				errs = multierr.Append(errs, addDefault(outboundSet, inbound.GetService(), 0))
 			}
-			for _, vob := range Match(virtualOutbounds.Items, inbound.Tags) {
+			for _, vob := range Match(activeVirtualOutbounds, inbound.Tags) {
 				addFromVirtualOutbound(outboundSet, vob, inbound.Tags, dp.Descriptor().Name, dp.Meta.GetName())
 			}
 		}
@@ -161,7 +169,7 @@ func BuildVirtualOutboundMeshView(ctx context.Context, rm manager.ReadOnlyResour
 			if service.Mesh == mesh && serviceVipEnabled {
 				errs = multierr.Append(errs, addDefault(outboundSet, service.GetTags()[mesh_proto.ServiceTag], 0))
 			}
-			for _, vob := range Match(virtualOutbounds.Items, service.Tags) {
+			for _, vob := range Match(activeVirtualOutbounds, service.Tags) {
 				addFromVirtualOutbound(outboundSet, vob, service.Tags, zi.Descriptor().Name, zi.Meta.GetName())
 			}
 		}
@@ -181,7 +189,7 @@ func BuildVirtualOutboundMeshView(ctx context.Context, rm manager.ReadOnlyResour
 			TagSet: tags,
 			Origin: vips.OriginHost,
 		}))
-		for _, vob := range Match(virtualOutbounds.Items, tags) {
+		for _, vob := range Match(activeVirtualOutbounds, tags) {
 			addFromVirtualOutbound(outboundSet, vob, tags, es.Descriptor().Name, es.Meta.GetName())
 		}
 	}
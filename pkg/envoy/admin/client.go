@@ -33,6 +33,7 @@ type ResourceWithAddress interface {
 type EnvoyAdminClient interface {
 	PostQuit(dataplane *core_mesh.DataplaneResource) error
 	ConfigDump(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error)
+	Clusters(proxy ResourceWithAddress, defaultAdminPort uint32) (*envoy_admin_v3.Clusters, error)
 }
 
 type envoyAdminClient struct {
@@ -176,6 +177,38 @@ func (a *envoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource) erro
 }
 
 func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAddress uint32) ([]byte, error) {
+	configDump, err := a.executeRequest(proxy, defaultAdminAddress, "config_dump", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cd := &envoy_admin_v3.ConfigDump{}
+	if err := util_proto.FromJSON(configDump, cd); err != nil {
+		return nil, err
+	}
+
+	if err := Sanitize(cd); err != nil {
+		return nil, err
+	}
+
+	return util_proto.ToJSONIndent(cd, " ")
+}
+
+func (a *envoyAdminClient) Clusters(proxy ResourceWithAddress, defaultAdminAddress uint32) (*envoy_admin_v3.Clusters, error) {
+	clusters, err := a.executeRequest(proxy, defaultAdminAddress, "clusters", url.Values{"format": []string{"json"}})
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &envoy_admin_v3.Clusters{}
+	if err := util_proto.FromJSON(clusters, cs); err != nil {
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+func (a *envoyAdminClient) executeRequest(proxy ResourceWithAddress, defaultAdminAddress uint32, path string, query url.Values) ([]byte, error) {
 	var httpClient *http.Client
 	var err error
 	u := &url.URL{}
@@ -205,7 +238,8 @@ func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAdd
 	}
 
 	u.Host = proxy.AdminAddress(defaultAdminAddress)
-	u.Path = "config_dump"
+	u.Path = path
+	u.RawQuery = query.Encode()
 	request, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -213,7 +247,7 @@ func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAdd
 
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to send GET to %s", "config_dump")
+		return nil, errors.Wrapf(err, "unable to send GET to %s", path)
 	}
 	defer response.Body.Close()
 
@@ -221,19 +255,5 @@ func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAdd
 		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, response.Body)
 	}
 
-	configDump, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	cd := &envoy_admin_v3.ConfigDump{}
-	if err := util_proto.FromJSON(configDump, cd); err != nil {
-		return nil, err
-	}
-
-	if err := Sanitize(cd); err != nil {
-		return nil, err
-	}
-
-	return util_proto.ToJSONIndent(cd, " ")
+	return io.ReadAll(response.Body)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 )
 
@@ -19,6 +20,9 @@ func Setup(rt runtime.Runtime) error {
 	if err := setupFinalizer(rt); err != nil {
 		return err
 	}
+	if err := setupExpiredPolicyCollector(rt); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -33,6 +37,22 @@ func setupCollector(rt runtime.Runtime) error {
 	)
 }
 
+func setupExpiredPolicyCollector(rt runtime.Runtime) error {
+	if rt.Config().Mode == config_core.Zone {
+		// Policies are created on Global in multizone deployment, so they are deleted there and the deletion is synced to Zones.
+		return nil
+	}
+	resourceTypes := []model.ResourceType{}
+	for _, descriptor := range registry.Global().ObjectDescriptors(model.HasScope(model.ScopeMesh)) {
+		if model.IsExpirable(descriptor) {
+			resourceTypes = append(resourceTypes, descriptor.Name)
+		}
+	}
+	return rt.Add(
+		NewExpiredPolicyCollector(rt.ResourceManager(), 1*time.Minute, resourceTypes...),
+	)
+}
+
 func setupFinalizer(rt runtime.Runtime) error {
 	var newTicker func() *time.Ticker
 	var resourceTypes []model.ResourceType
@@ -0,0 +1,87 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var (
+	expiredPolicyLog = core.Log.WithName("expired-policy-collector")
+)
+
+// expiredPolicyCollector deletes policies which are past their expiry time.
+type expiredPolicyCollector struct {
+	rm            manager.ResourceManager
+	polling       time.Duration
+	resourceTypes []model.ResourceType
+}
+
+func NewExpiredPolicyCollector(rm manager.ResourceManager, polling time.Duration, resourceTypes ...model.ResourceType) component.Component {
+	return &expiredPolicyCollector{
+		rm:            rm,
+		polling:       polling,
+		resourceTypes: resourceTypes,
+	}
+}
+
+func (c *expiredPolicyCollector) Start(stop <-chan struct{}) error {
+	// the context interrupts the cleanup in progress when the component is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(c.polling)
+	defer ticker.Stop()
+	expiredPolicyLog.Info("started")
+	for {
+		select {
+		case <-ticker.C:
+			for _, typ := range c.resourceTypes {
+				if err := c.cleanup(ctx, typ); err != nil {
+					expiredPolicyLog.Error(err, "unable to cleanup", "type", typ)
+				}
+			}
+		case <-ctx.Done():
+			expiredPolicyLog.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (c *expiredPolicyCollector) cleanup(ctx context.Context, typ model.ResourceType) error {
+	list, err := registry.Global().NewList(typ)
+	if err != nil {
+		return err
+	}
+	if err := c.rm.List(ctx, list); err != nil {
+		return err
+	}
+	now := core.Now()
+	for _, item := range list.GetItems() {
+		if !model.IsExpired(item, now) {
+			continue
+		}
+		rk := model.MetaToResourceKey(item.GetMeta())
+		expiredPolicyLog.Info("deleting expired policy", "type", typ, "name", rk.Name, "mesh", rk.Mesh)
+		if err := c.rm.Delete(ctx, item, store.DeleteBy(rk)); err != nil {
+			expiredPolicyLog.Error(err, "unable to delete expired policy", "type", typ, "name", rk.Name, "mesh", rk.Mesh)
+			continue
+		}
+	}
+	return nil
+}
+
+func (c *expiredPolicyCollector) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,130 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/gc"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Expired Policy Collector", func() {
+	var rm manager.ResourceManager
+	now := time.Now()
+	var backupNow func() time.Time
+
+	createTrafficPermission := func(name string, expiresAt *time.Time) {
+		tp := core_mesh.NewTrafficPermissionResource()
+		tp.Spec = &mesh_proto.TrafficPermission{
+			Sources: []*mesh_proto.Selector{{
+				Match: mesh_proto.MatchAnyService(),
+			}},
+			Destinations: []*mesh_proto.Selector{{
+				Match: mesh_proto.MatchAnyService(),
+			}},
+		}
+		if expiresAt != nil {
+			tp.Spec.ExpiresAt = proto.MustTimestampProto(*expiresAt)
+		}
+		err := rm.Create(context.Background(), tp, store.CreateByKey(name, core_model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		mtxNow.Lock()
+		backupNow = core.Now
+		core.Now = func() time.Time {
+			return now
+		}
+		mtxNow.Unlock()
+	})
+
+	AfterEach(func() {
+		mtxNow.Lock()
+		core.Now = backupNow
+		mtxNow.Unlock()
+	})
+
+	It("should delete expired policies", func() {
+		// given
+		expired := now.Add(-1 * time.Minute)
+		notExpired := now.Add(1 * time.Hour)
+		createTrafficPermission("tp-expired", &expired)
+		createTrafficPermission("tp-not-expired", &notExpired)
+		createTrafficPermission("tp-without-expiry", nil)
+
+		// when
+		collector := gc.NewExpiredPolicyCollector(rm, 100*time.Millisecond, core_mesh.TrafficPermissionType)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			_ = collector.Start(stop)
+		}()
+
+		// then
+		Eventually(func() ([]string, error) {
+			permissions := &core_mesh.TrafficPermissionResourceList{}
+			if err := rm.List(context.Background(), permissions); err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, tp := range permissions.Items {
+				names = append(names, tp.Meta.GetName())
+			}
+			return names, nil
+		}).Should(Equal([]string{"tp-not-expired", "tp-without-expiry"}))
+	})
+
+	It("should delete expired virtual outbounds", func() {
+		// given
+		vo := core_mesh.NewVirtualOutboundResource()
+		vo.Spec = &mesh_proto.VirtualOutbound{
+			Selectors: []*mesh_proto.Selector{{
+				Match: mesh_proto.MatchAnyService(),
+			}},
+			Conf: &mesh_proto.VirtualOutbound_Conf{
+				Host: "{{.service}}.mesh",
+				Port: "80",
+				Parameters: []*mesh_proto.VirtualOutbound_Conf_TemplateParameter{
+					{Name: "service", TagKey: mesh_proto.ServiceTag},
+				},
+			},
+			ExpiresAt: proto.MustTimestampProto(now.Add(-1 * time.Minute)),
+		}
+		err := rm.Create(context.Background(), vo, store.CreateByKey("vo-expired", core_model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		collector := gc.NewExpiredPolicyCollector(rm, 100*time.Millisecond, core_mesh.VirtualOutboundType)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			_ = collector.Start(stop)
+		}()
+
+		// then
+		Eventually(func() ([]*core_mesh.VirtualOutboundResource, error) {
+			virtualOutbounds := &core_mesh.VirtualOutboundResourceList{}
+			if err := rm.List(context.Background(), virtualOutbounds); err != nil {
+				return nil, err
+			}
+			return virtualOutbounds.Items, nil
+		}).Should(BeEmpty())
+	})
+})
@@ -62,13 +62,17 @@ var _ = Describe("KDS Server", func() {
 		// Do not forget to update this test after updating protobuf `KumaKdsOptions`.
 		Expect(registry.Global().ObjectTypes(model.HasKdsEnabled())).
 			To(HaveLen(len([]proto.Message{
+				kds_samples.AdaptiveConcurrency,
 				kds_samples.CircuitBreaker,
+				kds_samples.Compression,
+				kds_samples.CorsPolicy,
 				kds_samples.Dataplane,
 				kds_samples.DataplaneInsight,
 				kds_samples.ServiceInsight,
 				kds_samples.ExternalService,
 				kds_samples.FaultInjection,
 				kds_samples.GlobalSecret,
+				kds_samples.HeaderModification,
 				kds_samples.HealthCheck,
 				kds_samples.Mesh1,
 				kds_samples.ProxyTemplate,
@@ -79,6 +83,7 @@ var _ = Describe("KDS Server", func() {
 				kds_samples.TrafficLog,
 				kds_samples.TrafficPermission,
 				kds_samples.TrafficRoute,
+				kds_samples.TrafficRouteInsight,
 				kds_samples.TrafficTrace,
 				kds_samples.ZoneIngress,
 				kds_samples.ZoneIngressInsight,
@@ -97,11 +102,15 @@ var _ = Describe("KDS Server", func() {
 			// period expires. Then all the subsequent DiscoveryRequests will be served from the
 			// same snapshot, so resources that aren't already present won't be reported.
 
+			Exec(kds_verifier.Create(ctx, &mesh.AdaptiveConcurrencyResource{Spec: kds_samples.AdaptiveConcurrency}, store.CreateByKey("adaptive-concurrency-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.CircuitBreakerResource{Spec: kds_samples.CircuitBreaker}, store.CreateByKey("cb-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.CompressionResource{Spec: kds_samples.Compression}, store.CreateByKey("compression-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.CorsPolicyResource{Spec: kds_samples.CorsPolicy}, store.CreateByKey("cors-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneInsightResource{Spec: kds_samples.DataplaneInsight}, store.CreateByKey("insight-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneResource{Spec: kds_samples.Dataplane}, store.CreateByKey("dp-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.ExternalServiceResource{Spec: kds_samples.ExternalService}, store.CreateByKey("es-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.FaultInjectionResource{Spec: kds_samples.FaultInjection}, store.CreateByKey("fi-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.HeaderModificationResource{Spec: kds_samples.HeaderModification}, store.CreateByKey("hm-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.HealthCheckResource{Spec: kds_samples.HealthCheck}, store.CreateByKey("hc-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.MeshResource{Spec: kds_samples.Mesh1}, store.CreateByKey("mesh-1", model.NoMesh))).
 			Exec(kds_verifier.Create(ctx, &mesh.ProxyTemplateResource{Spec: kds_samples.ProxyTemplate}, store.CreateByKey("pt-1", "mesh-1"))).
@@ -133,16 +142,36 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.ExternalService))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.AdaptiveConcurrencyType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.AdaptiveConcurrency))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.CircuitBreakerType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.CircuitBreaker))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.CompressionType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.Compression))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.CorsPolicyType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.CorsPolicy))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.FaultInjectionType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.FaultInjection))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.HeaderModificationType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.HeaderModification))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.HealthCheckType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
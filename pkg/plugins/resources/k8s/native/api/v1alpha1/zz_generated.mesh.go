@@ -17,6 +17,101 @@ import (
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type AdaptiveConcurrency struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma AdaptiveConcurrency resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type AdaptiveConcurrencyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AdaptiveConcurrency `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AdaptiveConcurrency{}, &AdaptiveConcurrencyList{})
+}
+
+func (cb *AdaptiveConcurrency) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *AdaptiveConcurrency) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *AdaptiveConcurrency) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *AdaptiveConcurrency) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *AdaptiveConcurrency) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.AdaptiveConcurrency{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *AdaptiveConcurrency) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.AdaptiveConcurrency); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *AdaptiveConcurrency) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *AdaptiveConcurrencyList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.AdaptiveConcurrency{}, &AdaptiveConcurrency{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AdaptiveConcurrency",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.AdaptiveConcurrency{}, &AdaptiveConcurrencyList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AdaptiveConcurrencyList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 type CircuitBreaker struct {
@@ -112,6 +207,196 @@ func init() {
 	})
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type Compression struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma Compression resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type CompressionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Compression `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Compression{}, &CompressionList{})
+}
+
+func (cb *Compression) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *Compression) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *Compression) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *Compression) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *Compression) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.Compression{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *Compression) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.Compression); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *Compression) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *CompressionList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.Compression{}, &Compression{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Compression",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.Compression{}, &CompressionList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "CompressionList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type CorsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma CorsPolicy resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type CorsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CorsPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CorsPolicy{}, &CorsPolicyList{})
+}
+
+func (cb *CorsPolicy) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *CorsPolicy) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *CorsPolicy) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *CorsPolicy) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *CorsPolicy) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.CorsPolicy{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *CorsPolicy) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.CorsPolicy); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *CorsPolicy) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *CorsPolicyList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.CorsPolicy{}, &CorsPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "CorsPolicy",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.CorsPolicy{}, &CorsPolicyList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "CorsPolicyList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
 type Dataplane struct {
@@ -494,6 +779,101 @@ func init() {
 	})
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type HeaderModification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma HeaderModification resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type HeaderModificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HeaderModification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HeaderModification{}, &HeaderModificationList{})
+}
+
+func (cb *HeaderModification) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *HeaderModification) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *HeaderModification) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *HeaderModification) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *HeaderModification) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.HeaderModification{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *HeaderModification) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.HeaderModification); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *HeaderModification) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *HeaderModificationList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.HeaderModification{}, &HeaderModification{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "HeaderModification",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.HeaderModification{}, &HeaderModificationList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "HeaderModificationList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 type HealthCheck struct {
@@ -1691,6 +2071,101 @@ func init() {
 	})
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type TrafficRouteInsight struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma TrafficRouteInsight resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type TrafficRouteInsightList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficRouteInsight `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrafficRouteInsight{}, &TrafficRouteInsightList{})
+}
+
+func (cb *TrafficRouteInsight) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *TrafficRouteInsight) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *TrafficRouteInsight) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *TrafficRouteInsight) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *TrafficRouteInsight) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.TrafficRouteInsight{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *TrafficRouteInsight) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.TrafficRouteInsight); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *TrafficRouteInsight) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *TrafficRouteInsightList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.TrafficRouteInsight{}, &TrafficRouteInsight{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "TrafficRouteInsight",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.TrafficRouteInsight{}, &TrafficRouteInsightList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "TrafficRouteInsightList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 type TrafficTrace struct {
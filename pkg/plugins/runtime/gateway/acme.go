@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"net/http"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+// hasACMECertificate returns whether the host can be served. This is
+// the case unless the host takes its certificate from ACME and the
+// certificate hasn't been obtained yet.
+func hasACMECertificate(ctx xds_context.MeshContext, gatewayName string, host GatewayHost) bool {
+	if host.TLS.GetAcme() == nil {
+		return true
+	}
+
+	name := acme.CertificateSecretName(gatewayName, host.Hostname)
+	for _, s := range ctx.Resources.Secrets().Items {
+		if s.GetMeta().GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasACMEListener returns whether any listener of the gateway takes its
+// certificate from ACME, in which case the HTTP listeners may have to
+// answer ACME challenges.
+func hasACMEListener(gateway *core_mesh.MeshGatewayResource) bool {
+	for _, l := range gateway.Spec.GetConf().GetListeners() {
+		if l.GetTls().GetAcme() != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addACMEChallengeEntries adds route entries that answer the pending
+// ACME HTTP-01 challenges of the gateway to the hosts of a HTTP
+// listener. A challenge is answered by the host with the challenged
+// hostname or, if there is none, by the hosts that match it.
+func addACMEChallengeEntries(ctx xds_context.MeshContext, gatewayName string, hostInfos []GatewayHostInfo) {
+	challenges, err := acme.ChallengesFor(ctx.Resources.Secrets().Items, gatewayName)
+	if err != nil {
+		log.Error(err, "failed to decode ACME challenges", "gateway", gatewayName)
+		return
+	}
+
+	for _, c := range challenges {
+		matches := func(hostname string) bool {
+			return hostname == c.Hostname
+		}
+
+		if !hasHost(hostInfos, c.Hostname) {
+			matches = func(hostname string) bool {
+				return hostname == WildcardHostname || match.Hostnames(c.Hostname, hostname)
+			}
+		}
+
+		for i := range hostInfos {
+			if !matches(hostInfos[i].Host.Hostname) {
+				continue
+			}
+
+			hostInfos[i].Entries = append(hostInfos[i].Entries, route.Entry{
+				Route: acme.ChallengesSecretName(gatewayName),
+				Match: route.Match{
+					ExactPath: acme.ChallengePathPrefix + c.Token,
+				},
+				Action: route.Action{
+					Respond: &route.DirectResponse{
+						Status: http.StatusOK,
+						Body:   c.KeyAuthorization,
+					},
+				},
+			})
+		}
+	}
+}
+
+func hasHost(hostInfos []GatewayHostInfo, hostname string) bool {
+	for _, h := range hostInfos {
+		if h.Host.Hostname == hostname {
+			return true
+		}
+	}
+
+	return false
+}
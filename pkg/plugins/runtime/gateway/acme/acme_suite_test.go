@@ -0,0 +1,11 @@
+package acme_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestACME(t *testing.T) {
+	test.RunSpecs(t, "Gateway ACME Suite")
+}
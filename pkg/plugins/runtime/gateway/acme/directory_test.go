@@ -0,0 +1,289 @@
+package acme_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeDirectory is a minimal ACME (RFC 8555) server that issues
+// certificates for the HTTP-01 challenges that Validate accepts.
+type fakeDirectory struct {
+	*httptest.Server
+
+	// Validate reports whether the gateway serves the given
+	// challenge token.
+	Validate func(token string) bool
+	// RejectOrders makes the directory refuse to create orders.
+	RejectOrders bool
+
+	mutex    sync.Mutex
+	requests map[string]int
+	orders   []*fakeOrder
+
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+}
+
+type fakeOrder struct {
+	Hostname    string
+	AuthzStatus string
+	Status      string
+	Certificate []byte
+}
+
+func newFakeDirectory() *fakeDirectory {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake ACME CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	d := &fakeDirectory{
+		Validate: func(string) bool { return true },
+		requests: map[string]int{},
+		caKey:    key,
+		caCert:   cert,
+	}
+	d.Server = httptest.NewServer(http.HandlerFunc(d.serveHTTP))
+
+	return d
+}
+
+// Requests returns how many requests were sent to the given endpoint,
+// e.g. "new-account" or "new-order".
+func (d *fakeDirectory) Requests(endpoint string) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.requests[endpoint]
+}
+
+func (d *fakeDirectory) DirectoryURL() string {
+	return d.URL + "/directory"
+}
+
+func (d *fakeDirectory) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", time.Now().UnixNano()))
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	endpoint := parts[0]
+	d.requests[endpoint]++
+
+	var order *fakeOrder
+	var id int
+	if len(parts) == 2 {
+		if _, err := fmt.Sscan(parts[1], &id); err != nil || id >= len(d.orders) {
+			http.NotFound(w, r)
+			return
+		}
+		order = d.orders[id]
+	}
+
+	switch endpoint {
+	case "directory":
+		d.writeJSON(w, http.StatusOK, map[string]string{
+			"newNonce":   d.URL + "/new-nonce",
+			"newAccount": d.URL + "/new-account",
+			"newOrder":   d.URL + "/new-order",
+		})
+	case "new-nonce":
+		w.WriteHeader(http.StatusOK)
+	case "new-account":
+		w.Header().Set("Location", d.URL+"/account/1")
+		d.writeJSON(w, http.StatusCreated, map[string]string{"status": "valid"})
+	case "new-order":
+		if d.RejectOrders {
+			d.writeProblem(w, http.StatusForbidden, "urn:ietf:params:acme:error:rejectedIdentifier", "orders are rejected")
+			return
+		}
+
+		var payload struct {
+			Identifiers []struct {
+				Value string `json:"value"`
+			} `json:"identifiers"`
+		}
+		if err := decodePayload(r, &payload); err != nil || len(payload.Identifiers) != 1 {
+			d.writeProblem(w, http.StatusBadRequest, "urn:ietf:params:acme:error:malformed", "invalid order")
+			return
+		}
+
+		d.orders = append(d.orders, &fakeOrder{
+			Hostname:    payload.Identifiers[0].Value,
+			AuthzStatus: "pending",
+			Status:      "pending",
+		})
+		id = len(d.orders) - 1
+
+		w.Header().Set("Location", fmt.Sprintf("%s/order/%d", d.URL, id))
+		d.writeJSON(w, http.StatusCreated, d.orderJSON(id))
+	case "order":
+		d.writeJSON(w, http.StatusOK, d.orderJSON(id))
+	case "authz":
+		d.writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status":     order.AuthzStatus,
+			"identifier": map[string]string{"type": "dns", "value": order.Hostname},
+			"challenges": []interface{}{d.challengeJSON(id)},
+		})
+	case "challenge":
+		if d.Validate(fmt.Sprintf("token-%d", id)) {
+			order.AuthzStatus = "valid"
+			order.Status = "ready"
+		} else {
+			order.AuthzStatus = "invalid"
+			order.Status = "invalid"
+		}
+		d.writeJSON(w, http.StatusOK, d.challengeJSON(id))
+	case "finalize":
+		var payload struct {
+			CSR string `json:"csr"`
+		}
+		if err := decodePayload(r, &payload); err != nil || order.Status != "ready" {
+			d.writeProblem(w, http.StatusForbidden, "urn:ietf:params:acme:error:orderNotReady", "order is not ready")
+			return
+		}
+
+		cert, err := d.sign(payload.CSR)
+		if err != nil {
+			d.writeProblem(w, http.StatusBadRequest, "urn:ietf:params:acme:error:badCSR", err.Error())
+			return
+		}
+
+		order.Certificate = cert
+		order.Status = "valid"
+
+		w.Header().Set("Location", fmt.Sprintf("%s/order/%d", d.URL, id))
+		d.writeJSON(w, http.StatusOK, d.orderJSON(id))
+	case "cert":
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(order.Certificate)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (d *fakeDirectory) orderJSON(id int) map[string]interface{} {
+	order := d.orders[id]
+
+	o := map[string]interface{}{
+		"status":         order.Status,
+		"identifiers":    []interface{}{map[string]string{"type": "dns", "value": order.Hostname}},
+		"authorizations": []string{fmt.Sprintf("%s/authz/%d", d.URL, id)},
+		"finalize":       fmt.Sprintf("%s/finalize/%d", d.URL, id),
+	}
+	if order.Status == "valid" {
+		o["certificate"] = fmt.Sprintf("%s/cert/%d", d.URL, id)
+	}
+
+	return o
+}
+
+func (d *fakeDirectory) challengeJSON(id int) map[string]interface{} {
+	return map[string]interface{}{
+		"type":   "http-01",
+		"url":    fmt.Sprintf("%s/challenge/%d", d.URL, id),
+		"token":  fmt.Sprintf("token-%d", id),
+		"status": d.orders[id].AuthzStatus,
+	}
+}
+
+// sign issues a certificate for the base64url encoded CSR and returns
+// it with the CA certificate as a PEM encoded chain.
+func (d *fakeDirectory) sign(encodedCSR string) ([]byte, error) {
+	der, err := base64.RawURLEncoding.DecodeString(encodedCSR)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, d.caCert, csr.PublicKey, d.caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: d.caCert.Raw})...,
+	), nil
+}
+
+func (d *fakeDirectory) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (d *fakeDirectory) writeProblem(w http.ResponseWriter, status int, problem string, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"type":   problem,
+		"detail": detail,
+	})
+}
+
+// decodePayload decodes the payload of the JWS in the request body.
+// The signature isn't verified.
+func decodePayload(r *http.Request, payload interface{}) error {
+	var jws struct {
+		Payload string `json:"payload"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jws); err != nil {
+		return err
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, payload)
+}
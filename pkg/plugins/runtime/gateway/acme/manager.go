@@ -0,0 +1,585 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var log = core.Log.WithName("plugin").WithName("runtime").WithName("gateway").WithName("acme")
+
+const (
+	// DefaultInterval is how often the gateways are checked for
+	// certificates to obtain or renew.
+	DefaultInterval = time.Minute
+
+	// DefaultRenewBefore is how long before it expires a certificate
+	// is renewed.
+	DefaultRenewBefore = 30 * 24 * time.Hour
+
+	// DefaultPropagationDelay is how long to wait for a challenge to
+	// reach the gateway proxies before the issuer is asked to
+	// validate it.
+	DefaultPropagationDelay = 5 * time.Second
+
+	// DefaultIssueTimeout bounds the time it takes to obtain a single
+	// certificate.
+	DefaultIssueTimeout = 2 * time.Minute
+
+	// DefaultMinBackoff is how long to wait before obtaining a
+	// certificate again after the first failure. The wait doubles
+	// with every further failure, up to DefaultMaxBackoff.
+	DefaultMinBackoff = 5 * time.Minute
+
+	// DefaultMaxBackoff is the longest wait between two attempts to
+	// obtain the same certificate.
+	DefaultMaxBackoff = 6 * time.Hour
+)
+
+// Request is a certificate that has to be obtained for a hostname of
+// a gateway.
+type Request struct {
+	Mesh     string
+	Gateway  string
+	Hostname string
+	ACME     *mesh_proto.MeshGateway_TLS_ACME
+}
+
+func (r Request) key() requestKey {
+	return requestKey{
+		Mesh:     r.Mesh,
+		Gateway:  r.Gateway,
+		Hostname: r.Hostname,
+	}
+}
+
+type requestKey struct {
+	Mesh     string
+	Gateway  string
+	Hostname string
+}
+
+// failure tracks the failed attempts to obtain a certificate.
+type failure struct {
+	Attempts int
+	RetryAt  time.Time
+}
+
+// Manager obtains and renews the certificates of the gateway HTTPS
+// listeners that are configured with an ACME issuer. The certificates
+// are stored in Secrets that the gateway generator loads, and the
+// HTTP-01 challenges are published in a Secret that the gateway
+// generator turns into routes.
+type Manager struct {
+	ResourceManager  manager.ResourceManager
+	DataSourceLoader datasource.Loader
+
+	Interval         time.Duration
+	RenewBefore      time.Duration
+	PropagationDelay time.Duration
+	IssueTimeout     time.Duration
+	MinBackoff       time.Duration
+	MaxBackoff       time.Duration
+
+	// failures is only accessed by the reconciliation loop.
+	failures map[requestKey]failure
+
+	clientsMutex sync.Mutex
+	// clients caches the registered ACME clients by mesh and account
+	// Secret name, so that each account is registered only once.
+	clients map[model.ResourceKey]*acme.Client
+}
+
+var _ component.Component = &Manager{}
+
+// NewManager returns a Manager with the default intervals.
+func NewManager(rm manager.ResourceManager, loader datasource.Loader) *Manager {
+	return &Manager{
+		ResourceManager:  rm,
+		DataSourceLoader: loader,
+		Interval:         DefaultInterval,
+		RenewBefore:      DefaultRenewBefore,
+		PropagationDelay: DefaultPropagationDelay,
+		IssueTimeout:     DefaultIssueTimeout,
+		MinBackoff:       DefaultMinBackoff,
+		MaxBackoff:       DefaultMaxBackoff,
+		failures:         map[requestKey]failure{},
+		clients:          map[model.ResourceKey]*acme.Client{},
+	}
+}
+
+func (m *Manager) NeedLeaderElection() bool {
+	return true
+}
+
+func (m *Manager) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+
+	log.Info("starting the ACME certificate manager")
+
+	for {
+		m.reconcile(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			log.Info("stopping")
+			return nil
+		}
+	}
+}
+
+func (m *Manager) reconcile(ctx context.Context) {
+	requests, err := m.Requests(ctx)
+	if err != nil {
+		log.Error(err, "failed to list ACME certificate requests")
+		return
+	}
+
+	// Forget the failures of certificates that are no longer requested,
+	// e.g. because they were obtained in the meantime or the listener
+	// was removed.
+	requested := map[requestKey]bool{}
+	for _, req := range requests {
+		requested[req.key()] = true
+	}
+	for key := range m.failures {
+		if !requested[key] {
+			delete(m.failures, key)
+		}
+	}
+
+	now := core.Now()
+
+	for _, req := range requests {
+		logger := log.WithValues("mesh", req.Mesh, "gateway", req.Gateway, "hostname", req.Hostname)
+
+		if f, ok := m.failures[req.key()]; ok && now.Before(f.RetryAt) {
+			logger.V(1).Info("backing off obtaining ACME certificate", "retryAt", f.RetryAt)
+			continue
+		}
+
+		logger.Info("obtaining ACME certificate")
+
+		issueCtx, cancel := context.WithTimeout(ctx, m.IssueTimeout)
+		err := m.Issue(issueCtx, req)
+		cancel()
+
+		if err != nil {
+			f := m.backOff(req.key(), now)
+			logger.Error(err, "failed to obtain ACME certificate", "retryAt", f.RetryAt)
+			continue
+		}
+
+		delete(m.failures, req.key())
+		logger.Info("obtained ACME certificate")
+	}
+}
+
+// backOff records a failed attempt to obtain the certificate for key
+// and schedules the next attempt with an exponential backoff.
+func (m *Manager) backOff(key requestKey, now time.Time) failure {
+	f := m.failures[key]
+	f.Attempts++
+
+	backoff := m.MinBackoff
+	for i := 1; i < f.Attempts && backoff < m.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > m.MaxBackoff {
+		backoff = m.MaxBackoff
+	}
+
+	f.RetryAt = now.Add(backoff)
+	m.failures[key] = f
+
+	return f
+}
+
+// Requests returns the certificates that are missing or have to be
+// renewed.
+func (m *Manager) Requests(ctx context.Context) ([]Request, error) {
+	meshes := core_mesh.MeshResourceList{}
+	if err := m.ResourceManager.List(ctx, &meshes); err != nil {
+		return nil, err
+	}
+
+	deadline := core.Now().Add(m.RenewBefore)
+
+	var requests []Request
+
+	for _, mesh := range meshes.Items {
+		gateways := core_mesh.MeshGatewayResourceList{}
+		if err := m.ResourceManager.List(ctx, &gateways, store.ListByMesh(mesh.GetMeta().GetName())); err != nil {
+			return nil, err
+		}
+
+		secrets := system.SecretResourceList{}
+		if err := m.ResourceManager.List(ctx, &secrets, store.ListByMesh(mesh.GetMeta().GetName())); err != nil {
+			return nil, err
+		}
+
+		secretsByName := map[string]*system.SecretResource{}
+		for _, s := range secrets.Items {
+			secretsByName[s.GetMeta().GetName()] = s
+		}
+
+		for _, gateway := range gateways.Items {
+			seen := map[string]bool{}
+
+			for _, l := range gateway.Spec.GetConf().GetListeners() {
+				acmeConf := l.GetTls().GetAcme()
+				if acmeConf == nil || l.GetProtocol() != mesh_proto.MeshGateway_Listener_HTTPS {
+					continue
+				}
+
+				hostname := l.GetHostname()
+				if seen[hostname] {
+					continue
+				}
+				seen[hostname] = true
+
+				secret := secretsByName[CertificateSecretName(gateway.GetMeta().GetName(), hostname)]
+				if secret != nil && !NeedsRenewal(secret.Spec.GetData().GetValue(), hostname, deadline) {
+					continue
+				}
+
+				requests = append(requests, Request{
+					Mesh:     mesh.GetMeta().GetName(),
+					Gateway:  gateway.GetMeta().GetName(),
+					Hostname: hostname,
+					ACME:     acmeConf,
+				})
+			}
+		}
+	}
+
+	return requests, nil
+}
+
+// Issue obtains the requested certificate from the ACME issuer and
+// stores it in the certificate Secret of the gateway hostname.
+func (m *Manager) Issue(ctx context.Context, req Request) error {
+	client, err := m.client(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(req.Hostname))
+	if err != nil {
+		return errors.Wrap(err, "failed to create ACME order")
+	}
+
+	// Whatever happens, the gateway doesn't need to answer the
+	// challenges for this hostname any more.
+	defer func() {
+		if err := m.updateChallenges(req, func(challenges []Challenge) []Challenge {
+			return removeChallenges(challenges, req.Hostname)
+		}); err != nil {
+			log.Error(err, "failed to remove ACME challenges", "mesh", req.Mesh, "gateway", req.Gateway)
+		}
+	}()
+
+	for _, url := range order.AuthzURLs {
+		if err := m.authorize(ctx, client, req, url); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.WaitOrder(ctx, order.URI); err != nil {
+		return errors.Wrap(err, "ACME order failed")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: []string{req.Hostname},
+	}, key)
+	if err != nil {
+		return err
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to finalize ACME order")
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	// The gateway expects the server certificate first, followed by
+	// the rest of the chain and the private key.
+	var data []byte
+	for _, der := range chain {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})...)
+
+	return m.upsertSecret(model.ResourceKey{
+		Mesh: req.Mesh,
+		Name: CertificateSecretName(req.Gateway, req.Hostname),
+	}, data)
+}
+
+// authorize answers the HTTP-01 challenge of the given authorization
+// through the gateway and waits for the issuer to validate it.
+func (m *Manager) authorize(ctx context.Context, client *acme.Client, req Request, url string) error {
+	authz, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ACME authorization")
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "http-01" {
+			challenge = c
+			break
+		}
+	}
+
+	if challenge == nil {
+		return errors.Errorf("ACME authorization for %q has no HTTP-01 challenge", authz.Identifier.Value)
+	}
+
+	keyAuth, err := client.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return err
+	}
+
+	if err := m.updateChallenges(req, func(challenges []Challenge) []Challenge {
+		return append(challenges, Challenge{
+			Hostname:         req.Hostname,
+			Token:            challenge.Token,
+			KeyAuthorization: keyAuth,
+		})
+	}); err != nil {
+		return errors.Wrap(err, "failed to publish ACME challenge")
+	}
+
+	// Give the gateway proxies time to receive the challenge route.
+	select {
+	case <-time.After(m.PropagationDelay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return errors.Wrap(err, "failed to accept ACME challenge")
+	}
+
+	if _, err := client.WaitAuthorization(ctx, url); err != nil {
+		return errors.Wrap(err, "ACME authorization failed")
+	}
+
+	return nil
+}
+
+// client returns the client of the ACME account for the requested
+// directory and email. The account is registered the first time the
+// client is used, and the registered client is cached for the next
+// requests.
+func (m *Manager) client(ctx context.Context, req Request) (*acme.Client, error) {
+	m.clientsMutex.Lock()
+	defer m.clientsMutex.Unlock()
+
+	key := model.ResourceKey{
+		Mesh: req.Mesh,
+		Name: AccountSecretName(req.ACME.GetDirectoryUrl(), req.ACME.GetEmail()),
+	}
+
+	if client, ok := m.clients[key]; ok {
+		return client, nil
+	}
+
+	client, err := m.newClient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &acme.Account{}
+	if email := req.ACME.GetEmail(); email != "" {
+		account.Contact = []string{"mailto:" + email}
+	}
+
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, errors.Wrap(err, "failed to register ACME account")
+	}
+
+	m.clients[key] = client
+
+	return client, nil
+}
+
+func (m *Manager) newClient(ctx context.Context, req Request) (*acme.Client, error) {
+	key, err := m.accountKey(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ACME account key")
+	}
+
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: req.ACME.GetDirectoryUrl(),
+	}
+
+	if ca := req.ACME.GetCaCert(); ca != nil {
+		data, err := m.DataSourceLoader.Load(ctx, req.Mesh, ca)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load ACME directory CA certificate")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.New("failed to parse ACME directory CA certificate")
+		}
+
+		client.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:    pool,
+					MinVersion: tls.VersionTLS12,
+				},
+			},
+		}
+	}
+
+	return client, nil
+}
+
+// accountKey returns the key of the ACME account for the requested
+// directory and email, generating and storing a new key the first time.
+func (m *Manager) accountKey(ctx context.Context, req Request) (crypto.Signer, error) {
+	secret := system.NewSecretResource()
+	name := AccountSecretName(req.ACME.GetDirectoryUrl(), req.ACME.GetEmail())
+
+	err := m.ResourceManager.Get(ctx, secret, store.GetByKey(name, req.Mesh))
+	switch {
+	case err == nil:
+		block, _ := pem.Decode(secret.Spec.GetData().GetValue())
+		if block == nil {
+			return nil, errors.Errorf("secret %q does not contain a PEM encoded key", name)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	case !store.IsResourceNotFound(err):
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.Spec = &system_proto.Secret{
+		Data: &wrappers.BytesValue{
+			Value: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+		},
+	}
+
+	if err := m.ResourceManager.Create(ctx, secret, store.CreateBy(model.ResourceKey{Mesh: req.Mesh, Name: name})); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// updateChallenges applies fn to the pending challenges of the requested
+// gateway and stores the result.
+func (m *Manager) updateChallenges(req Request, fn func([]Challenge) []Challenge) error {
+	key := model.ResourceKey{
+		Mesh: req.Mesh,
+		Name: ChallengesSecretName(req.Gateway),
+	}
+
+	return manager.Upsert(m.ResourceManager, key, system.NewSecretResource(), func(resource model.Resource) error {
+		secret := resource.(*system.SecretResource)
+
+		challenges, err := decodeChallenges(secret.Spec)
+		if err != nil {
+			return err
+		}
+
+		challenges = fn(challenges)
+		sort.SliceStable(challenges, func(i, j int) bool {
+			return challenges[i].Hostname < challenges[j].Hostname
+		})
+
+		data, err := json.Marshal(challenges)
+		if err != nil {
+			return err
+		}
+
+		secret.Spec = &system_proto.Secret{
+			Data: &wrappers.BytesValue{Value: data},
+		}
+
+		return nil
+	})
+}
+
+func (m *Manager) upsertSecret(key model.ResourceKey, data []byte) error {
+	return manager.Upsert(m.ResourceManager, key, system.NewSecretResource(), func(resource model.Resource) error {
+		resource.(*system.SecretResource).Spec = &system_proto.Secret{
+			Data: &wrappers.BytesValue{Value: data},
+		}
+		return nil
+	})
+}
+
+func removeChallenges(challenges []Challenge, hostname string) []Challenge {
+	var remaining []Challenge
+
+	for _, c := range challenges {
+		if c.Hostname != hostname {
+			remaining = append(remaining, c)
+		}
+	}
+
+	return remaining
+}
@@ -0,0 +1,296 @@
+package acme_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/register"
+	"github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Manager", func() {
+	var rm manager.ResourceManager
+	var m *acme.Manager
+
+	create := func(r model.Resource, name string, mesh string) {
+		Expect(rm.Create(context.Background(), r, store.CreateByKey(name, mesh))).To(Succeed())
+	}
+
+	createSecret := func(name string, data []byte) {
+		secret := system.NewSecretResource()
+		secret.Spec = &system_proto.Secret{
+			Data: &wrappers.BytesValue{Value: data},
+		}
+		create(secret, name, "default")
+	}
+
+	newCertificate := func(hostname string) []byte {
+		pair, err := tls.NewSelfSignedCert(hostname, tls.ServerCertType, tls.ECDSAKeyType, hostname)
+		Expect(err).ToNot(HaveOccurred())
+		return append(pair.CertPEM, pair.KeyPEM...)
+	}
+
+	BeforeEach(func() {
+		register.RegisterGatewayTypes()
+
+		rm = manager.NewResourceManager(memory.NewStore())
+		m = acme.NewManager(rm, nil)
+
+		create(core_mesh.NewMeshResource(), "default", model.NoMesh)
+
+		gateway := core_mesh.NewMeshGatewayResource()
+		Expect(util_proto.FromYAML([]byte(`
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: https://acme.example.com/directory
+  - hostname: api.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: https://acme.example.com/directory
+  - hostname: static.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: static
+  - hostname: www.example.com
+    port: 80
+    protocol: HTTP
+`), gateway.Spec)).To(Succeed())
+		create(gateway, "edge-gateway", "default")
+	})
+
+	It("should request missing certificates", func() {
+		// when
+		requests, err := m.Requests(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Mesh).To(Equal("default"))
+		Expect(requests[0].Gateway).To(Equal("edge-gateway"))
+		Expect(requests[0].Hostname).To(Equal("www.example.com"))
+		Expect(requests[0].ACME.GetDirectoryUrl()).To(Equal("https://acme.example.com/directory"))
+		Expect(requests[1].Hostname).To(Equal("api.example.com"))
+	})
+
+	It("should not request valid certificates", func() {
+		// given
+		createSecret(acme.CertificateSecretName("edge-gateway", "www.example.com"), newCertificate("www.example.com"))
+		// and a certificate for the wrong hostname
+		createSecret(acme.CertificateSecretName("edge-gateway", "api.example.com"), newCertificate("www.example.com"))
+
+		// when
+		requests, err := m.Requests(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Hostname).To(Equal("api.example.com"))
+	})
+
+	It("should renew expiring certificates", func() {
+		// given
+		createSecret(acme.CertificateSecretName("edge-gateway", "www.example.com"), newCertificate("www.example.com"))
+		createSecret(acme.CertificateSecretName("edge-gateway", "api.example.com"), newCertificate("api.example.com"))
+
+		// when the certificates expire within the renewal period
+		m.RenewBefore = tls.DefaultValidityPeriod + time.Hour
+		requests, err := m.Requests(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("should find the pending challenges of a gateway", func() {
+		// given
+		createSecret(acme.ChallengesSecretName("edge-gateway"),
+			[]byte(`[{"hostname":"www.example.com","token":"abc","keyAuthorization":"abc.xyz"}]`))
+
+		secrets := system.SecretResourceList{}
+		Expect(rm.List(context.Background(), &secrets, store.ListByMesh("default"))).To(Succeed())
+
+		// when
+		challenges, err := acme.ChallengesFor(secrets.Items, "edge-gateway")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(challenges).To(Equal([]acme.Challenge{{
+			Hostname:         "www.example.com",
+			Token:            "abc",
+			KeyAuthorization: "abc.xyz",
+		}}))
+
+		// when
+		challenges, err = acme.ChallengesFor(secrets.Items, "other-gateway")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(challenges).To(BeEmpty())
+	})
+})
+
+var _ = Describe("CertificateSecretName", func() {
+	It("should be a valid secret name", func() {
+		Expect(acme.CertificateSecretName("edge-gateway", "WWW.Example.com")).
+			To(Equal("acme-cert.edge-gateway.www.example.com"))
+	})
+})
+
+var _ = Describe("Manager with an ACME directory", func() {
+	var rm manager.ResourceManager
+	var m *acme.Manager
+	var directory *fakeDirectory
+	var stop chan struct{}
+	var done chan error
+
+	secret := func(name string) []byte {
+		secret := system.NewSecretResource()
+		if err := rm.Get(context.Background(), secret, store.GetByKey(name, "default")); err != nil {
+			return nil
+		}
+		return secret.Spec.GetData().GetValue()
+	}
+
+	BeforeEach(func() {
+		register.RegisterGatewayTypes()
+
+		directory = newFakeDirectory()
+
+		rm = manager.NewResourceManager(memory.NewStore())
+		m = acme.NewManager(rm, nil)
+		m.Interval = 10 * time.Millisecond
+		m.PropagationDelay = 0
+
+		// The challenges are validated by looking them up in the
+		// Secret that the gateway generator serves them from.
+		directory.Validate = func(token string) bool {
+			secrets := system.SecretResourceList{}
+			if err := rm.List(context.Background(), &secrets, store.ListByMesh("default")); err != nil {
+				return false
+			}
+			challenges, err := acme.ChallengesFor(secrets.Items, "edge-gateway")
+			if err != nil {
+				return false
+			}
+			for _, c := range challenges {
+				if c.Token == token {
+					return true
+				}
+			}
+			return false
+		}
+
+		Expect(rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))).To(Succeed())
+
+		gateway := core_mesh.NewMeshGatewayResource()
+		Expect(util_proto.FromYAML([]byte(fmt.Sprintf(`
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: www.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: %[1]s
+        email: admin@example.com
+  - hostname: api.example.com
+    port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: %[1]s
+        email: admin@example.com
+`, directory.DirectoryURL())), gateway.Spec)).To(Succeed())
+		Expect(rm.Create(context.Background(), gateway, store.CreateByKey("edge-gateway", "default"))).To(Succeed())
+
+		stop = make(chan struct{})
+		done = make(chan error)
+	})
+
+	start := func() {
+		go func() {
+			done <- m.Start(stop)
+		}()
+	}
+
+	AfterEach(func() {
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+		directory.Close()
+	})
+
+	It("should obtain the certificates with a single account", func() {
+		// when
+		start()
+
+		// then
+		for _, hostname := range []string{"www.example.com", "api.example.com"} {
+			name := acme.CertificateSecretName("edge-gateway", hostname)
+			Eventually(func() bool {
+				return acme.NeedsRenewal(secret(name), hostname, time.Now())
+			}, "5s", "10ms").Should(BeFalse())
+		}
+
+		Expect(directory.Requests("new-account")).To(Equal(1))
+		Expect(directory.Requests("new-order")).To(Equal(2))
+
+		// and the challenges are no longer served
+		secrets := system.SecretResourceList{}
+		Expect(rm.List(context.Background(), &secrets, store.ListByMesh("default"))).To(Succeed())
+		Expect(acme.ChallengesFor(secrets.Items, "edge-gateway")).To(BeEmpty())
+	})
+
+	It("should back off after failing to obtain a certificate", func() {
+		// given
+		directory.RejectOrders = true
+
+		// when
+		start()
+
+		// then one attempt is made for each hostname
+		Eventually(func() int {
+			return directory.Requests("new-order")
+		}, "5s", "10ms").Should(Equal(2))
+
+		// and the failed certificates aren't requested again right away
+		Consistently(func() int {
+			return directory.Requests("new-order")
+		}, "200ms", "10ms").Should(Equal(2))
+
+		Expect(directory.Requests("new-account")).To(Equal(1))
+	})
+})
@@ -0,0 +1,97 @@
+package acme
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+)
+
+// ChallengePathPrefix is the path prefix that ACME issuers fetch HTTP-01
+// challenge responses from.
+const ChallengePathPrefix = "/.well-known/acme-challenge/"
+
+// Challenge is a pending HTTP-01 challenge that the gateway has to
+// answer for an ACME issuer.
+type Challenge struct {
+	Hostname         string `json:"hostname"`
+	Token            string `json:"token"`
+	KeyAuthorization string `json:"keyAuthorization"`
+}
+
+// CertificateSecretName returns the name of the Secret that holds the
+// ACME certificate and private key for the given gateway hostname.
+func CertificateSecretName(gateway string, hostname string) string {
+	return fmt.Sprintf("acme-cert.%s.%s", gateway, strings.ToLower(hostname))
+}
+
+// ChallengesSecretName returns the name of the Secret that holds the
+// pending HTTP-01 challenges of the given gateway.
+func ChallengesSecretName(gateway string) string {
+	return fmt.Sprintf("acme-challenges.%s", gateway)
+}
+
+// AccountSecretName returns the name of the Secret that holds the key of
+// the ACME account for the given directory and contact email.
+func AccountSecretName(directoryURL string, email string) string {
+	sum := sha256.Sum256([]byte(directoryURL + "\n" + email))
+	return fmt.Sprintf("acme-account.%s", hex.EncodeToString(sum[:8]))
+}
+
+// ChallengesFor returns the pending challenges stored for the given
+// gateway in the list of secrets.
+func ChallengesFor(secrets []*system.SecretResource, gateway string) ([]Challenge, error) {
+	name := ChallengesSecretName(gateway)
+
+	for _, s := range secrets {
+		if s.GetMeta().GetName() == name {
+			return decodeChallenges(s.Spec)
+		}
+	}
+
+	return nil, nil
+}
+
+func decodeChallenges(secret *system_proto.Secret) ([]Challenge, error) {
+	data := secret.GetData().GetValue()
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var challenges []Challenge
+	if err := json.Unmarshal(data, &challenges); err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
+
+// NeedsRenewal returns whether the PEM encoded certificate in data has to
+// be obtained again, because it doesn't cover the hostname or expires
+// before the given deadline.
+func NeedsRenewal(data []byte, hostname string, deadline time.Time) bool {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return true
+		}
+
+		if block.Type == "CERTIFICATE" {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return true
+			}
+
+			return cert.VerifyHostname(hostname) != nil || cert.NotAfter.Before(deadline)
+		}
+
+		data = rest
+	}
+}
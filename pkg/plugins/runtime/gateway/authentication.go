@@ -0,0 +1,183 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// generateAuthentication generates the resources and the HTTP filters
+// that authenticate requests on the given listener. The OIDC flow needs
+// a cluster for the token endpoint and the client and cookie secrets, so
+// these are returned as resources that the listener depends on.
+func generateAuthentication(
+	ctx xds_context.MeshContext,
+	info GatewayListenerInfo,
+) (*core_xds.ResourceSet, []envoy_listeners.FilterChainBuilderOpt, error) {
+	auth := info.Listener.Authentication
+	if auth == nil {
+		return nil, nil, nil
+	}
+
+	resources := core_xds.NewResourceSet()
+
+	var opts []envoy_listeners.FilterChainBuilderOpt
+
+	// The ACME issuer can't authenticate, so the HTTP-01 challenges
+	// have to be answered without authentication.
+	var bypassPathPrefixes []string
+	if info.Listener.Protocol == mesh_proto.MeshGateway_Listener_HTTP && hasACMEListener(info.Gateway) {
+		bypassPathPrefixes = append(bypassPathPrefixes, acme.ChallengePathPrefix)
+	}
+
+	// Both filters are prepended to the HTTP filters, so the OAuth2
+	// filter is configured last to run first and set the bearer token
+	// that the JWT filter may then verify.
+	if jwt := auth.GetJwt(); jwt != nil {
+		jwks := map[string]string{}
+
+		for _, p := range jwt.GetProviders() {
+			data, err := ctx.DataSourceLoader.Load(context.Background(), ctx.Resource.GetMeta().GetName(), p.GetJwks())
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to load JWKS for provider %q", p.GetName())
+			}
+
+			jwks[p.GetName()] = string(data)
+		}
+
+		opts = append(opts, envoy_listeners.JwtAuthn(jwt, jwks, bypassPathPrefixes...))
+	}
+
+	if oidc := auth.GetOidc(); oidc != nil {
+		cluster, err := generateTokenEndpointCluster(ctx, info, oidc.GetTokenEndpoint(), oidc.GetCaCert())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate OIDC token endpoint cluster")
+		}
+
+		resources.Add(cluster)
+
+		clientSecret, err := generateGenericSecret(ctx,
+			names.GetSecretName("oidc.client", "listener", info.Listener.ResourceName), oidc.GetClientSecret())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to load OIDC client secret")
+		}
+
+		hmacSecret, err := generateGenericSecret(ctx,
+			names.GetSecretName("oidc.hmac", "listener", info.Listener.ResourceName), oidc.GetHmacSecret())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to load OIDC HMAC secret")
+		}
+
+		resources.Add(NewResource(clientSecret.Name, clientSecret))
+		resources.Add(NewResource(hmacSecret.Name, hmacSecret))
+
+		opts = append(opts, envoy_listeners.OAuth2(oidc, cluster.Name, clientSecret.Name, hmacSecret.Name, bypassPathPrefixes...))
+	}
+
+	return resources, opts, nil
+}
+
+// systemCaPath is the path of the CA bundle in the kuma-dp image. It
+// verifies token endpoints that don't configure a CA certificate.
+const systemCaPath = "/etc/ssl/certs/ca-certificates.crt"
+
+// generateTokenEndpointCluster generates a cluster that reaches the host
+// of the given HTTPS token endpoint URL. The endpoint is verified with
+// the given CA certificate, or with the system trust store if there is
+// none, and its certificate must match the endpoint hostname.
+func generateTokenEndpointCluster(
+	ctx xds_context.MeshContext,
+	info GatewayListenerInfo,
+	endpoint string,
+	caCert *system_proto.DataSource,
+) (*core_xds.Resource, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "https" {
+		return nil, errors.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	portNum, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port %q", port)
+	}
+
+	ep := core_xds.Endpoint{
+		Target: u.Hostname(),
+		Port:   uint32(portNum),
+		ExternalService: &core_xds.ExternalService{
+			TLSEnabled:   true,
+			ServerName:   u.Hostname(),
+			SystemCaPath: systemCaPath,
+		},
+	}
+
+	if caCert != nil {
+		ca, err := ctx.DataSourceLoader.Load(context.Background(), ctx.Resource.GetMeta().GetName(), caCert)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load CA certificate")
+		}
+
+		ep.ExternalService.CaCert = ca
+	}
+
+	name := names.Join("oidc", info.Listener.ResourceName, net.JoinHostPort(u.Hostname(), port))
+
+	msg, err := clusters.NewClusterBuilder(info.Proxy.APIVersion).Configure(
+		clusters.ProvidedEndpointCluster(name, info.Proxy.Dataplane.IsIPv6(), ep),
+		clusters.ClientSideTLS([]core_xds.Endpoint{ep}),
+	).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResource(name, msg), nil
+}
+
+// generateGenericSecret loads the given datasource into a generic secret
+// that filters can reference over SDS.
+func generateGenericSecret(
+	ctx xds_context.MeshContext,
+	name string,
+	source *system_proto.DataSource,
+) (*envoy_tls.Secret, error) {
+	data, err := ctx.DataSourceLoader.Load(context.Background(), ctx.Resource.GetMeta().GetName(), source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_tls.Secret{
+		Name: name,
+		Type: &envoy_tls.Secret_GenericSecret{
+			GenericSecret: &envoy_tls.GenericSecret{
+				Secret: &envoy_config_core.DataSource{
+					Specifier: &envoy_config_core.DataSource_InlineBytes{
+						InlineBytes: data,
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"math"
 
 	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/pkg/errors"
@@ -113,19 +114,157 @@ func (c *ClusterGenerator) GenerateClusters(ctx xds_context.Context, info Gatewa
 	return resources, nil
 }
 
+// GenerateUDPCluster generates the cluster that the UDP proxy forwards
+// the datagrams of the route entry to. The UDP proxy can only forward
+// to a single cluster, so the endpoints of all the destinations are
+// combined in one cluster and the destination weights are spread over
+// the weights of their endpoints.
+//
+// Mesh proxies, zone ingresses and zone egresses don't proxy UDP, so
+// the datagrams are sent directly to the endpoints, outside of the mesh
+// mTLS. This means that only the endpoints in the local zone and the
+// external services that are reachable without TLS can be used. It
+// returns nil if there is no such endpoint.
+func (c *ClusterGenerator) GenerateUDPCluster(ctx xds_context.Context, info GatewayListenerInfo, entry route.Entry) (*core_xds.Resource, error) {
+	if len(entry.Action.Forward) == 0 {
+		return nil, errors.Errorf("route %q has no destinations", entry.Route)
+	}
+
+	type weightedEndpoints struct {
+		weight    uint32
+		endpoints []core_xds.Endpoint
+		// total is the sum of the weights of the endpoints.
+		total uint64
+	}
+
+	var destinations []weightedEndpoints
+
+	for _, dest := range entry.Action.Forward {
+		weight := dest.Weight
+
+		// Like Envoy weighted clusters, a destination with no
+		// weight only gets traffic when it is the only one.
+		if weight == 0 {
+			if len(entry.Action.Forward) > 1 {
+				continue
+			}
+			weight = 1
+		}
+
+		d := weightedEndpoints{weight: weight}
+		for _, endpoint := range c.udpEndpoints(ctx.Mesh.Resource, info, dest) {
+			d.endpoints = append(d.endpoints, endpoint)
+			d.total += uint64(endpointWeight(endpoint))
+		}
+
+		if len(d.endpoints) > 0 {
+			destinations = append(destinations, d)
+		}
+	}
+
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+
+	// The endpoints of each destination are scaled to the same total
+	// weight, so that the share of each destination is its weight.
+	scale := uint64(1)
+	for _, d := range destinations {
+		scale = lcm(scale, d.total)
+	}
+
+	var endpoints []core_xds.Endpoint
+
+	for _, d := range destinations {
+		for _, endpoint := range d.endpoints {
+			weight := saturatingMultiply(uint64(endpointWeight(endpoint)), uint64(d.weight))
+			endpoint.Weight = uint32(saturatingMultiply(weight, scale/d.total))
+			// All the endpoints are in the local zone, so they
+			// are not weighted or prioritized by locality.
+			endpoint.Locality = nil
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	dest := entry.Action.Forward[0]
+
+	return buildClusterResource(
+		&dest,
+		clusters.NewClusterBuilder(info.Proxy.APIVersion).Configure(
+			clusters.ProvidedEndpointCluster(dest.Destination[mesh_proto.ServiceTag], info.Proxy.Dataplane.IsIPv6(), endpoints...),
+		),
+	)
+}
+
+// udpEndpoints returns the endpoints of the destination that the
+// gateway can send datagrams to directly.
+func (c *ClusterGenerator) udpEndpoints(mesh *core_mesh.MeshResource, info GatewayListenerInfo, dest route.Destination) []core_xds.Endpoint {
+	var endpoints []core_xds.Endpoint
+
+	for _, endpoint := range info.OutboundEndpoints[dest.Destination[mesh_proto.ServiceTag]] {
+		if !endpoint.ContainsTags(dest.Destination) || endpoint.UnixDomainPath != "" {
+			continue
+		}
+
+		if endpoint.IsExternalService() {
+			// With ZoneEgress enabled, the endpoints of external
+			// services are zone egresses.
+			if mesh.ZoneEgressEnabled() || endpoint.ExternalService.TLSEnabled {
+				continue
+			}
+		} else if zone, ok := endpoint.Tags[mesh_proto.ZoneTag]; ok && zone != c.Zone {
+			// The endpoints of the other zones are zone ingresses
+			// or zone egresses.
+			continue
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints
+}
+
+// lcm returns the least common multiple of the weights.
+func lcm(a, b uint64) uint64 {
+	x, y := a, b
+	for y != 0 {
+		x, y = y, x%y
+	}
+
+	return saturatingMultiply(a/x, b)
+}
+
+// saturatingMultiply multiplies the weights, saturating at the
+// maximum weight.
+func saturatingMultiply(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint32/a {
+		return math.MaxUint32
+	}
+
+	return a * b
+}
+
+func endpointWeight(endpoint core_xds.Endpoint) uint32 {
+	if endpoint.Weight == 0 {
+		return 1
+	}
+
+	return endpoint.Weight
+}
+
 func (c *ClusterGenerator) generateMeshCluster(
 	mesh *core_mesh.MeshResource,
 	info GatewayListenerInfo,
 	dest *route.Destination,
 	upstreamServiceName string,
 ) (*core_xds.Resource, error) {
-	protocol := route.InferServiceProtocol([]core_xds.Endpoint{{
+	protocol := upstreamProtocol(info, route.InferServiceProtocol([]core_xds.Endpoint{{
 		Tags: dest.Destination,
-	}})
+	}}))
 
 	builder := newClusterBuilder(info.Proxy.APIVersion, protocol, dest).Configure(
 		clusters.EdsCluster(dest.Destination[mesh_proto.ServiceTag]),
-		clusters.LB(nil /* TODO(jpeach) uses default Round Robin*/),
+		clusters.LB(dest.LoadBalancer),
 		clusters.ClientSideMTLS(mesh, upstreamServiceName, true, []envoy.Tags{dest.Destination}),
 	)
 
@@ -155,17 +294,31 @@ func (c *ClusterGenerator) generateExternalCluster(
 		endpoints = append(endpoints, *ep)
 	}
 
-	protocol := route.InferServiceProtocol(endpoints)
+	protocol := upstreamProtocol(info, route.InferServiceProtocol(endpoints))
 
 	return buildClusterResource(
 		dest,
 		newClusterBuilder(info.Proxy.APIVersion, protocol, dest).Configure(
 			clusters.ProvidedEndpointCluster(dest.Destination[mesh_proto.ServiceTag], info.Proxy.Dataplane.IsIPv6(), endpoints...),
+			clusters.LB(dest.LoadBalancer),
 			clusters.ClientSideTLS(endpoints),
 		),
 	)
 }
 
+// upstreamProtocol returns the protocol used to connect to the
+// upstream service. TCP and TLS listeners proxy raw connections, so they
+// never speak HTTP to the upstream, whatever protocol the service declares.
+func upstreamProtocol(info GatewayListenerInfo, protocol core_mesh.Protocol) core_mesh.Protocol {
+	switch info.Listener.Protocol {
+	case mesh_proto.MeshGateway_Listener_TCP,
+		mesh_proto.MeshGateway_Listener_TLS:
+		return core_mesh.ProtocolTCP
+	default:
+		return protocol
+	}
+}
+
 func newClusterBuilder(
 	version envoy.APIVersion,
 	protocol core_mesh.Protocol,
@@ -174,6 +327,7 @@ func newClusterBuilder(
 	builder := clusters.NewClusterBuilder(version).Configure(
 		clusters.Timeout(protocol, timeoutPolicyFor(dest)),
 		clusters.CircuitBreaker(circuitBreakerPolicyFor(dest)),
+		clusters.RetryBudget(retryPolicyFor(dest)),
 		clusters.OutlierDetection(circuitBreakerPolicyFor(dest)),
 		clusters.HealthCheck(protocol, healthCheckPolicyFor(dest)),
 	)
@@ -251,6 +405,14 @@ func circuitBreakerPolicyFor(dest *route.Destination) *core_mesh.CircuitBreakerR
 	return nil // TODO(jpeach) default circuit breaker policy
 }
 
+func retryPolicyFor(dest *route.Destination) *core_mesh.RetryResource {
+	if policy, ok := dest.Policies[core_mesh.RetryType]; ok {
+		return policy.(*core_mesh.RetryResource)
+	}
+
+	return nil
+}
+
 func healthCheckPolicyFor(dest *route.Destination) *core_mesh.HealthCheckResource {
 	if policy, ok := dest.Policies[core_mesh.HealthCheckType]; ok {
 		return policy.(*core_mesh.HealthCheckResource)
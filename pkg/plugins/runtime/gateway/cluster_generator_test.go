@@ -0,0 +1,181 @@
+package gateway_test
+
+import (
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("UDP cluster", func() {
+	type endpoint struct {
+		address string
+		weight  uint32
+	}
+
+	generate := func(mesh *core_mesh.MeshResource, endpoints core_xds.EndpointMap, forward ...route.Destination) []endpoint {
+		dataplane := core_mesh.NewDataplaneResource()
+		dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+			Address: "192.168.1.1",
+		}
+
+		generator := gateway.ClusterGenerator{Zone: "zone-1"}
+		resource, err := generator.GenerateUDPCluster(
+			xds_context.Context{Mesh: xds_context.MeshContext{Resource: mesh}},
+			gateway.GatewayListenerInfo{
+				Proxy: &core_xds.Proxy{
+					APIVersion: envoy.APIV3,
+					Dataplane:  dataplane,
+				},
+				OutboundEndpoints: endpoints,
+			},
+			route.Entry{
+				Route:  "udp",
+				Action: route.Action{Forward: forward},
+			},
+		)
+		Expect(err).To(Succeed())
+
+		if resource == nil {
+			return nil
+		}
+
+		var result []endpoint
+		for _, locality := range resource.Resource.(*envoy_cluster_v3.Cluster).GetLoadAssignment().GetEndpoints() {
+			for _, lbEndpoint := range locality.GetLbEndpoints() {
+				result = append(result, endpoint{
+					address: lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress(),
+					weight:  lbEndpoint.GetLoadBalancingWeight().GetValue(),
+				})
+			}
+		}
+
+		return result
+	}
+
+	dns := func(weight uint32) route.Destination {
+		return route.Destination{
+			Destination: map[string]string{mesh_proto.ServiceTag: "dns"},
+			Weight:      weight,
+		}
+	}
+
+	It("should only forward to endpoints that can receive datagrams", func() {
+		// given
+		endpoints := core_xds.EndpointMap{
+			"dns": []core_xds.Endpoint{{
+				// Local dataplane.
+				Target: "192.168.1.10",
+				Port:   53,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns", mesh_proto.ZoneTag: "zone-1"},
+				Weight: 1,
+			}, {
+				// Zone ingress of the other zone.
+				Target: "10.0.0.1",
+				Port:   10001,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns", mesh_proto.ZoneTag: "zone-2"},
+				Weight: 1,
+			}, {
+				// External service with TLS.
+				Target:          "dns.example.com",
+				Port:            853,
+				Tags:            map[string]string{mesh_proto.ServiceTag: "dns"},
+				Weight:          1,
+				ExternalService: &core_xds.ExternalService{TLSEnabled: true},
+			}},
+		}
+
+		// when
+		actual := generate(core_mesh.NewMeshResource(), endpoints, dns(1))
+
+		// then
+		Expect(actual).To(ConsistOf(endpoint{address: "192.168.1.10", weight: 1}))
+	})
+
+	It("should not forward to external services through zone egress", func() {
+		// given
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Routing = &mesh_proto.Routing{ZoneEgress: true}
+		endpoints := core_xds.EndpointMap{
+			"dns": []core_xds.Endpoint{{
+				Target:          "192.168.1.20",
+				Port:            10002,
+				Tags:            map[string]string{mesh_proto.ServiceTag: "dns"},
+				Weight:          1,
+				ExternalService: &core_xds.ExternalService{},
+			}},
+		}
+
+		// when
+		actual := generate(mesh, endpoints, dns(1))
+
+		// then
+		Expect(actual).To(BeEmpty())
+	})
+
+	It("should spread the destination weights over the endpoints", func() {
+		// given
+		endpoints := core_xds.EndpointMap{
+			"dns": []core_xds.Endpoint{{
+				Target: "192.168.1.10",
+				Port:   53,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns", "version": "v1"},
+				Weight: 1,
+			}, {
+				Target: "192.168.1.11",
+				Port:   53,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns", "version": "v1"},
+				Weight: 2,
+			}, {
+				Target: "192.168.1.12",
+				Port:   53,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns", "version": "v2"},
+				Weight: 1,
+			}},
+		}
+
+		// when
+		actual := generate(core_mesh.NewMeshResource(), endpoints,
+			route.Destination{
+				Destination: map[string]string{mesh_proto.ServiceTag: "dns", "version": "v1"},
+				Weight:      90,
+			},
+			route.Destination{
+				Destination: map[string]string{mesh_proto.ServiceTag: "dns", "version": "v2"},
+				Weight:      10,
+			},
+		)
+
+		// then
+		Expect(actual).To(ConsistOf(
+			endpoint{address: "192.168.1.10", weight: 90},
+			endpoint{address: "192.168.1.11", weight: 180},
+			endpoint{address: "192.168.1.12", weight: 30},
+		))
+	})
+
+	It("should saturate large weights", func() {
+		// given
+		endpoints := core_xds.EndpointMap{
+			"dns": []core_xds.Endpoint{{
+				Target: "192.168.1.10",
+				Port:   53,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "dns"},
+				Weight: 1 << 31,
+			}},
+		}
+
+		// when
+		actual := generate(core_mesh.NewMeshResource(), endpoints, dns(1000))
+
+		// then
+		Expect(actual).To(ConsistOf(endpoint{address: "192.168.1.10", weight: 1<<32 - 1}))
+	})
+})
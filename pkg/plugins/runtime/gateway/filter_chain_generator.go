@@ -6,6 +6,7 @@ import (
 	"crypto/rsa"
 	"encoding/pem"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
 	"github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
@@ -67,9 +70,14 @@ func (g *HTTPFilterChainGenerator) Generate(
 ) {
 	log.V(1).Info("generating filter chain", "protocol", "HTTP")
 
+	resources, authn, err := generateAuthentication(ctx, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// HTTP listeners get a single filter chain for all hostnames. So
 	// if there's already a filter chain, we have nothing to do.
-	return nil, []*envoy_listeners.FilterChainBuilder{newFilterChain(ctx, info)}, nil
+	return resources, []*envoy_listeners.FilterChainBuilder{newFilterChain(ctx, info, authn)}, nil
 }
 
 // HTTPSFilterChainGenerator generates a filter chain for an HTTPS listener.
@@ -83,6 +91,15 @@ func (g *HTTPSFilterChainGenerator) Generate(
 ) {
 	resources := core_xds.NewResourceSet()
 
+	authResources, authn, err := generateAuthentication(ctx, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if authResources != nil {
+		resources.AddSet(authResources)
+	}
+
 	var filterChainBuilders []*envoy_listeners.FilterChainBuilder
 
 	for _, host := range hosts {
@@ -93,8 +110,18 @@ func (g *HTTPSFilterChainGenerator) Generate(
 
 		switch host.TLS.GetMode() {
 		case mesh_proto.MeshGateway_TLS_TERMINATE:
+			certificates := host.TLS.GetCertificates()
+
+			if host.TLS.GetAcme() != nil {
+				certificates = []*system_proto.DataSource{{
+					Type: &system_proto.DataSource_Secret{
+						Secret: acme.CertificateSecretName(info.Gateway.GetMeta().GetName(), host.Hostname),
+					},
+				}}
+			}
+
 			// Note that Envoy 1.184 and earlier will only accept 1 SDS reference.
-			for _, cert := range host.TLS.GetCertificates() {
+			for _, cert := range certificates {
 				secret, err := g.generateCertificateSecret(ctx, host, cert)
 				if err != nil {
 					return nil, nil, errors.Wrap(err, "failed to generate TLS certificate")
@@ -108,14 +135,15 @@ func (g *HTTPSFilterChainGenerator) Generate(
 			}
 
 		case mesh_proto.MeshGateway_TLS_PASSTHROUGH:
-			// TODO(jpeach) add support for PASSTHROUGH mode.
-			return nil, nil, errors.Errorf("unsupported TLS mode %q", host.TLS.GetMode())
+			// HTTP routing needs to decrypt the requests, so
+			// passthrough is only supported by TLS listeners.
+			return nil, nil, errors.Errorf("TLS mode %q is only supported on TLS listeners", host.TLS.GetMode())
 
 		default:
 			return nil, nil, errors.Errorf("unsupported TLS mode %q", host.TLS.GetMode())
 		}
 
-		builder := newFilterChain(ctx, info)
+		builder := newFilterChain(ctx, info, authn)
 
 		builder.Configure(
 			envoy_listeners.MatchTransportProtocol("tls"),
@@ -193,6 +221,131 @@ func (g *HTTPSFilterChainGenerator) generateCertificateSecret(
 	return tlsSecret, err
 }
 
+// TCPFilterChainGenerator generates a filter chain for a TCP listener.
+type TCPFilterChainGenerator struct {
+}
+
+func (g *TCPFilterChainGenerator) Generate(
+	ctx xds_context.MeshContext, info GatewayListenerInfo, _ []GatewayHost,
+) (
+	*core_xds.ResourceSet, []*envoy_listeners.FilterChainBuilder, error,
+) {
+	log.V(1).Info("generating filter chain", "protocol", "TCP")
+
+	// There is nothing to match TCP connections on, so all the
+	// connections are forwarded to the backends of the single
+	// route entry.
+	var destinations []route.Destination
+	for _, hostInfo := range info.HostInfos {
+		if len(hostInfo.Entries) > 0 {
+			destinations = hostInfo.Entries[0].Action.Forward
+			break
+		}
+	}
+
+	clusters := tcpProxyClusters(destinations)
+	if len(clusters) == 0 {
+		return nil, nil, nil
+	}
+
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	builder := envoy_listeners.NewFilterChainBuilder(info.Proxy.APIVersion).Configure(
+		envoy_listeners.TcpProxy(service, clusters...),
+		ListenerNetworkAccessLog(ctx, info),
+	)
+
+	return nil, []*envoy_listeners.FilterChainBuilder{builder}, nil
+}
+
+// TLSFilterChainGenerator generates the filter chains for a TLS
+// listener. The TLS sessions are not terminated, but routed to the
+// backends by the SNI hostname sent by the client.
+type TLSFilterChainGenerator struct {
+}
+
+func (g *TLSFilterChainGenerator) Generate(
+	ctx xds_context.MeshContext, info GatewayListenerInfo, _ []GatewayHost,
+) (
+	*core_xds.ResourceSet, []*envoy_listeners.FilterChainBuilder, error,
+) {
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	var filterChainBuilders []*envoy_listeners.FilterChainBuilder
+
+	for _, hostInfo := range info.HostInfos {
+		log.V(1).Info("generating filter chain",
+			"protocol", "TLS",
+			"hostname", hostInfo.Host.Hostname,
+		)
+
+		// Each host has at most one reachable route entry.
+		if len(hostInfo.Entries) == 0 {
+			continue
+		}
+
+		clusters := tcpProxyClusters(hostInfo.Entries[0].Action.Forward)
+		if len(clusters) == 0 {
+			continue
+		}
+
+		// The TLS inspector listener filter extracts the SNI
+		// hostname, so that the filter chain can match it.
+		builder := envoy_listeners.NewFilterChainBuilder(info.Proxy.APIVersion).Configure(
+			envoy_listeners.MatchTransportProtocol("tls"),
+			envoy_listeners.MatchServerNames(hostInfo.Host.Hostname),
+			envoy_listeners.TcpProxy(service, clusters...),
+			ListenerNetworkAccessLog(ctx, info),
+		)
+
+		filterChainBuilders = append(filterChainBuilders, builder)
+	}
+
+	return nil, filterChainBuilders, nil
+}
+
+// tcpProxyClusters converts route destinations to the weighted clusters
+// of a TCP proxy. Destinations that resolve to the same cluster have
+// their weights combined.
+func tcpProxyClusters(destinations []route.Destination) []envoy.Cluster {
+	weights := map[string]uint32{}
+	tags := map[string]envoy.Tags{}
+
+	for _, d := range destinations {
+		weights[d.Name] += d.Weight
+		tags[d.Name] = d.Destination
+	}
+
+	var names []string
+	for name := range weights {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var clusters []envoy.Cluster
+
+	for _, name := range names {
+		// Envoy requires weighted clusters to have a non-zero weight,
+		// so a backend with no weight only gets traffic when it is
+		// the only backend.
+		if weights[name] == 0 && len(names) > 1 {
+			continue
+		}
+
+		clusters = append(clusters, envoy.NewCluster(
+			envoy.WithName(name),
+			envoy.WithService(tags[name][mesh_proto.ServiceTag]),
+			envoy.WithWeight(weights[name]),
+			envoy.WithTags(tags[name]),
+		))
+	}
+
+	return clusters
+}
+
 func newDownstreamTypedConfig() *envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext {
 	conf := &envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext{
 		CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
@@ -215,7 +368,11 @@ func newDownstreamTypedConfig() *envoy_extensions_transport_sockets_tls_v3.Downs
 	return conf
 }
 
-func newFilterChain(ctx xds_context.MeshContext, info GatewayListenerInfo) *envoy_listeners.FilterChainBuilder {
+func newFilterChain(
+	ctx xds_context.MeshContext,
+	info GatewayListenerInfo,
+	authn []envoy_listeners.FilterChainBuilderOpt,
+) *envoy_listeners.FilterChainBuilder {
 	// A Gateway is a single service across all listeners.
 	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
 
@@ -259,30 +416,41 @@ func newFilterChain(ctx xds_context.MeshContext, info GatewayListenerInfo) *envo
 		// Force the ratelimit filter to always be present. This
 		// is a no-op unless we later add a per-route configuration.
 		envoy_listeners.RateLimit([]*core_mesh.RateLimitResource{nil}),
-		envoy_listeners.DefaultCompressorFilter(),
-		envoy_listeners.Tracing(ctx.GetTracingBackend(info.Proxy.Policies.TrafficTrace), service),
-		// In mesh proxies, the access log is configured on the outbound
-		// listener, which is why we index the Logs slice by destination
-		// service name.  A Gateway listener by definition forwards traffic
-		// to multiple destinations, so rather than making up some arbitrary
-		// rules about which destination service we should accept here, we
-		// match the log policy for the generic pass through service. This
-		// will be the only policy available for a Dataplane with no outbounds.
-		envoy_listeners.HttpAccessLog(
-			ctx.Resource.Meta.GetName(),
-			envoy.TrafficDirectionInbound,
-			service,                // Source service is the gateway service.
-			mesh_proto.MatchAllTag, // Destination service could be anywhere, depending on the routes.
-			ctx.GetLoggingBackend(info.Proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
-			info.Proxy,
-		),
+		compressorFilter(info.Listener.Compression),
+		ListenerTracing(ctx, info),
+		ListenerHTTPAccessLog(ctx, info),
 	)
 
+	// The authentication filters are prepended to the filter chain, so
+	// they run before the other filters, but after the CORS filter
+	// that is prepended last.
+	builder.Configure(authn...)
+
+	// The CORS policies are set on the virtual hosts, but they are
+	// only enforced if the listener has the CORS filter.
+	for _, hostInfo := range info.HostInfos {
+		if hostInfo.Host.Cors != nil {
+			builder.Configure(envoy_listeners.CorsFilter())
+			break
+		}
+	}
+
 	// TODO(jpeach) if proxy protocol is enabled, add the proxy protocol listener filter.
 
 	return builder
 }
 
+// compressorFilter configures the compressor filter from the given
+// Compression policy. If there is no policy, the default gzip compressor
+// is used.
+func compressorFilter(compression *core_mesh.CompressionResource) envoy_listeners.FilterChainBuilderOpt {
+	if compression == nil {
+		return envoy_listeners.DefaultCompressorFilter()
+	}
+
+	return envoy_listeners.Compression(compression)
+}
+
 // newServerSecret parses a blob that contains one or more PEM object
 // to create a single Envoy TLS certificate secret. The resulting secret
 // must have exactly one private key, and at least one certificate.
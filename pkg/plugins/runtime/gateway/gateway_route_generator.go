@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"sort"
 	"strings"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -119,6 +120,159 @@ func GenerateEnvoyRouteEntries(host GatewayHost) []route.Entry {
 	return PopulatePolicies(host, entries)
 }
 
+// GenerateEnvoyTCPRouteEntries generates route entries for the TCP
+// routes attached to the given host. TCP routes have no match criteria,
+// so there is nothing to choose a rule by. A single entry forwards the
+// connections to the backends of all the rules of all the routes.
+func GenerateEnvoyTCPRouteEntries(host GatewayHost) []route.Entry {
+	gatewayRoutes := filterGatewayRoutes(host.Routes, func(route *core_mesh.MeshGatewayRouteResource) bool {
+		return len(route.Spec.GetConf().GetTcp().GetRules()) > 0
+	})
+
+	entry := makeMergedForwardEntry(gatewayRoutes, func(route *core_mesh.MeshGatewayRouteResource) [][]*mesh_proto.MeshGatewayRoute_Backend {
+		var backends [][]*mesh_proto.MeshGatewayRoute_Backend
+		for _, rule := range route.Spec.GetConf().GetTcp().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+		return backends
+	})
+	if entry == nil {
+		return nil
+	}
+
+	return PopulatePolicies(host, []route.Entry{*entry})
+}
+
+// GenerateEnvoyUDPRouteEntries generates route entries for the UDP
+// routes attached to the given host. Like TCP routes, UDP routes have
+// no match criteria, so a single entry forwards the datagrams to the
+// backends of all the rules of all the routes.
+func GenerateEnvoyUDPRouteEntries(host GatewayHost) []route.Entry {
+	gatewayRoutes := filterGatewayRoutes(host.Routes, func(route *core_mesh.MeshGatewayRouteResource) bool {
+		return len(route.Spec.GetConf().GetUdp().GetRules()) > 0
+	})
+
+	entry := makeMergedForwardEntry(gatewayRoutes, func(route *core_mesh.MeshGatewayRouteResource) [][]*mesh_proto.MeshGatewayRoute_Backend {
+		var backends [][]*mesh_proto.MeshGatewayRoute_Backend
+		for _, rule := range route.Spec.GetConf().GetUdp().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+		return backends
+	})
+	if entry == nil {
+		return nil
+	}
+
+	return PopulatePolicies(host, []route.Entry{*entry})
+}
+
+// GenerateEnvoyTLSRouteEntries generates route entries for the TLS
+// routes attached to the given host. The host is matched to the routes
+// by SNI hostname, and then, like TCP routes, a single entry forwards
+// the connections to the backends of all the rules of the most specific
+// routes.
+func GenerateEnvoyTLSRouteEntries(host GatewayHost) []route.Entry {
+	gatewayRoutes := filterGatewayRoutes(host.Routes, func(route *core_mesh.MeshGatewayRouteResource) bool {
+		if len(route.Spec.GetConf().GetTls().GetRules()) == 0 {
+			return false
+		}
+
+		// Wildcard hosts accept all routes.
+		if host.Hostname == WildcardHostname {
+			return true
+		}
+
+		// If the route has no hostnames, it matches all hosts.
+		names := route.Spec.GetConf().GetTls().GetHostnames()
+		if len(names) == 0 {
+			return true
+		}
+
+		return match.Hostnames(host.Hostname, names...)
+	})
+
+	// Routes that list a matching hostname are more specific than the
+	// routes without hostnames, so they take precedence.
+	var specific []*core_mesh.MeshGatewayRouteResource
+	for _, r := range gatewayRoutes {
+		if len(r.Spec.GetConf().GetTls().GetHostnames()) > 0 {
+			specific = append(specific, r)
+		}
+	}
+	if len(specific) > 0 {
+		gatewayRoutes = specific
+	}
+
+	entry := makeMergedForwardEntry(gatewayRoutes, func(route *core_mesh.MeshGatewayRouteResource) [][]*mesh_proto.MeshGatewayRoute_Backend {
+		var backends [][]*mesh_proto.MeshGatewayRoute_Backend
+		for _, rule := range route.Spec.GetConf().GetTls().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+		return backends
+	})
+	if entry == nil {
+		return nil
+	}
+
+	return PopulatePolicies(host, []route.Entry{*entry})
+}
+
+// firstGatewayRoute returns the route that sorts first by name, or nil
+// if there are no routes.
+func firstGatewayRoute(routes []*core_mesh.MeshGatewayRouteResource) *core_mesh.MeshGatewayRouteResource {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].GetMeta().GetName() < routes[j].GetMeta().GetName()
+	})
+
+	return routes[0]
+}
+
+// makeMergedForwardEntry makes a route entry that forwards to the
+// backends of all the rules of the given routes, or returns nil if
+// there are no routes. The backends share the traffic according to
+// their weights, and a backend that is in several rules gets the sum
+// of its weights. The entry is named after the route that sorts first
+// by name.
+//
+// A backend with no weight gets all the traffic of its rule when it
+// is the only backend of the rule, so it is given a weight of 1 to
+// keep a share of the traffic.
+func makeMergedForwardEntry(
+	routes []*core_mesh.MeshGatewayRouteResource,
+	rules func(*core_mesh.MeshGatewayRouteResource) [][]*mesh_proto.MeshGatewayRoute_Backend,
+) *route.Entry {
+	first := firstGatewayRoute(routes)
+	if first == nil {
+		return nil
+	}
+
+	entry := route.Entry{
+		Route: first.GetMeta().GetName(),
+	}
+
+	for _, r := range routes {
+		for _, backends := range rules(r) {
+			for _, b := range backends {
+				weight := b.GetWeight()
+				if weight == 0 && len(backends) == 1 {
+					weight = 1
+				}
+
+				entry.Action.Forward = append(entry.Action.Forward, route.Destination{
+					Destination: b.GetDestination(),
+					Weight:      weight,
+				})
+			}
+		}
+	}
+
+	return &entry
+}
+
 func makeRouteEntry(name string, rule *mesh_proto.MeshGatewayRoute_HttpRoute_Rule) route.Entry {
 	entry := route.Entry{
 		Route: name,
@@ -126,9 +280,10 @@ func makeRouteEntry(name string, rule *mesh_proto.MeshGatewayRoute_HttpRoute_Rul
 
 	for _, b := range rule.GetBackends() {
 		target := route.Destination{
-			Destination: b.GetDestination(),
-			Weight:      b.GetWeight(),
-			Policies:    nil,
+			Destination:  b.GetDestination(),
+			Weight:       b.GetWeight(),
+			Policies:     nil,
+			LoadBalancer: rule.GetLoadBalancer(),
 		}
 
 		entry.Action.Forward = append(entry.Action.Forward, target)
@@ -150,29 +305,69 @@ func makeRouteEntry(name string, rule *mesh_proto.MeshGatewayRoute_HttpRoute_Rul
 					Destination: m.Backend.GetDestination(),
 				},
 			}
+		} else if d := f.GetDirectResponse(); d != nil {
+			entry.Action.Respond = &route.DirectResponse{
+				Status: d.GetStatusCode(),
+				Body:   d.GetBody(),
+			}
 		} else if h := f.GetRequestHeader(); h != nil {
 			if entry.RequestHeaders == nil {
 				entry.RequestHeaders = &route.Headers{}
 			}
 
-			for _, s := range h.GetSet() {
-				entry.RequestHeaders.Replace = append(
-					entry.RequestHeaders.Replace, route.Pair(s.GetName(), s.GetValue()))
+			addHeaders(entry.RequestHeaders, h.GetSet(), h.GetAdd(), h.GetRemove())
+		} else if h := f.GetResponseHeader(); h != nil {
+			if entry.ResponseHeaders == nil {
+				entry.ResponseHeaders = &route.Headers{}
 			}
 
-			for _, s := range h.GetAdd() {
-				entry.RequestHeaders.Append = append(
-					entry.RequestHeaders.Append, route.Pair(s.GetName(), s.GetValue()))
-			}
-
-			entry.RequestHeaders.Delete = append(
-				entry.RequestHeaders.Delete, h.GetRemove()...)
+			addHeaders(entry.ResponseHeaders, h.GetSet(), h.GetAdd(), h.GetRemove())
+		} else if r := f.GetRewrite(); r != nil {
+			entry.Rewrite = makeRewrite(r)
 		}
 	}
 
 	return entry
 }
 
+func addHeaders(
+	headers *route.Headers,
+	set []*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+	add []*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+	remove []string,
+) {
+	for _, s := range set {
+		headers.Replace = append(headers.Replace, route.Pair(s.GetName(), s.GetValue()))
+	}
+
+	for _, s := range add {
+		headers.Append = append(headers.Append, route.Pair(s.GetName(), s.GetValue()))
+	}
+
+	headers.Delete = append(headers.Delete, remove...)
+}
+
+func makeRewrite(r *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite) *route.Rewrite {
+	rewrite := &route.Rewrite{
+		Host:                  r.GetHostname(),
+		HostToBackendHostname: r.GetHostToBackendHostname(),
+	}
+
+	switch p := r.GetPath().(type) {
+	case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull:
+		rewrite.ReplaceFullPath = &p.ReplaceFull
+	case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch:
+		rewrite.ReplacePrefixMatch = &p.ReplacePrefixMatch
+	case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceRegex:
+		rewrite.RegexPath = &route.RegexSubstitution{
+			Pattern:      p.ReplaceRegex.GetPattern(),
+			Substitution: p.ReplaceRegex.GetSubstitution(),
+		}
+	}
+
+	return rewrite
+}
+
 func makeRouteMatch(ruleMatch *mesh_proto.MeshGatewayRoute_HttpRoute_Match) route.Match {
 	match := route.Match{}
 
@@ -1287,6 +1287,245 @@ conf:
       backends:
       - destination:
           kuma.io/service: external-httpbin
+`,
+		),
+
+		Entry("match CORS policy",
+			"27-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`, `
+type: CorsPolicy
+mesh: default
+name: echo-service
+sources:
+- match:
+    kuma.io/service: gateway-default
+destinations:
+- match:
+    kuma.io/service: echo-service
+    kuma.io/protocol: http
+conf:
+  allowOrigins:
+  - exact: https://example.com
+  - regex: https://.*\.example\.com
+  allowMethods:
+  - GET
+  - POST
+  allowHeaders:
+  - x-custom-header
+  maxAge: 1h
+  allowCredentials: true
+`,
+		),
+		Entry("generates cookie based sticky sessions",
+			"28-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+      load_balancer:
+        ring_hash:
+          hash_function: XX_HASH
+          min_ring_size: 1024
+          max_ring_size: 8192
+        hash_policies:
+        - cookie:
+            name: session
+            ttl: 1h
+            path: /
+`,
+		),
+		Entry("rewrite the matched path prefix",
+			"29-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api/v1
+      filters:
+      - rewrite:
+          replace_prefix_match: /v2
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /legacy/
+      filters:
+      - rewrite:
+          replace_prefix_match: ""
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+		Entry("rewrite the full path and regex path",
+			"30-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /status
+      filters:
+      - rewrite:
+          replace_full: /health/ready
+          hostname: health.example.com
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: REGEX
+          value: "^/users/[0-9]+/profile$"
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: "^/users/([0-9]+)/profile$"
+            substitution: "/profiles/\\1"
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+		Entry("rewrite the host header to the backend hostname",
+			"31-gateway-route.yaml", `
+type: ExternalService
+mesh: default
+name: external-httpbin
+tags:
+  kuma.io/service: external-httpbin
+  kuma.io/protocol: http
+networking:
+  address: httpbin.com:80
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /httpbin
+      filters:
+      - rewrite:
+          replace_prefix_match: /
+          host_to_backend_hostname: true
+      backends:
+      - destination:
+          kuma.io/service: external-httpbin
+`,
+		),
+		Entry("should create a direct response route",
+			"32-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /robots.txt
+      filters:
+      - direct_response:
+          status_code: 200
+          body: |
+            User-agent: *
+            Disallow: /
+      - response_header:
+          set:
+          - name: content-type
+            value: text/plain
+    - matches:
+      - path:
+          match: PREFIX
+          value: /maintenance
+      filters:
+      - direct_response:
+          status_code: 503
+`,
+		),
+		Entry("should create a response header rewrite route",
+			"33-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - response_header:
+          set:
+          - name: Cache-Control
+            value: no-store
+          add:
+          - name: x-served-by
+            value: edge-gateway
+          remove:
+          - Server
+      backends:
+      - destination:
+          kuma.io/service: echo-service
 `,
 		),
 	}
@@ -1344,4 +1583,728 @@ conf:
 		)
 	})
 
+	Context("with a TCP gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 5432
+    protocol: TCP
+    tags:
+      port: tcp/5432
+`))).To(Succeed())
+
+			dataplanes.Generate("postgres-v1", "kuma.io/protocol", "tcp")
+			dataplanes.Generate("postgres-v2", "kuma.io/protocol", "tcp")
+		})
+
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureResources ...string) {
+				// given
+				for _, resource := range fixtureResources {
+					Expect(StoreInlineFixture(rt, []byte(resource))).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "tcp", goldenFileName)))
+
+				// then
+				Expect(snap.Consistent()).To(Succeed())
+			},
+			Entry("should not generate a listener without routes",
+				"01-gateway-route.yaml",
+			),
+			Entry("should forward to a single backend",
+				"02-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: postgres
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: postgres-v1
+`,
+			),
+			Entry("should forward to weighted backends",
+				"03-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: postgres
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - weight: 90
+        destination:
+          kuma.io/service: postgres-v1
+      - weight: 10
+        destination:
+          kuma.io/service: postgres-v2
+`,
+			),
+			// HTTP routes are ignored by TCP listeners, and when
+			// there are multiple TCP routes, their backends share
+			// the connections.
+			Entry("should merge the backends of all the TCP routes",
+				"04-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: postgres-b
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: postgres-v2
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: postgres-a
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: postgres-v1
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+			),
+			Entry("match circuit breaker policy",
+				"05-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: postgres
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: postgres-v1
+`, `
+type: CircuitBreaker
+mesh: default
+name: postgres
+sources:
+- match:
+    kuma.io/service: gateway-default
+destinations:
+- match:
+    kuma.io/service: postgres-v1
+conf:
+  thresholds:
+    maxConnections: 100
+`,
+			),
+			Entry("should merge the backends of all the TCP rules",
+				"06-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: postgres
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - weight: 3
+        destination:
+          kuma.io/service: postgres-v1
+    - backends:
+      - weight: 1
+        destination:
+          kuma.io/service: postgres-v2
+`,
+			),
+		)
+	})
+
+	Context("with a TLS gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8883
+    protocol: TLS
+    hostname: mqtt.example.com
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      port: tls/8883
+  - port: 8883
+    protocol: TLS
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      port: tls/8883
+`))).To(Succeed())
+
+			dataplanes.Generate("mqtt-broker", "kuma.io/protocol", "tcp")
+			dataplanes.Generate("mqtt-broker-canary", "kuma.io/protocol", "tcp")
+			dataplanes.Generate("secure-service", "kuma.io/protocol", "tcp")
+		})
+
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureResources ...string) {
+				// given
+				for _, resource := range fixtureResources {
+					Expect(StoreInlineFixture(rt, []byte(resource))).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "tls", goldenFileName)))
+
+				// then
+				Expect(snap.Consistent()).To(Succeed())
+			},
+			Entry("should not generate a listener without routes",
+				"01-gateway-route.yaml",
+			),
+			// The route without hostnames is attached to all the
+			// listener hostnames, but the route that lists the
+			// hostname takes precedence.
+			Entry("should route on SNI hostnames",
+				"02-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: mqtt
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: mqtt-broker
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: secure
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: secure-service
+`,
+			),
+			Entry("should forward to weighted backends",
+				"03-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: mqtt
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+    rules:
+    - backends:
+      - weight: 95
+        destination:
+          kuma.io/service: mqtt-broker
+      - weight: 5
+        destination:
+          kuma.io/service: mqtt-broker-canary
+`,
+			),
+			// Route hostnames that are attached to the wildcard
+			// listener generate a filter chain for each hostname.
+			Entry("should expand route hostnames into filter chains",
+				"04-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: secure
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - "*.secure.example.com"
+    - other.example.com
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: secure-service
+`,
+			),
+			Entry("should merge the backends of the routes for a hostname",
+				"05-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: mqtt
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: mqtt-broker
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: mqtt-canary
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - mqtt.example.com
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: mqtt-broker-canary
+`,
+			),
+		)
+	})
+
+	Context("with a UDP gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 53
+    protocol: UDP
+    tags:
+      port: udp/53
+  - port: 53
+    protocol: TCP
+    tags:
+      port: tcp/53
+`))).To(Succeed())
+
+			dataplanes.GenerateN(2, "dns", "kuma.io/protocol", "tcp")
+			dataplanes.Generate("dns-canary", "kuma.io/protocol", "tcp")
+		})
+
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureResources ...string) {
+				// given
+				for _, resource := range fixtureResources {
+					Expect(StoreInlineFixture(rt, []byte(resource))).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "udp", goldenFileName)))
+
+				// then
+				Expect(snap.Consistent()).To(Succeed())
+			},
+			Entry("should not generate a listener without routes",
+				"01-gateway-route.yaml",
+			),
+			Entry("should forward to a single backend",
+				"02-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: dns
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: dns
+`,
+			),
+			Entry("should forward to weighted backends",
+				"03-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: dns
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - weight: 90
+        destination:
+          kuma.io/service: dns
+      - weight: 10
+        destination:
+          kuma.io/service: dns-canary
+`,
+			),
+			Entry("should forward to an external service",
+				"04-gateway-route.yaml", `
+type: ExternalService
+mesh: default
+name: syslog
+tags:
+  kuma.io/service: syslog
+  kuma.io/protocol: tcp
+networking:
+  address: syslog.example.com:514
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: syslog
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: syslog
+`,
+			),
+			Entry("should merge the backends of all the UDP routes",
+				"06-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: dns
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: dns
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: dns-canary
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: dns-canary
+`,
+			),
+			// UDP and TCP listeners bind different sockets, so
+			// they can share a port.
+			Entry("should generate UDP and TCP listeners on the same port",
+				"05-gateway-route.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: dns-udp
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  udp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: dns
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: dns-tcp
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - destination:
+          kuma.io/service: dns
+`,
+			),
+		)
+	})
+
+	Context("with an authenticating HTTP gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`))).To(Succeed())
+		})
+
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureResources ...string) {
+				// given
+				for _, resource := range fixtureResources {
+					Expect(StoreInlineFixture(rt, []byte(resource))).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "auth", goldenFileName)))
+
+				// then
+				Expect(snap.Consistent()).To(Succeed())
+			},
+			Entry("should validate JWTs",
+				"01-gateway-route.yaml", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    authentication:
+      jwt:
+        providers:
+        - name: example
+          issuer: https://auth.example.com
+          audiences:
+          - echo
+          jwks:
+            inline: eyJrZXlzIjpbXX0=
+        allowMissing: true
+`,
+			),
+			Entry("should log users in with OIDC",
+				"02-gateway-route.yaml", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    authentication:
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com/token
+        clientId: echo
+        clientSecret:
+          inline: Y2xpZW50LXNlY3JldA==
+        hmacSecret:
+          inline: aG1hYy1zZWNyZXQ=
+        signoutPath: /logout
+        forwardBearerToken: true
+      jwt:
+        providers:
+        - name: example
+          jwks:
+            inline: eyJrZXlzIjpbXX0=
+`,
+			),
+			Entry("should verify the OIDC token endpoint with the CA certificate",
+				"03-gateway-route.yaml", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    authentication:
+      oidc:
+        authorizationEndpoint: https://auth.example.com/authorize
+        tokenEndpoint: https://auth.example.com:8443/token
+        caCert:
+          inline: Y2EtY2VydA==
+        clientId: echo
+        clientSecret:
+          inline: Y2xpZW50LXNlY3JldA==
+        hmacSecret:
+          inline: aG1hYy1zZWNyZXQ=
+`,
+			),
+		)
+	})
+
+	Context("with an ACME HTTPS gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8443
+    protocol: HTTPS
+    tags:
+      port: https/8443
+    tls:
+      mode: TERMINATE
+      acme:
+        directoryUrl: https://acme.example.com/directory
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`))).To(Succeed())
+
+			Expect(StoreInlineFixture(rt, []byte(`
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`))).To(Succeed())
+		})
+
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureFiles ...string) {
+				// given
+				for _, name := range fixtureFiles {
+					Expect(StoreNamedFixture(rt, name)).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "acme", goldenFileName)))
+
+				// then
+				Expect(snap.Consistent()).To(Succeed())
+			},
+			Entry("should not serve hosts without certificates",
+				"01-gateway-route.yaml",
+			),
+			Entry("should answer challenges on HTTP listeners",
+				"02-gateway-route.yaml", "secret-acme-challenges.yaml",
+			),
+			Entry("should serve obtained certificates",
+				"03-gateway-route.yaml", "secret-acme-default.yaml",
+			),
+			Entry("should answer challenges on authenticated HTTP listeners",
+				"04-gateway-route.yaml", "gateway-acme-authenticated.yaml", "secret-acme-challenges.yaml",
+			),
+		)
+	})
 })
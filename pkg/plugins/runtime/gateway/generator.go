@@ -19,6 +19,7 @@ import (
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	"github.com/kumahq/kuma/pkg/xds/generator"
 	"github.com/kumahq/kuma/pkg/xds/topology"
 )
 
@@ -51,12 +52,27 @@ type GatewayHost struct {
 	Routes   []model.Resource
 	Policies map[model.ResourceType][]match.RankedPolicy
 	TLS      *mesh_proto.MeshGateway_TLS_Conf
+	// Cors is the most specific CorsPolicy that selects the listener
+	// of this host, if any.
+	Cors *core_mesh.CorsPolicyResource
 }
 
 type GatewayListener struct {
 	Port         uint32
 	Protocol     mesh_proto.MeshGateway_Listener_Protocol
 	ResourceName string
+	// Compression is the most specific Compression policy that
+	// selects the listener, if any.
+	Compression *core_mesh.CompressionResource
+	// Authentication configures how requests to the listener are
+	// authenticated. Listeners that share a port always have the
+	// same authentication.
+	Authentication *mesh_proto.MeshGateway_Authentication
+	// Logging and Tracing override the TrafficLog and TrafficTrace
+	// policies for the listener. Listeners that share a port always
+	// have the same logging and tracing.
+	Logging *mesh_proto.MeshGateway_Logging
+	Tracing *mesh_proto.MeshGateway_Tracing
 }
 
 // GatewayListenerInfo holds everything needed to generate resources for a
@@ -129,10 +145,20 @@ func GatewayListenerInfoFromProxy(
 
 	// Multiple listener specifications can have the same port. If
 	// they are compatible, then we can collapse those specifications
-	// down to a single listener.
-	collapsed := map[uint32][]*mesh_proto.MeshGateway_Listener{}
+	// down to a single listener. UDP listeners bind a different socket
+	// than the other protocols, so they can share ports with them.
+	type listenerKey struct {
+		port uint32
+		udp  bool
+	}
+
+	collapsed := map[listenerKey][]*mesh_proto.MeshGateway_Listener{}
 	for _, ep := range gateway.Spec.GetConf().GetListeners() {
-		collapsed[ep.GetPort()] = append(collapsed[ep.GetPort()], ep)
+		key := listenerKey{
+			port: ep.GetPort(),
+			udp:  ep.GetProtocol() == mesh_proto.MeshGateway_Listener_UDP,
+		}
+		collapsed[key] = append(collapsed[key], ep)
 	}
 
 	externalServices := ctx.Resources.ExternalServices()
@@ -156,13 +182,13 @@ func GatewayListenerInfoFromProxy(
 		ctx.DataSourceLoader,
 	)
 
-	for port, listeners := range collapsed {
+	for key, listeners := range collapsed {
 		// Force all listeners on the same port to have the same protocol.
 		for i := range listeners {
 			if listeners[i].GetProtocol() != listeners[0].GetProtocol() {
 				return nil, errors.Errorf(
 					"cannot collapse listener protocols %s and %s on port %d",
-					listeners[i].GetProtocol(), listeners[0].GetProtocol(), port,
+					listeners[i].GetProtocol(), listeners[0].GetProtocol(), key.port,
 				)
 			}
 		}
@@ -174,6 +200,16 @@ func GatewayListenerInfoFromProxy(
 
 		var hostInfos []GatewayHostInfo
 		for _, host := range hosts {
+			// ACME certificates are stored in a Secret once they are
+			// obtained. Until then, the host can't be served.
+			if !hasACMECertificate(ctx, gateway.Meta.GetName(), host) {
+				log.V(1).Info("waiting for ACME certificate",
+					"listener-name", listener.ResourceName,
+					"hostname", host.Hostname,
+				)
+				continue
+			}
+
 			log.V(1).Info("applying merged traffic routes",
 				"listener-port", listener.Port,
 				"listener-name", listener.ResourceName,
@@ -181,10 +217,20 @@ func GatewayListenerInfoFromProxy(
 
 			hostInfos = append(hostInfos, GatewayHostInfo{
 				Host:    host,
-				Entries: GenerateEnvoyRouteEntries(host),
+				Entries: generateEnvoyRouteEntries(listener.Protocol, host),
 			})
 		}
 
+		// Without any host left to serve, there would be a route
+		// configuration for a listener that doesn't exist.
+		if len(hosts) > 0 && len(hostInfos) == 0 {
+			continue
+		}
+
+		if listener.Protocol == mesh_proto.MeshGateway_Listener_HTTP {
+			addACMEChallengeEntries(ctx, gateway.Meta.GetName(), hostInfos)
+		}
+
 		listenerInfos = append(listenerInfos, GatewayListenerInfo{
 			Proxy:             proxy,
 			Gateway:           gateway,
@@ -212,17 +258,53 @@ func (g Generator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*co
 			return nil, errors.New("no support for protocol")
 		}
 
-		ldsResources, err := g.generateLDS(ctx.Mesh, info, info.HostInfos)
+		// UDP listeners have no filter chains or routes, so
+		// they are generated separately.
+		if info.Listener.Protocol == mesh_proto.MeshGateway_Listener_UDP {
+			udpResources, err := g.generateUDP(ctx, info)
+			if err != nil {
+				return nil, err
+			}
+			resources.AddSet(udpResources)
+			continue
+		}
+
+		// Clusters have to be generated first, since that assigns
+		// the cluster names that the listeners and routes refer to.
+		cdsResources, err := g.generateCDS(ctx, info, info.HostInfos)
 		if err != nil {
 			return nil, err
 		}
-		resources.AddSet(ldsResources)
+		resources.AddSet(cdsResources)
 
-		rdsResources, err := g.generateRDS(ctx, info, info.HostInfos)
+		ldsResources, err := g.generateLDS(ctx.Mesh, info, info.HostInfos)
 		if err != nil {
 			return nil, err
 		}
-		resources.AddSet(rdsResources)
+		resources.AddSet(ldsResources)
+
+		// The TracingProxyGenerator only generates the cluster of
+		// the TrafficTrace backend, but a listener can trace to a
+		// backend of its own.
+		if backend := listenerTracingBackend(ctx.Mesh, info); backend != nil {
+			tracingCluster, err := generator.TracingProxyGenerator{}.GenerateCluster(proxy, backend)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to generate tracing cluster for listener %q", info.Listener.ResourceName)
+			}
+			resources.Add(tracingCluster)
+		}
+
+		// TCP listeners forward directly to the clusters, so
+		// only HTTP listeners have a route configuration.
+		switch info.Listener.Protocol {
+		case mesh_proto.MeshGateway_Listener_HTTP,
+			mesh_proto.MeshGateway_Listener_HTTPS:
+			rdsResources, err := g.generateRDS(ctx, info, info.HostInfos)
+			if err != nil {
+				return nil, err
+			}
+			resources.AddSet(rdsResources)
+		}
 	}
 
 	return resources, nil
@@ -243,6 +325,12 @@ func (g Generator) generateLDS(ctx xds_context.MeshContext, info GatewayListener
 	}
 	resources.AddSet(res)
 
+	// Envoy rejects listeners without filter chains, so there is
+	// no listener when there is nowhere to forward the traffic to.
+	if len(filterChainBuilders) == 0 {
+		return resources, nil
+	}
+
 	for _, filterChainBuilder := range filterChainBuilders {
 		listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder))
 	}
@@ -256,18 +344,65 @@ func (g Generator) generateLDS(ctx xds_context.MeshContext, info GatewayListener
 	return resources, nil
 }
 
-func (g Generator) generateRDS(ctx xds_context.Context, info GatewayListenerInfo, hostInfos []GatewayHostInfo) (*core_xds.ResourceSet, error) {
+func (g Generator) generateUDP(ctx xds_context.Context, info GatewayListenerInfo) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+
+	// There is nothing to match UDP datagrams on, so all the
+	// datagrams are forwarded to the destinations of the single
+	// route entry. Without an entry or without endpoints that can
+	// receive datagrams, there is no listener since there is nowhere
+	// to forward the datagrams to.
+	var entry *route.Entry
+	for i := range info.HostInfos {
+		if len(info.HostInfos[i].Entries) > 0 {
+			entry = &info.HostInfos[i].Entries[0]
+			break
+		}
+	}
+
+	if entry == nil {
+		return resources, nil
+	}
+
+	cluster, err := g.ClusterGenerator.GenerateUDPCluster(ctx, info, *entry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate UDP cluster for dataplane %q", info.Proxy.Id)
+	}
+
+	if cluster == nil {
+		return resources, nil
+	}
+	resources.Add(cluster)
+
+	res, err := BuildResourceSet(GenerateUDPListener(info, cluster.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build listener resource")
+	}
+	resources.AddSet(res)
+
+	return resources, nil
+}
+
+func (g Generator) generateCDS(ctx xds_context.Context, info GatewayListenerInfo, hostInfos []GatewayHostInfo) (*core_xds.ResourceSet, error) {
 	resources := core_xds.NewResourceSet()
-	routeConfig := GenerateRouteConfig(info)
 
-	// Make a pass over the generators for each virtual host.
 	for _, hostInfo := range hostInfos {
 		clusterRes, err := g.ClusterGenerator.GenerateClusters(ctx, info, hostInfo.Entries)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to generate clusters for dataplane %q", info.Proxy.Id)
 		}
 		resources.AddSet(clusterRes)
+	}
 
+	return resources, nil
+}
+
+func (g Generator) generateRDS(ctx xds_context.Context, info GatewayListenerInfo, hostInfos []GatewayHostInfo) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	routeConfig := GenerateRouteConfig(info)
+
+	// Make a pass over the generators for each virtual host.
+	for _, hostInfo := range hostInfos {
 		vh, err := GenerateVirtualHost(ctx, info, hostInfo.Host, hostInfo.Entries)
 		if err != nil {
 			return nil, err
@@ -284,6 +419,44 @@ func (g Generator) generateRDS(ctx xds_context.Context, info GatewayListenerInfo
 	return resources, nil
 }
 
+// generateEnvoyRouteEntries generates the route entries for the route
+// type that is appropriate to the listener protocol.
+func generateEnvoyRouteEntries(protocol mesh_proto.MeshGateway_Listener_Protocol, host GatewayHost) []route.Entry {
+	switch protocol {
+	case mesh_proto.MeshGateway_Listener_TCP:
+		return GenerateEnvoyTCPRouteEntries(host)
+	case mesh_proto.MeshGateway_Listener_TLS:
+		return GenerateEnvoyTLSRouteEntries(host)
+	case mesh_proto.MeshGateway_Listener_UDP:
+		return GenerateEnvoyUDPRouteEntries(host)
+	default:
+		return GenerateEnvoyRouteEntries(host)
+	}
+}
+
+// listenerAcceptsRoute returns whether the given route has the route
+// type that is appropriate to the listener protocol.
+func listenerAcceptsRoute(protocol mesh_proto.MeshGateway_Listener_Protocol, r model.Resource) bool {
+	gatewayRoute, ok := r.(*core_mesh.MeshGatewayRouteResource)
+	if !ok {
+		return false
+	}
+
+	switch protocol {
+	case mesh_proto.MeshGateway_Listener_HTTP,
+		mesh_proto.MeshGateway_Listener_HTTPS:
+		return gatewayRoute.Spec.GetConf().GetHttp() != nil
+	case mesh_proto.MeshGateway_Listener_TCP:
+		return gatewayRoute.Spec.GetConf().GetTcp() != nil
+	case mesh_proto.MeshGateway_Listener_TLS:
+		return gatewayRoute.Spec.GetConf().GetTls() != nil
+	case mesh_proto.MeshGateway_Listener_UDP:
+		return gatewayRoute.Spec.GetConf().GetUdp() != nil
+	default:
+		return false
+	}
+}
+
 // MakeGatewayListener converts a collapsed set of listener configurations
 // in to a single configuration with a matched set of route resources. The
 // given listeners must have a consistent protocol and port.
@@ -302,8 +475,13 @@ func MakeGatewayListener(
 			listeners[0].GetProtocol().String(),
 			listeners[0].GetPort(),
 		),
+		Authentication: listeners[0].GetAuthentication(),
+		Logging:        listeners[0].GetLogging(),
+		Tracing:        listeners[0].GetTracing(),
 	}
 
+	var compressions []match.RankedPolicy
+
 	// Hostnames must be unique to a listener to remove ambiguity
 	// in policy selection and TLS configuration.
 	for _, l := range listeners {
@@ -323,13 +501,10 @@ func MakeGatewayListener(
 			TLS:      l.GetTls(),
 		}
 
-		switch listener.Protocol {
-		case mesh_proto.MeshGateway_Listener_HTTP,
-			mesh_proto.MeshGateway_Listener_HTTPS:
-			host.Routes = append(host.Routes,
-				match.Routes(meshContext.Resources.GatewayRoutes(), l.GetTags())...)
-		default:
-			// TODO(jpeach) match other route types that are appropriate to the protocol.
+		for _, r := range match.Routes(meshContext.Resources.GatewayRoutes(), l.GetTags()) {
+			if listenerAcceptsRoute(listener.Protocol, r) {
+				host.Routes = append(host.Routes, r)
+			}
 		}
 
 		for _, t := range ConnectionPolicyTypes {
@@ -339,9 +514,26 @@ func MakeGatewayListener(
 			host.Policies[t] = matches
 		}
 
+		if best := match.BestConnectionPolicy(match.ConnectionPoliciesBySource(
+			l.GetTags(),
+			match.ToConnectionPolicies(meshContext.Resources.CorsPolicies()))); best != nil {
+			host.Cors = best.(*core_mesh.CorsPolicyResource)
+		}
+
+		compressions = append(compressions, match.ConnectionPoliciesBySource(
+			l.GetTags(),
+			match.ToConnectionPolicies(meshContext.Resources.Compressions()))...)
+
 		hostsByName[hostname] = host
 	}
 
+	// The compressor is a filter of the listener's HTTP connection
+	// manager, so a single Compression policy applies to all the
+	// hostnames regardless of the route destinations.
+	if best := match.BestConnectionPolicy(compressions); best != nil {
+		listener.Compression = best.(*core_mesh.CompressionResource)
+	}
+
 	var hosts []GatewayHost
 	for _, host := range hostsByName {
 		hosts = append(hosts, host)
@@ -395,7 +587,7 @@ func RedistributeWildcardRoutes(
 			continue
 		}
 
-		names := gw.Spec.GetConf().GetHttp().GetHostnames()
+		names := routeHostnames(gw)
 
 		// No hostnames on this route, it stays as a wildcard route.
 		if len(names) == 0 {
@@ -451,3 +643,12 @@ func RedistributeWildcardRoutes(
 
 	return flattened
 }
+
+// routeHostnames returns the hostnames of the HTTP or TLS route.
+func routeHostnames(r *core_mesh.MeshGatewayRouteResource) []string {
+	if tls := r.Spec.GetConf().GetTls(); tls != nil {
+		return tls.GetHostnames()
+	}
+
+	return r.Spec.GetConf().GetHttp().GetHostnames()
+}
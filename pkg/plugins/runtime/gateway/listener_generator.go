@@ -1,8 +1,14 @@
 package gateway
 
 import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
@@ -16,10 +22,20 @@ import (
 // Buffer defaults.
 const DefaultConnectionBuffer = 32 * 1024
 
+// Access log defaults. Unlike the sidecar formats, the gateway formats
+// record the client address and the TLS session of edge connections.
+const (
+	DefaultHTTPAccessLogFormat    = `[%START_TIME%] %KUMA_MESH% "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-B3-TRACEID?X-DATADOG-TRACEID)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%" "%REQUESTED_SERVER_NAME%" "%DOWNSTREAM_TLS_VERSION%" "%KUMA_SOURCE_SERVICE%" "%UPSTREAM_HOST%"`
+	DefaultNetworkAccessLogFormat = `[%START_TIME%] %RESPONSE_FLAGS% %KUMA_MESH% %DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%->%KUMA_SOURCE_SERVICE%->%UPSTREAM_HOST% sni=%REQUESTED_SERVER_NAME% tls=%DOWNSTREAM_TLS_VERSION% took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes`
+)
+
 func SupportsProtocol(p mesh_proto.MeshGateway_Listener_Protocol) bool {
 	switch p {
 	case mesh_proto.MeshGateway_Listener_HTTP,
-		mesh_proto.MeshGateway_Listener_HTTPS:
+		mesh_proto.MeshGateway_Listener_HTTPS,
+		mesh_proto.MeshGateway_Listener_TCP,
+		mesh_proto.MeshGateway_Listener_TLS,
+		mesh_proto.MeshGateway_Listener_UDP:
 		return true
 	default:
 		return false
@@ -62,3 +78,141 @@ func GenerateListener(info GatewayListenerInfo) *envoy_listeners.ListenerBuilder
 			envoy_listeners.TLSInspector(),
 		)
 }
+
+// GenerateUDPListener generates a UDP listener that forwards all the
+// datagrams it receives to the given cluster. UDP listeners have
+// no filter chains.
+func GenerateUDPListener(info GatewayListenerInfo, cluster string) *envoy_listeners.ListenerBuilder {
+	port := info.Listener.Port
+	protocol := info.Listener.Protocol
+	address := info.Proxy.Dataplane.Spec.GetNetworking().Address
+
+	log.V(1).Info("generating listener",
+		"address", address,
+		"port", port,
+		"protocol", protocol,
+	)
+
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	return envoy_listeners.NewListenerBuilder(info.Proxy.APIVersion).
+		Configure(
+			envoy_listeners.InboundListener(
+				envoy_names.GetGatewayListenerName(info.Gateway.Meta.GetName(), protocol.String(), port),
+				address, port, core_xds.SocketAddressProtocolUDP),
+			envoy_listeners.UDPProxy(service, cluster),
+		)
+}
+
+// ListenerHTTPAccessLog configures the access log of a HTTP connection
+// manager for the listener.
+func ListenerHTTPAccessLog(ctx xds_context.MeshContext, info GatewayListenerInfo) envoy_listeners.FilterChainBuilderOpt {
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	return envoy_listeners.HttpAccessLog(
+		ctx.Resource.Meta.GetName(),
+		envoy.TrafficDirectionInbound,
+		service,                // Source service is the gateway service.
+		mesh_proto.MatchAllTag, // Destination service could be anywhere, depending on the routes.
+		listenerLoggingBackend(ctx, info, DefaultHTTPAccessLogFormat),
+		info.Proxy,
+	)
+}
+
+// ListenerNetworkAccessLog configures the access log of a TCP proxy
+// for the listener.
+func ListenerNetworkAccessLog(ctx xds_context.MeshContext, info GatewayListenerInfo) envoy_listeners.FilterChainBuilderOpt {
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	return envoy_listeners.NetworkAccessLog(
+		ctx.Resource.Meta.GetName(),
+		envoy.TrafficDirectionInbound,
+		service,                // Source service is the gateway service.
+		mesh_proto.MatchAllTag, // Destination service could be anywhere, depending on the routes.
+		listenerLoggingBackend(ctx, info, DefaultNetworkAccessLogFormat),
+		info.Proxy,
+	)
+}
+
+// ListenerTracing configures the tracing of a HTTP connection manager
+// for the listener.
+func ListenerTracing(ctx xds_context.MeshContext, info GatewayListenerInfo) envoy_listeners.FilterChainBuilderOpt {
+	// A Gateway is a single service across all listeners.
+	service := info.Proxy.Dataplane.Spec.GetIdentifyingService()
+
+	return envoy_listeners.Tracing(listenerTracingBackend(ctx, info), service)
+}
+
+// listenerLoggingBackend returns the logging backend of the listener,
+// with the listener format applied. Listeners without a backend of
+// their own use the backend of the matching TrafficLog.
+func listenerLoggingBackend(ctx xds_context.MeshContext, info GatewayListenerInfo, defaultFormat string) *mesh_proto.LoggingBackend {
+	logging := info.Listener.Logging
+
+	// In mesh proxies, the access log is configured on the outbound
+	// listener, which is why we index the Logs slice by destination
+	// service name.  A Gateway listener by definition forwards traffic
+	// to multiple destinations, so rather than making up some arbitrary
+	// rules about which destination service we should accept here, we
+	// match the log policy for the generic pass through service. This
+	// will be the only policy available for a Dataplane with no outbounds.
+	backend := ctx.GetLoggingBackend(info.Proxy.Policies.TrafficLogs[core_mesh.PassThroughService])
+
+	if name := logging.GetBackend(); name != "" {
+		backend = ctx.Resource.GetLoggingBackend(name)
+		if backend == nil {
+			log.Info("logging backend is not found, ignoring",
+				"listener-name", info.Listener.ResourceName,
+				"backend", name,
+			)
+			return nil
+		}
+	}
+
+	if backend == nil {
+		return nil
+	}
+
+	backend = proto.Clone(backend).(*mesh_proto.LoggingBackend)
+
+	if format := logging.GetFormat(); format != "" {
+		backend.Format = format
+	}
+
+	if backend.Format == "" {
+		backend.Format = defaultFormat
+	}
+
+	return backend
+}
+
+// listenerTracingBackend returns the tracing backend of the listener,
+// with the listener sampling applied. Listeners without a backend of
+// their own use the backend of the matching TrafficTrace.
+func listenerTracingBackend(ctx xds_context.MeshContext, info GatewayListenerInfo) *mesh_proto.TracingBackend {
+	tracing := info.Listener.Tracing
+	backend := ctx.GetTracingBackend(info.Proxy.Policies.TrafficTrace)
+
+	if name := tracing.GetBackend(); name != "" {
+		backend = ctx.Resource.GetTracingBackend(name)
+		if backend == nil {
+			log.Info("tracing backend is not found, ignoring",
+				"listener-name", info.Listener.ResourceName,
+				"backend", name,
+			)
+			return nil
+		}
+	}
+
+	if backend == nil || tracing.GetSampling() == nil {
+		return backend
+	}
+
+	backend = proto.Clone(backend).(*mesh_proto.TracingBackend)
+	backend.Sampling = wrapperspb.Double(tracing.GetSampling().GetValue())
+
+	return backend
+}
@@ -201,6 +201,111 @@ conf:
     tags:
       name: any-hostname
 `),
+
+		Entry("should generate listener logging overrides",
+			"06-gateway-listener.yaml", `
+type: MeshGateway
+mesh: logging
+name: logging-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    logging:
+      backend: logstash
+      format: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT% %REQUESTED_SERVER_NAME% %DOWNSTREAM_TLS_VERSION% %RESPONSE_CODE%'
+`),
+
+		Entry("should generate listener tracing overrides",
+			"07-gateway-listener.yaml", `
+type: MeshGateway
+mesh: tracing
+name: tracing-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    tracing:
+      sampling: 5.0
+`),
+
+		Entry("should generate listener tracing backend overrides",
+			"08-gateway-listener.yaml", `
+type: MeshGateway
+mesh: tracing
+name: tracing-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    tracing:
+      backend: zipkin
+`),
+	)
+
+	DescribeTable("Generate Envoy xDS clusters",
+		func(golden string, gateway string) {
+			snap, err := Do(gateway)
+			Expect(err).To(Succeed())
+
+			out, err := yaml.Marshal(MakeProtoResource(snap.Resources[envoy_types.Cluster]))
+			Expect(err).To(Succeed())
+
+			Expect(out).To(matchers.MatchGoldenYAML(path.Join("testdata", golden)))
+		},
+		Entry("should generate clusters for listener tracing backends",
+			"01-gateway-listener-clusters.yaml", `
+type: MeshGateway
+mesh: tracing
+name: tracing-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    tracing:
+      backend: zipkin
+  - port: 9090
+    protocol: HTTP
+    tags:
+      port: http/9090
+`),
+		Entry("should generate clusters for listener tracing backends without a TrafficTrace",
+			"02-gateway-listener-clusters.yaml", `
+type: MeshGateway
+mesh: logging
+name: logging-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    tracing:
+      backend: zipkin
+`),
 	)
 
 	DescribeTable("fail to generate xDS resources",
@@ -225,11 +330,11 @@ conf:
     tags:
       port: http/8080
   - port: 8080
-    protocol: HTTPS
+    protocol: TLS
     tls:
       mode: PASSTHROUGH
     tags:
-      port: http/9090
+      port: tls/8080
 `,
 		),
 	)
@@ -45,6 +45,30 @@ func ConnectionPoliciesBySource(
 	return matches
 }
 
+// BestConnectionPolicy returns the highest ranked policy. If multiple
+// policies have the same rank, the most recent one is preferred.
+func BestConnectionPolicy(policies []RankedPolicy) policy.ConnectionPolicy {
+	var best *RankedPolicy
+
+	for i, p := range policies {
+		if best == nil {
+			best = &policies[i]
+			continue
+		}
+
+		n := p.Rank.CompareTo(best.Rank)
+		if n > 0 || (n == 0 && p.Policy.GetMeta().GetCreationTime().After(best.Policy.GetMeta().GetCreationTime())) {
+			best = &policies[i]
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	return best.Policy
+}
+
 // OldestPolicy returns the resource that has the earliest creation time.
 func OldestPolicy(policies []model.Resource) model.Resource {
 	if len(policies) == 0 {
@@ -5,7 +5,9 @@ import (
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/register"
 	"github.com/kumahq/kuma/pkg/xds/generator"
 	"github.com/kumahq/kuma/pkg/xds/template"
@@ -27,6 +29,7 @@ var (
 type plugin struct{}
 
 var _ core_plugins.BootstrapPlugin = &plugin{}
+var _ core_plugins.RuntimePlugin = &plugin{}
 
 func (p *plugin) BeforeBootstrap(context *core_plugins.MutablePluginContext, config core_plugins.PluginConfig) error {
 	if !context.Config().Experimental.MeshGateway {
@@ -60,6 +63,30 @@ func (p *plugin) AfterBootstrap(context *core_plugins.MutablePluginContext, conf
 	return nil
 }
 
+func (p *plugin) Customize(rt core_runtime.Runtime) error {
+	if !rt.Config().Experimental.MeshGateway {
+		return nil
+	}
+
+	// In a multizone deployment, Secrets are owned by the global
+	// control plane and synced down to the zones. A zone control plane
+	// can't store certificates, because the next sync from global would
+	// delete them, so certificates are obtained on global only.
+	if rt.Config().Mode == config_core.Zone {
+		return nil
+	}
+
+	manager := acme.NewManager(rt.ResourceManager(), rt.DataSourceLoader())
+
+	// On global, challenge responses have to reach the zones over KDS
+	// before the gateway dataplanes can serve them.
+	if rt.Config().Mode == config_core.Global {
+		manager.PropagationDelay += rt.Config().Multizone.Global.KDS.RefreshInterval
+	}
+
+	return rt.Add(manager)
+}
+
 func (p *plugin) Name() core_plugins.PluginName {
 	return PluginName
 }
@@ -88,6 +115,8 @@ func NewProxyProfile(zone string) generator.ResourceGenerator {
 				FilterChainGenerators: map[mesh_proto.MeshGateway_Listener_Protocol]FilterChainGenerator{
 					mesh_proto.MeshGateway_Listener_HTTP:  &HTTPFilterChainGenerator{},
 					mesh_proto.MeshGateway_Listener_HTTPS: &HTTPSFilterChainGenerator{},
+					mesh_proto.MeshGateway_Listener_TCP:   &TCPFilterChainGenerator{},
+					mesh_proto.MeshGateway_Listener_TLS:   &TLSFilterChainGenerator{},
 				}},
 			ClusterGenerator: ClusterGenerator{
 				Zone: zone,
@@ -0,0 +1,61 @@
+package gateway_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/acme"
+	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
+)
+
+type recordingComponentManager struct {
+	component.Manager
+	components []component.Component
+}
+
+func (r *recordingComponentManager) Add(c ...component.Component) error {
+	r.components = append(r.components, c...)
+	return nil
+}
+
+var _ = Describe("Gateway Runtime Plugin", func() {
+	DescribeTable("should run the ACME manager",
+		func(mode config_core.CpMode, expected int) {
+			config := kuma_cp.DefaultConfig()
+			config.Mode = mode
+			config.Experimental.MeshGateway = true
+
+			builder, err := test_runtime.BuilderFor(context.Background(), config)
+			Expect(err).To(Succeed())
+
+			components := &recordingComponentManager{}
+			builder.WithComponentManager(components)
+
+			rt, err := builder.Build()
+			Expect(err).To(Succeed())
+
+			// when
+			plugin := plugins.Plugins().RuntimePlugins()[gateway.PluginName]
+			Expect(plugin.Customize(rt)).To(Succeed())
+
+			// then
+			var managers []*acme.Manager
+			for _, c := range components.components {
+				if m, ok := c.(*acme.Manager); ok {
+					managers = append(managers, m)
+				}
+			}
+			Expect(managers).To(HaveLen(expected))
+		},
+		Entry("on a standalone control plane", config_core.Standalone, 1),
+		Entry("on a global control plane", config_core.Global, 1),
+		Entry("not on a zone control plane", config_core.Zone, 0),
+	)
+})
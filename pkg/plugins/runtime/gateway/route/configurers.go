@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
@@ -227,6 +228,55 @@ func RouteDeleteRequestHeader(name string) RouteConfigurer {
 	})
 }
 
+// RouteAppendResponseHeader appends the given value to the existing values of the given response header.
+func RouteAppendResponseHeader(name string, value string) RouteConfigurer {
+	if name == "" || value == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToAdd = append(r.ResponseHeadersToAdd,
+			&envoy_config_core.HeaderValueOption{
+				Append: util_proto.Bool(true),
+				Header: &envoy_config_core.HeaderValue{
+					Key:   http.CanonicalHeaderKey(name),
+					Value: value,
+				},
+			},
+		)
+	})
+}
+
+// RouteReplaceResponseHeader replaces all values of the given response header with the given value.
+func RouteReplaceResponseHeader(name string, value string) RouteConfigurer {
+	if name == "" || value == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToAdd = append(r.ResponseHeadersToAdd,
+			&envoy_config_core.HeaderValueOption{
+				Append: util_proto.Bool(false),
+				Header: &envoy_config_core.HeaderValue{
+					Key:   http.CanonicalHeaderKey(name),
+					Value: value,
+				},
+			},
+		)
+	})
+}
+
+// RouteDeleteResponseHeader deletes the given header from the HTTP response.
+func RouteDeleteResponseHeader(name string) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToRemove = append(r.ResponseHeadersToRemove, name)
+	})
+}
+
 // RouteMirror enables traffic mirroring on the route. It is an error to enable
 // mirroring if the route is not forwarding. The route action must be configured
 // beforehand.
@@ -261,6 +311,78 @@ func RouteMirror(percent float64, destination Destination) RouteConfigurer {
 	})
 }
 
+// RouteRewritePrefixPath rewrites the matched path prefix (or the path
+// for exact path matches) of the forwarded request. It is an error to
+// rewrite the path if the route is not forwarding. The route action must
+// be configured beforehand.
+func RouteRewritePrefixPath(prefix string) RouteConfigurer {
+	if prefix == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if r.GetAction() == nil {
+			return errors.New("cannot configure path rewrite before the route action")
+		}
+
+		if action := r.GetRoute(); action != nil {
+			action.PrefixRewrite = prefix
+		}
+
+		return nil
+	})
+}
+
+// RouteRewriteRegexPath rewrites the path of the forwarded request by
+// replacing the parts that match the given regex with the substitution.
+// Envoy applies the rewrite to the path, but not the query string. It is
+// an error to rewrite the path if the route is not forwarding. The route
+// action must be configured beforehand.
+func RouteRewriteRegexPath(regex string, substitution string) RouteConfigurer {
+	if regex == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if r.GetAction() == nil {
+			return errors.New("cannot configure path rewrite before the route action")
+		}
+
+		if action := r.GetRoute(); action != nil {
+			action.RegexRewrite = &envoy_type_matcher.RegexMatchAndSubstitute{
+				Pattern:      regexOf(regex),
+				Substitution: substitution,
+			}
+		}
+
+		return nil
+	})
+}
+
+// RouteAutoHostRewrite replaces the Host header on the forwarded request
+// with the hostname of the upstream host. Envoy only does this for
+// clusters whose hosts are resolved by DNS. The route action must be
+// configured beforehand.
+func RouteAutoHostRewrite(enabled bool) RouteConfigurer {
+	if !enabled {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if r.GetAction() == nil {
+			return errors.New("cannot configure the Host header before the route action")
+		}
+
+		if action := r.GetRoute(); action != nil {
+			action.HostRewriteSpecifier = &envoy_config_route.RouteAction_AutoHostRewrite{
+				AutoHostRewrite: util_proto.Bool(true),
+			}
+		}
+
+		return nil
+	})
+}
+
 // RoutePerFilterConfig sets an optional per-filter configuration message
 // for this route. filterName is the name of the filter that should receive
 // the configuration that is specified in filterConfig
@@ -320,6 +442,33 @@ func RouteActionRedirect(redirect *Redirection) RouteConfigurer {
 	})
 }
 
+// RouteActionDirectResponse configures the route to respond immediately
+// with the given status and body. This replaces any previous action
+// specification.
+func RouteActionDirectResponse(respond *DirectResponse) RouteConfigurer {
+	if respond == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		action := &envoy_config_route.DirectResponseAction{
+			Status: respond.Status,
+		}
+
+		if respond.Body != "" {
+			action.Body = &envoy_config_core.DataSource{
+				Specifier: &envoy_config_core.DataSource_InlineString{
+					InlineString: respond.Body,
+				},
+			}
+		}
+
+		r.Action = &envoy_config_route.Route_DirectResponse{
+			DirectResponse: action,
+		}
+	})
+}
+
 // RouteActionForward configures the route to forward traffic to the
 // given destinations, with the appropriate weights. This replaces any
 // previous action specification.
@@ -366,6 +515,24 @@ func RouteActionForward(destinations []Destination) RouteConfigurer {
 	})
 }
 
+// RouteActionHashPolicies sets the hash policies used by the consistent
+// hashing load balancers of the forwarding route action.
+func RouteActionHashPolicies(hashPolicies []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy) RouteConfigurer {
+	if len(hashPolicies) == 0 {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		action := r.GetRoute()
+		if action == nil {
+			return nil
+		}
+
+		action.HashPolicy = v3.NewHashPolicies(hashPolicies)
+		return nil
+	})
+}
+
 // RouteActionRetryDefault initializes the retry policy with defaults appropriate for the protocol.
 func RouteActionRetryDefault(protocol core_mesh.Protocol) RouteConfigurer {
 	// The retry policy only supports HTTP and GRPC.
@@ -385,9 +552,9 @@ func RouteActionRetryDefault(protocol core_mesh.Protocol) RouteConfigurer {
 
 		switch protocol {
 		case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
-			p.RetryOn = envoy_listeners.HttpRetryOnDefault
+			p.RetryOn = v3.HttpRetryOnDefault
 		case core_mesh.ProtocolGRPC:
-			p.RetryOn = envoy_listeners.GrpcRetryOnDefault
+			p.RetryOn = v3.GrpcRetryOnDefault
 		}
 
 		route.RetryPolicy = p
@@ -481,7 +648,7 @@ func RouteActionRetryOnStatus(httpStatus ...uint32) RouteConfigurer {
 
 	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
 		if p := r.GetRoute().GetRetryPolicy(); p != nil {
-			p.RetryOn = envoy_listeners.HttpRetryOnRetriableStatusCodes
+			p.RetryOn = v3.HttpRetryOnRetriableStatusCodes
 			p.RetriableStatusCodes = make([]uint32, len(httpStatus))
 			copy(p.RetriableStatusCodes, httpStatus)
 		}
@@ -509,6 +676,55 @@ func RouteActionRetryOnConditions(conditionNames ...string) RouteConfigurer {
 	})
 }
 
+// RouteActionRetryOnHeaders sets the response headers whose presence triggers retries.
+func RouteActionRetryOnHeaders(headerNames ...string) RouteConfigurer {
+	if len(headerNames) == 0 {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if p := r.GetRoute().GetRetryPolicy(); p != nil {
+			p.RetriableHeaders = v3.RetriableHeaders(headerNames)
+		}
+
+		return nil
+	})
+}
+
+// RouteActionRetryRateLimitedBackoff sets the backoff policy for retries
+// of the rate limited responses.
+func RouteActionRetryRateLimitedBackoff(backOff *mesh_proto.Retry_Conf_RateLimitedBackOff) RouteConfigurer {
+	if backOff == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if p := r.GetRoute().GetRetryPolicy(); p != nil {
+			p.RateLimitedRetryBackOff = v3.RateLimitedRetryBackOff(backOff)
+		}
+
+		return nil
+	})
+}
+
+// RouteActionHedgeOnPerTryTimeout sends a hedged request when the per-try
+// timeout of the retry policy elapses.
+func RouteActionHedgeOnPerTryTimeout(hedge bool) RouteConfigurer {
+	if !hedge {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if p := r.GetRoute(); p != nil && p.GetRetryPolicy() != nil {
+			p.HedgePolicy = &envoy_config_route.HedgePolicy{
+				HedgeOnPerTryTimeout: true,
+			}
+		}
+
+		return nil
+	})
+}
+
 // RouteActionRequestTimeout sets the total timeout for an upstream request.
 func RouteActionRequestTimeout(timeout time.Duration) RouteConfigurer {
 	if timeout == 0 {
@@ -1,6 +1,7 @@
 package route
 
 import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 )
@@ -35,6 +36,14 @@ type Entry struct {
 	// RequestHeaders specifies transformations on the HTTP
 	// request headers.
 	RequestHeaders *Headers
+
+	// ResponseHeaders specifies transformations on the HTTP
+	// response headers.
+	ResponseHeaders *Headers
+
+	// Rewrite specifies modifications to the request URL when it
+	// is forwarded.
+	Rewrite *Rewrite
 }
 
 // KeyValue is a generic pairing of key and value strings. Route table
@@ -72,7 +81,14 @@ type Match struct {
 type Action struct {
 	Forward  []Destination
 	Redirect *Redirection
-	Respond  struct{} // TODO(jpeach) add DirectResponseAction support
+	Respond  *DirectResponse
+}
+
+// DirectResponse is an action that responds to a HTTP request with a
+// static HTTP response.
+type DirectResponse struct {
+	Status uint32 // HTTP status code.
+	Body   string // Response body (optional).
 }
 
 // Redirection is an action that responds to a HTTP request with a HTTP
@@ -100,6 +116,10 @@ type Destination struct {
 	// Kuma connection policies for traffic forwarded to
 	// this destination.
 	Policies map[model.ResourceType]model.Resource
+
+	// LoadBalancer configures the load balancing of the traffic
+	// forwarded to this destination.
+	LoadBalancer *mesh_proto.TrafficRoute_LoadBalancer
 }
 
 // Headers is a set of operations to perform on HTTP message headers.
@@ -118,3 +138,21 @@ type Mirror struct {
 	Forward    Destination
 	Percentage float64
 }
+
+// Rewrite specifies modifications to the request URL. At most one of
+// the path rewrites should be given.
+type Rewrite struct {
+	ReplaceFullPath    *string // Replacement for the whole path.
+	ReplacePrefixMatch *string // Replacement for the matched path prefix.
+	RegexPath          *RegexSubstitution
+
+	Host                  string // Replacement Host header (optional).
+	HostToBackendHostname bool   // Whether to set the Host header to the backend hostname.
+}
+
+// RegexSubstitution replaces the parts of a string that match Pattern
+// with Substitution.
+type RegexSubstitution struct {
+	Pattern      string
+	Substitution string
+}
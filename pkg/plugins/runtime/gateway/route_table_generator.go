@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"sort"
+	"strings"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -36,6 +37,10 @@ func GenerateVirtualHost(
 		)
 	}
 
+	if host.Cors != nil {
+		vh.Configure(envoy_routes.Cors(host.Cors.Spec.GetConf()))
+	}
+
 	// TODO(jpeach) match the FaultInjection policy for this virtual host.
 
 	// TODO(jpeach) apply additional virtual host configuration.
@@ -53,9 +58,18 @@ func GenerateVirtualHost(
 			route.RouteMatchExactHeader(":method", e.Match.Method),
 
 			route.RouteActionRedirect(e.Action.Redirect),
+			route.RouteActionDirectResponse(e.Action.Respond),
 			route.RouteActionForward(e.Action.Forward),
 		)
 
+		// All the destinations of the entry come from the same rule, so
+		// they share the load balancer configuration.
+		if len(e.Action.Forward) > 0 {
+			routeBuilder.Configure(
+				route.RouteActionHashPolicies(e.Action.Forward[0].LoadBalancer.GetHashPolicies()),
+			)
+		}
+
 		// Generate a retry policy for this route, if there is one.
 		routeBuilder.Configure(
 			retryRouteConfigurers(
@@ -118,6 +132,24 @@ func GenerateVirtualHost(
 			}
 		}
 
+		if rs := e.ResponseHeaders; rs != nil {
+			for _, h := range rs.Replace {
+				routeBuilder.Configure(route.RouteReplaceResponseHeader(h.Key, h.Value))
+			}
+
+			for _, h := range rs.Append {
+				routeBuilder.Configure(route.RouteAppendResponseHeader(h.Key, h.Value))
+			}
+
+			for _, name := range rs.Delete {
+				routeBuilder.Configure(route.RouteDeleteResponseHeader(name))
+			}
+		}
+
+		if rw := e.Rewrite; rw != nil {
+			routeBuilder.Configure(rewriteRouteConfigurers(e.Match, rw)...)
+		}
+
 		// After configuring the route action, attempt to configure mirroring.
 		// This only affects the forwarding action.
 		if m := e.Mirror; m != nil {
@@ -130,6 +162,43 @@ func GenerateVirtualHost(
 	return vh, nil
 }
 
+// rewriteRouteConfigurers returns the set of route configurers needed
+// to implement the URL rewrite for a route with the given match.
+func rewriteRouteConfigurers(m route.Match, rw *route.Rewrite) []route.RouteConfigurer {
+	configurers := []route.RouteConfigurer{
+		route.RouteReplaceHostHeader(rw.Host),
+		route.RouteAutoHostRewrite(rw.HostToBackendHostname),
+	}
+
+	switch {
+	case rw.ReplaceFullPath != nil:
+		configurers = append(configurers,
+			route.RouteRewriteRegexPath("^/.*$", *rw.ReplaceFullPath))
+	case rw.ReplacePrefixMatch != nil:
+		// Prefix matches are expanded to a prefix match with a
+		// trailing '/' and an exact match without one (see
+		// GenerateEnvoyRouteEntries), so the replacement has to
+		// follow the same shape to keep path components intact.
+		switch {
+		case m.PrefixPath != "":
+			configurers = append(configurers,
+				route.RouteRewritePrefixPath(strings.TrimRight(*rw.ReplacePrefixMatch, "/")+"/"))
+		case m.ExactPath != "":
+			replacement := *rw.ReplacePrefixMatch
+			if replacement == "" {
+				replacement = "/"
+			}
+
+			configurers = append(configurers, route.RouteRewritePrefixPath(replacement))
+		}
+	case rw.RegexPath != nil:
+		configurers = append(configurers,
+			route.RouteRewriteRegexPath(rw.RegexPath.Pattern, rw.RegexPath.Substitution))
+	}
+
+	return configurers
+}
+
 // retryRouteConfigurers returns the set of route configurers needed to implement the retry policy (if there is one).
 func retryRouteConfigurers(protocol core_mesh.Protocol, policy model.Resource) []route.RouteConfigurer {
 	retry, _ := policy.(*core_mesh.RetryResource)
@@ -164,12 +233,16 @@ func retryRouteConfigurers(protocol core_mesh.Protocol, policy model.Resource) [
 		conf := retry.Spec.GetConf().GetHttp()
 		configurers = append(configurers,
 			route.RouteActionRetryOnStatus(conf.GetRetriableStatusCodes()...),
+			route.RouteActionRetryOnConditions(v3.HttpRetryOnConditions(conf)...),
+			route.RouteActionRetryOnHeaders(conf.GetRetriableHeaders()...),
 			route.RouteActionRetryMethods(methodStrings(conf.GetRetriableMethods())...),
 			route.RouteActionRetryTimeout(conf.GetPerTryTimeout().AsDuration()),
 			route.RouteActionRetryCount(conf.GetNumRetries().GetValue()),
 			route.RouteActionRetryBackoff(
 				conf.GetBackOff().GetBaseInterval().AsDuration(),
 				conf.GetBackOff().GetMaxInterval().AsDuration()),
+			route.RouteActionRetryRateLimitedBackoff(conf.GetRateLimitedBackOff()),
+			route.RouteActionHedgeOnPerTryTimeout(conf.GetHedgeOnPerTryTimeout()),
 		)
 	case core_mesh.ProtocolGRPC:
 		conf := retry.Spec.GetConf().GetGrpc()
@@ -180,6 +253,8 @@ func retryRouteConfigurers(protocol core_mesh.Protocol, policy model.Resource) [
 			route.RouteActionRetryBackoff(
 				conf.GetBackOff().GetBaseInterval().AsDuration(),
 				conf.GetBackOff().GetMaxInterval().AsDuration()),
+			route.RouteActionRetryRateLimitedBackoff(conf.GetRateLimitedBackOff()),
+			route.RouteActionHedgeOnPerTryTimeout(conf.GetHedgeOnPerTryTimeout()),
 		)
 	}
 
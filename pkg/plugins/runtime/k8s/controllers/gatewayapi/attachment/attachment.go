@@ -35,6 +35,7 @@ const (
 func findRouteListenerAttachment(
 	gateway *gatewayapi.Gateway,
 	routeNs kube_client.Object,
+	routeKind gatewayapi.Kind,
 	refSectionName *gatewayapi.SectionName,
 ) (Attachment, error) {
 	// Build a map of whether attaching to each listener is possible
@@ -43,8 +44,12 @@ func findRouteListenerAttachment(
 	for _, l := range gateway.Spec.Listeners {
 		ns := l.AllowedRoutes.Namespaces
 
-		// The gateway controller ensures Kinds is either empty or contains
-		// HTTPRoute, so we can ignore it here.
+		// Kinds (or the listener protocol if Kinds is empty)
+		// determines which kinds of route can attach.
+		if !common.ListenerAllowsRouteKind(l, routeKind) {
+			listeners[l.Name] = NotPermitted
+			continue
+		}
 
 		// From determines whether we are permitted to attach to this ParentRef
 		switch *ns.From {
@@ -68,7 +73,7 @@ func findRouteListenerAttachment(
 		case gatewayapi.NamespacesFromAll:
 		}
 
-		return Allowed, nil
+		listeners[l.Name] = Allowed
 	}
 
 	sectionName := ""
@@ -144,12 +149,13 @@ func getParentRefGateway(
 	return gateway, nil
 }
 
-// EvaluateParentRefAttachment reports whether a route in the given namespace can attach
-// via the given ParentRef.
+// EvaluateParentRefAttachment reports whether a route of the given kind in
+// the given namespace can attach via the given ParentRef.
 func EvaluateParentRefAttachment(
 	ctx context.Context,
 	client kube_client.Client,
 	routeNs *kube_core.Namespace,
+	routeKind gatewayapi.Kind,
 	ref gatewayapi.ParentReference,
 ) (Attachment, error) {
 	gateway, err := getParentRefGateway(ctx, client, routeNs.GetName(), ref)
@@ -160,5 +166,5 @@ func EvaluateParentRefAttachment(
 		return Unknown, nil
 	}
 
-	return findRouteListenerAttachment(gateway, routeNs, ref.SectionName)
+	return findRouteListenerAttachment(gateway, routeNs, routeKind, ref.SectionName)
 }
@@ -50,6 +50,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				defaultRouteNs,
+				common.HTTPRouteKind,
 				simpleRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -61,6 +62,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				defaultRouteNs,
+				common.HTTPRouteKind,
 				simpleRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -75,6 +77,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				otherRouteNs,
+				common.HTTPRouteKind,
 				simpleRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -89,6 +92,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				otherRouteNs,
+				common.HTTPRouteKind,
 				simpleRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -109,6 +113,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				defaultRouteNs,
+				common.HTTPRouteKind,
 				parentRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -123,6 +128,7 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				otherRouteNs,
+				common.HTTPRouteKind,
 				parentRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -136,12 +142,73 @@ var _ = Describe("AllowedRoutes support", func() {
 				context.Background(),
 				kubeClient,
 				otherRouteNs,
+				common.HTTPRouteKind,
 				parentRef,
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).To(Equal(attachment.Allowed))
 		})
 	})
+	Context("route kinds", func() {
+		var parentRef gatewayapi.ParentReference
+		BeforeEach(func() {
+			parentRef = *gatewayRef.DeepCopy()
+			parentRef.Name = gatewayapi.ObjectName(gatewayMultipleListeners.Name)
+		})
+
+		It("denies kinds not supported by the listener protocol", func() {
+			parentRef.SectionName = &simpleListenerName
+
+			res, err := attachment.EvaluateParentRefAttachment(
+				context.Background(),
+				kubeClient,
+				defaultRouteNs,
+				common.TCPRouteKind,
+				parentRef,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(attachment.NotPermitted))
+		})
+		It("denies kinds not supported by any listener", func() {
+			parentRef.Name = gatewayapi.ObjectName(gateway.Name)
+
+			res, err := attachment.EvaluateParentRefAttachment(
+				context.Background(),
+				kubeClient,
+				defaultRouteNs,
+				common.TCPRouteKind,
+				parentRef,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(attachment.NotPermitted))
+		})
+		It("allows kinds supported by the listener protocol", func() {
+			parentRef.SectionName = &tcpListenerName
+
+			res, err := attachment.EvaluateParentRefAttachment(
+				context.Background(),
+				kubeClient,
+				defaultRouteNs,
+				common.TCPRouteKind,
+				parentRef,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(attachment.Allowed))
+		})
+		It("denies kinds not in the listener allowed kinds", func() {
+			parentRef.SectionName = &mismatchedKindsListenerName
+
+			res, err := attachment.EvaluateParentRefAttachment(
+				context.Background(),
+				kubeClient,
+				defaultRouteNs,
+				common.HTTPRouteKind,
+				parentRef,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(attachment.NotPermitted))
+		})
+	})
 })
 
 var (
@@ -181,6 +248,31 @@ var (
 			},
 		},
 	}
+	tcpListenerName = gatewayapi.SectionName("tcp")
+	tcpListener     = gatewayapi.Listener{
+		Name:     tcpListenerName,
+		Port:     gatewayapi.PortNumber(5432),
+		Protocol: gatewayapi.TCPProtocolType,
+		AllowedRoutes: &gatewayapi.AllowedRoutes{
+			Namespaces: &gatewayapi.RouteNamespaces{
+				From: &fromSame,
+			},
+		},
+	}
+	mismatchedKindsListenerName = gatewayapi.SectionName("mismatched-kinds")
+	mismatchedKindsListener     = gatewayapi.Listener{
+		Name:     mismatchedKindsListenerName,
+		Port:     gatewayapi.PortNumber(5433),
+		Protocol: gatewayapi.TCPProtocolType,
+		AllowedRoutes: &gatewayapi.AllowedRoutes{
+			Namespaces: &gatewayapi.RouteNamespaces{
+				From: &fromSame,
+			},
+			Kinds: []gatewayapi.RouteGroupKind{
+				{Group: &gatewayGroup, Kind: common.HTTPRouteKind},
+			},
+		},
+	}
 	gatewayClass = &gatewayapi.GatewayClass{
 		ObjectMeta: kube_meta.ObjectMeta{
 			Name: "kuma",
@@ -224,6 +316,8 @@ var (
 			Listeners: []gatewayapi.Listener{
 				simpleListener,
 				allNsListener,
+				tcpListener,
+				mismatchedKindsListener,
 			},
 		},
 		Status: gatewayapi.GatewayStatus{
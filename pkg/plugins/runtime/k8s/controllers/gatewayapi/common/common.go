@@ -6,6 +6,8 @@ import (
 
 	"github.com/pkg/errors"
 	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_schema "k8s.io/apimachinery/pkg/runtime/schema"
 	kube_types "k8s.io/apimachinery/pkg/types"
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -15,9 +17,72 @@ import (
 
 const (
 	ControllerName = gatewayapi.GatewayController("gateways.kuma.io/controller")
-	HTTPRouteKind  = gatewayapi.Kind("HTTPRoute")
+	GatewayKind    = gatewayapi.Kind("Gateway")
+
+	// The route kinds that are converted to MeshGatewayRoutes. GRPCRoute
+	// is not one of them, since it first appears in a gateway-api version
+	// that needs newer Kubernetes libraries than the ones we build with.
+	HTTPRouteKind = gatewayapi.Kind("HTTPRoute")
+	TCPRouteKind  = gatewayapi.Kind("TCPRoute")
+	TLSRouteKind  = gatewayapi.Kind("TLSRoute")
 )
 
+// SupportedRouteKinds returns the kinds of routes that can attach to a
+// listener with the given protocol.
+func SupportedRouteKinds(protocol gatewayapi.ProtocolType) []gatewayapi.Kind {
+	switch protocol {
+	case gatewayapi.HTTPProtocolType, gatewayapi.HTTPSProtocolType:
+		return []gatewayapi.Kind{HTTPRouteKind}
+	case gatewayapi.TCPProtocolType:
+		return []gatewayapi.Kind{TCPRouteKind}
+	case gatewayapi.TLSProtocolType:
+		return []gatewayapi.Kind{TLSRouteKind}
+	default:
+		return nil
+	}
+}
+
+// ListenerAllowsRouteKind checks whether routes of the given kind can
+// attach to the listener. If the listener doesn't restrict the route
+// kinds, the kinds supported by the listener protocol are allowed.
+func ListenerAllowsRouteKind(listener gatewayapi.Listener, kind gatewayapi.Kind) bool {
+	supported := false
+	for _, k := range SupportedRouteKinds(listener.Protocol) {
+		if k == kind {
+			supported = true
+		}
+	}
+
+	if !supported {
+		return false
+	}
+
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		return true
+	}
+
+	for _, gk := range listener.AllowedRoutes.Kinds {
+		if gk.Kind == kind && (gk.Group == nil || *gk.Group == gatewayapi.GroupName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KindPresent checks whether the CRD for the given Gateway API kind
+// is installed.
+func KindPresent(mapper kube_apimeta.RESTMapper, kind gatewayapi.Kind) bool {
+	gk := kube_schema.GroupKind{
+		Group: gatewayapi.SchemeGroupVersion.Group,
+		Kind:  string(kind),
+	}
+
+	mappings, _ := mapper.RESTMappings(gk, gatewayapi.SchemeGroupVersion.Version)
+
+	return len(mappings) > 0
+}
+
 func ServiceTagForGateway(name kube_types.NamespacedName) map[string]string {
 	return map[string]string{
 		mesh_proto.ServiceTag: fmt.Sprintf("%s_%s_gateway", name.Name, name.Namespace),
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -10,12 +11,20 @@ import (
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kube_types "k8s.io/apimachinery/pkg/types"
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
 	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
 )
 
-const ownerLabel = "gateways.kuma.io/gateway.networking.k8s.io-owner"
+const OwnerLabel = "gateways.kuma.io/gateway.networking.k8s.io-owner"
+
+// OwnerLabelValue returns the value of the owner label for objects owned
+// by the object of the given kind and name. The kind is part of the value,
+// so that same-named objects of different kinds don't share owned objects.
+func OwnerLabelValue(ownerKind gatewayapi.Kind, owner kube_types.NamespacedName) string {
+	return fmt.Sprintf("%s-%s-%s", strings.ToLower(string(ownerKind)), owner.Namespace, owner.Name)
+}
 
 // ReconcileLabelledObject manages a set of owned kuma objects based on
 // labels with the owner key.
@@ -26,17 +35,26 @@ func ReconcileLabelledObject(
 	ctx context.Context,
 	registry k8s_registry.TypeRegistry,
 	client kube_client.Client,
+	ownerKind gatewayapi.Kind,
 	owner kube_types.NamespacedName,
 	ownerMesh string,
 	ownedType k8s_registry.ResourceType,
 	ownedSpec proto.Message,
 ) error {
+	// Objects used to be labelled without the owner kind. These are
+	// replaced by objects with the current label.
+	if err := deleteLabelledObjects(ctx, registry, client, ownedType, kube_client.MatchingLabels{
+		OwnerLabel: fmt.Sprintf("%s-%s", owner.Namespace, owner.Name),
+	}); err != nil {
+		return err
+	}
+
 	// First we list which existing objects are owned by this owner.
 	// We expect either 0 or 1 and depending on whether routeSpec is nil
 	// we either create an object or update or delete the existing one.
-	ownerLabelValue := fmt.Sprintf("%s-%s", owner.Namespace, owner.Name)
+	ownerLabelValue := OwnerLabelValue(ownerKind, owner)
 	labels := kube_client.MatchingLabels{
-		ownerLabel: ownerLabelValue,
+		OwnerLabel: ownerLabelValue,
 	}
 
 	ownedList, err := registry.NewList(ownedType)
@@ -91,7 +109,7 @@ func ReconcileLabelledObject(
 		&kube_meta.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-", ownerLabelValue),
 			Labels: map[string]string{
-				ownerLabel: ownerLabelValue,
+				OwnerLabel: ownerLabelValue,
 			},
 		},
 	)
@@ -103,3 +121,28 @@ func ReconcileLabelledObject(
 
 	return nil
 }
+
+func deleteLabelledObjects(
+	ctx context.Context,
+	registry k8s_registry.TypeRegistry,
+	client kube_client.Client,
+	ownedType k8s_registry.ResourceType,
+	labels kube_client.MatchingLabels,
+) error {
+	ownedList, err := registry.NewList(ownedType)
+	if err != nil {
+		return errors.Wrapf(err, "could not create list of owned %T", ownedType)
+	}
+
+	if err := client.List(ctx, ownedList, labels); err != nil {
+		return err
+	}
+
+	for _, item := range ownedList.GetItems() {
+		if err := client.Delete(ctx, item); err != nil && !kube_apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -40,6 +40,10 @@ type GatewayReconciler struct {
 	SystemNamespace string
 	ProxyFactory    *containers.DataplaneProxyFactory
 	ResourceManager manager.ResourceManager
+
+	// routeKinds are the kinds of routes whose CRDs are installed
+	// in the cluster. It is set up in SetupWithManager.
+	routeKinds []routeKind
 }
 
 // Reconcile handles transforming a gateway-api MeshGateway into a Kuma MeshGateway and
@@ -51,7 +55,7 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request
 		if kube_apierrs.IsNotFound(err) {
 			// We don't know the mesh, but we don't need it to delete our
 			// object.
-			err := common.ReconcileLabelledObject(ctx, r.TypeRegistry, r.Client, req.NamespacedName, core_model.NoMesh, &mesh_proto.MeshGateway{}, nil)
+			err := common.ReconcileLabelledObject(ctx, r.TypeRegistry, r.Client, common.GatewayKind, req.NamespacedName, core_model.NoMesh, &mesh_proto.MeshGateway{}, nil)
 			return kube_ctrl.Result{}, errors.Wrap(err, "could not delete owned MeshGateway.kuma.io")
 		}
 
@@ -84,7 +88,7 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request
 
 	var gatewayInstance *mesh_k8s.MeshGatewayInstance
 	if gatewaySpec != nil {
-		if err := common.ReconcileLabelledObject(ctx, r.TypeRegistry, r.Client, req.NamespacedName, mesh, &mesh_proto.MeshGateway{}, gatewaySpec); err != nil {
+		if err := common.ReconcileLabelledObject(ctx, r.TypeRegistry, r.Client, common.GatewayKind, req.NamespacedName, mesh, &mesh_proto.MeshGateway{}, gatewaySpec); err != nil {
 			return kube_ctrl.Result{}, errors.Wrap(err, "could not reconcile owned MeshGateway.kuma.io")
 		}
 
@@ -145,36 +149,44 @@ func (r *GatewayReconciler) createOrUpdateInstance(ctx context.Context, client k
 const gatewayIndexField = ".metadata.gateway"
 
 // gatewaysForRoute returns a function that calculates which MeshGateways might
-// be affected by changes in an HTTPRoute so they can be reconciled.
+// be affected by changes in a route so they can be reconciled.
 func gatewaysForRoute(l logr.Logger) kube_handler.MapFunc {
 	l = l.WithName("gatewaysForRoute")
 
 	return func(obj kube_client.Object) []kube_reconcile.Request {
-		route, ok := obj.(*gatewayapi.HTTPRoute)
-		if !ok {
-			l.Error(nil, "unexpected error converting to be mapped %T object to HTTPRoute", obj)
+		switch obj.(type) {
+		case *gatewayapi.HTTPRoute, *gatewayapi.TCPRoute, *gatewayapi.TLSRoute:
+		default:
+			l.Error(nil, "unexpected error converting to be mapped %T object to a route", obj)
 			return nil
 		}
 
 		var requests []kube_reconcile.Request
-		for _, parentRef := range route.Spec.ParentRefs {
-			namespace := route.Namespace
-			if parentRef.Namespace != nil {
-				namespace = string(*parentRef.Namespace)
-			}
-
-			requests = append(
-				requests,
-				kube_reconcile.Request{
-					NamespacedName: kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)},
-				},
-			)
+		for _, name := range gatewayNamesForRoute(obj.GetNamespace(), routeParentRefs(obj)) {
+			requests = append(requests, kube_reconcile.Request{NamespacedName: name})
 		}
 
 		return requests
 	}
 }
 
+// gatewayNamesForRoute returns the names of the Gateways that the parent
+// refs of a route in the given namespace refer to.
+func gatewayNamesForRoute(routeNamespace string, parentRefs []gatewayapi.ParentReference) []kube_types.NamespacedName {
+	var names []kube_types.NamespacedName
+
+	for _, parentRef := range parentRefs {
+		namespace := routeNamespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+
+		names = append(names, kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)})
+	}
+
+	return names
+}
+
 // gatewaysForClass returns a function that calculates which Gateways might
 // be affected by changes in a GatewayClass so they can be reconciled.
 func gatewaysForClass(l logr.Logger, client kube_client.Client) kube_handler.MapFunc {
@@ -248,38 +260,42 @@ func gatewaysForConfig(l logr.Logger, client kube_client.Client) kube_handler.Ma
 }
 
 func (r *GatewayReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
-	// This index helps us list routes that point to a MeshGateway in
-	// attachedListenersForMeshGateway.
-	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayapi.HTTPRoute{}, gatewayIndexField, func(obj kube_client.Object) []string {
-		route := obj.(*gatewayapi.HTTPRoute)
+	// Only HTTPRoute is part of the standard Gateway API CRDs, the other
+	// route kinds are only present if the experimental CRDs are installed.
+	r.routeKinds = nil
+	for _, rk := range routeKinds {
+		if common.KindPresent(mgr.GetRESTMapper(), rk.kind) {
+			r.routeKinds = append(r.routeKinds, rk)
+		}
+	}
+
+	builder := kube_ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayapi.Gateway{}).
+		Owns(&mesh_k8s.MeshGateway{}).
+		Owns(&mesh_k8s.MeshGatewayInstance{})
 
-		var names []string
+	for _, rk := range r.routeKinds {
+		// This index helps us list routes that point to a MeshGateway in
+		// attachedRoutesForListeners.
+		if err := mgr.GetFieldIndexer().IndexField(context.Background(), rk.newObj(), gatewayIndexField, func(obj kube_client.Object) []string {
+			var names []string
 
-		for _, parentRef := range route.Spec.ParentRefs {
-			namespace := route.Namespace
-			if parentRef.Namespace != nil {
-				namespace = string(*parentRef.Namespace)
+			for _, name := range gatewayNamesForRoute(obj.GetNamespace(), routeParentRefs(obj)) {
+				names = append(names, name.String())
 			}
 
-			names = append(
-				names,
-				kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}.String(),
-			)
+			return names
+		}); err != nil {
+			return err
 		}
 
-		return names
-	}); err != nil {
-		return err
+		builder = builder.Watches(
+			&kube_source.Kind{Type: rk.newObj()},
+			kube_handler.EnqueueRequestsFromMapFunc(gatewaysForRoute(r.Log)),
+		)
 	}
 
-	return kube_ctrl.NewControllerManagedBy(mgr).
-		For(&gatewayapi.Gateway{}).
-		Owns(&mesh_k8s.MeshGateway{}).
-		Owns(&mesh_k8s.MeshGatewayInstance{}).
-		Watches(
-			&kube_source.Kind{Type: &gatewayapi.HTTPRoute{}},
-			kube_handler.EnqueueRequestsFromMapFunc(gatewaysForRoute(r.Log)),
-		).
+	return builder.
 		Watches(
 			&kube_source.Kind{Type: &gatewayapi.GatewayClass{}},
 			kube_handler.EnqueueRequestsFromMapFunc(gatewaysForClass(r.Log, r.Client)),
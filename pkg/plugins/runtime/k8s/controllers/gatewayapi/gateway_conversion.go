@@ -20,7 +20,8 @@ type ListenerConditions map[gatewayapi.SectionName][]kube_meta.Condition
 
 func validProtocol(protocol gatewayapi.ProtocolType) bool {
 	switch protocol {
-	case gatewayapi.HTTPProtocolType, gatewayapi.HTTPSProtocolType:
+	case gatewayapi.HTTPProtocolType, gatewayapi.HTTPSProtocolType,
+		gatewayapi.TCPProtocolType, gatewayapi.TLSProtocolType:
 		return true
 	default:
 	}
@@ -28,6 +29,29 @@ func validProtocol(protocol gatewayapi.ProtocolType) bool {
 	return false
 }
 
+// validTLSMode returns an error message if the listener TLS mode isn't
+// supported for the listener protocol. We terminate TLS for HTTPS
+// listeners and pass it through for TLS listeners.
+func validTLSMode(l gatewayapi.Listener) string {
+	mode := gatewayapi.TLSModeTerminate
+	if l.TLS != nil && l.TLS.Mode != nil {
+		mode = *l.TLS.Mode
+	}
+
+	switch l.Protocol {
+	case gatewayapi.HTTPSProtocolType:
+		if mode != gatewayapi.TLSModeTerminate {
+			return fmt.Sprintf("unsupported TLS mode %s for protocol %s", mode, l.Protocol)
+		}
+	case gatewayapi.TLSProtocolType:
+		if mode != gatewayapi.TLSModePassthrough {
+			return fmt.Sprintf("unsupported TLS mode %s for protocol %s", mode, l.Protocol)
+		}
+	}
+
+	return ""
+}
+
 func ValidateListeners(listeners []gatewayapi.Listener) ([]gatewayapi.Listener, ListenerConditions) {
 	var validListeners []gatewayapi.Listener
 	listenerConditions := ListenerConditions{}
@@ -116,6 +140,15 @@ func ValidateListeners(listeners []gatewayapi.Listener) ([]gatewayapi.Listener,
 			continue
 		}
 
+		if message := validTLSMode(l); message != "" {
+			appendDetachedCondition(
+				l.Name,
+				gatewayapi.ListenerReasonUnsupportedProtocol,
+				message,
+			)
+			continue
+		}
+
 		// TODO ListenerReasonUnsupportedAddress and ListenerReasonPortUnavailable
 		// need more information from Envoy Gateway
 
@@ -145,8 +178,6 @@ func ValidateListeners(listeners []gatewayapi.Listener) ([]gatewayapi.Listener,
 
 		// We don't set ListenerReasonRouteConflict because we already check the
 		// routes with ListenerReasonInvalidRouteKinds
-		// Once we support more than HTTPRoute it may be fitting to set this
-		// depending on the listener protocol
 
 		validListeners = append(validListeners, l)
 	}
@@ -190,8 +221,10 @@ func (r *GatewayReconciler) gapiToKumaGateway(
 			continue
 		}
 
+		supportedKinds := common.SupportedRouteKinds(l.Protocol)
+
 		for _, gk := range l.AllowedRoutes.Kinds {
-			if gk.Kind != common.HTTPRouteKind || *gk.Group != gatewayapi.GroupName {
+			if !kindIn(gk.Kind, supportedKinds) || *gk.Group != gatewayapi.GroupName {
 				metaGK := kube_meta.GroupKind{Group: string(*gk.Group), Kind: string(gk.Kind)}
 				listenerConditions[l.Name] = append(listenerConditions[l.Name],
 					kube_meta.Condition{
@@ -205,8 +238,10 @@ func (r *GatewayReconciler) gapiToKumaGateway(
 			}
 		}
 
+		// TCP listeners can't match on hostnames, so any
+		// hostname given is ignored.
 		listener.Hostname = "*"
-		if l.Hostname != nil {
+		if l.Hostname != nil && l.Protocol != gatewayapi.TCPProtocolType {
 			listener.Hostname = string(*l.Hostname)
 		}
 
@@ -226,10 +261,13 @@ func (r *GatewayReconciler) gapiToKumaGateway(
 				}
 			}
 
-			if len(unresolvableRefs) == 0 {
-				if l.TLS.Mode != nil && *l.TLS.Mode == gatewayapi.TLSModePassthrough {
-					continue // todo admission webhook should prevent this
+			if len(unresolvableRefs) == 0 && l.Protocol == gatewayapi.TLSProtocolType {
+				// ValidateListeners only allows passthrough for TLS
+				// listeners, so the certificates are unused.
+				listener.Tls = &mesh_proto.MeshGateway_TLS_Conf{
+					Mode: mesh_proto.MeshGateway_TLS_PASSTHROUGH,
 				}
+			} else if len(unresolvableRefs) == 0 {
 				listener.Tls = &mesh_proto.MeshGateway_TLS_Conf{
 					Mode: mesh_proto.MeshGateway_TLS_TERMINATE,
 				}
@@ -293,7 +331,7 @@ func (r *GatewayReconciler) gapiToKumaGateway(
 					Type:    string(gatewayapi.ListenerConditionResolvedRefs),
 					Status:  kube_meta.ConditionFalse,
 					Reason:  string(gatewayapi.ListenerReasonRefNotPermitted),
-					Message: fmt.Sprintf("references to %s not permitted by any ReferencePolicy", strings.Join(unresolvableRefs, ", ")),
+					Message: fmt.Sprintf("references to %s not permitted by any ReferenceGrant or ReferencePolicy", strings.Join(unresolvableRefs, ", ")),
 				},
 				{
 					Type:    string(gatewayapi.ListenerConditionReady),
@@ -324,3 +362,13 @@ func (r *GatewayReconciler) gapiToKumaGateway(
 
 	return kumaGateway, listenerConditions, nil
 }
+
+func kindIn(kind gatewayapi.Kind, kinds []gatewayapi.Kind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
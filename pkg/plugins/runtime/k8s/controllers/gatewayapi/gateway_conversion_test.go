@@ -161,4 +161,93 @@ var _ = Describe("ValidateListeners", func() {
 			}),
 		)
 	})
+	It("works with TCP and TLS passthrough listeners", func() {
+		same := gatewayapi.NamespacesFromSame
+		passthrough := gatewayapi.TLSModePassthrough
+		listeners := []gatewayapi.Listener{
+			{
+				Name:     gatewayapi.SectionName("tcp"),
+				Protocol: gatewayapi.TCPProtocolType,
+				Port:     gatewayapi.PortNumber(5432),
+				AllowedRoutes: &gatewayapi.AllowedRoutes{
+					Namespaces: &gatewayapi.RouteNamespaces{
+						From: &same,
+					},
+				},
+			},
+			{
+				Name:     gatewayapi.SectionName("tls"),
+				Protocol: gatewayapi.TLSProtocolType,
+				Port:     gatewayapi.PortNumber(443),
+				TLS: &gatewayapi.GatewayTLSConfig{
+					Mode: &passthrough,
+				},
+				AllowedRoutes: &gatewayapi.AllowedRoutes{
+					Namespaces: &gatewayapi.RouteNamespaces{
+						From: &same,
+					},
+				},
+			},
+		}
+		valids, conditions := k8s_gatewayapi.ValidateListeners(listeners)
+		Expect(valids).To(ConsistOf(
+			HaveField("Name", gatewayapi.SectionName("tcp")),
+			HaveField("Name", gatewayapi.SectionName("tls")),
+		))
+		Expect(conditions).To(BeEmpty())
+	})
+	It("detaches listeners with unsupported TLS modes", func() {
+		same := gatewayapi.NamespacesFromSame
+		passthrough := gatewayapi.TLSModePassthrough
+		terminate := gatewayapi.TLSModeTerminate
+		listeners := []gatewayapi.Listener{
+			{
+				Name:     gatewayapi.SectionName("https-passthrough"),
+				Protocol: gatewayapi.HTTPSProtocolType,
+				Port:     gatewayapi.PortNumber(443),
+				TLS: &gatewayapi.GatewayTLSConfig{
+					Mode: &passthrough,
+				},
+				AllowedRoutes: &gatewayapi.AllowedRoutes{
+					Namespaces: &gatewayapi.RouteNamespaces{
+						From: &same,
+					},
+				},
+			},
+			{
+				Name:     gatewayapi.SectionName("tls-terminate"),
+				Protocol: gatewayapi.TLSProtocolType,
+				Port:     gatewayapi.PortNumber(8443),
+				TLS: &gatewayapi.GatewayTLSConfig{
+					Mode: &terminate,
+				},
+				AllowedRoutes: &gatewayapi.AllowedRoutes{
+					Namespaces: &gatewayapi.RouteNamespaces{
+						From: &same,
+					},
+				},
+			},
+		}
+		valids, conditions := k8s_gatewayapi.ValidateListeners(listeners)
+
+		Expect(valids).To(BeEmpty())
+
+		detached := ContainElements(
+			MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(string(gatewayapi.ListenerConditionDetached)),
+				"Status": Equal(kube_meta.ConditionTrue),
+				"Reason": Equal(string(gatewayapi.ListenerReasonUnsupportedProtocol)),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(string(gatewayapi.ListenerConditionReady)),
+				"Status": Equal(kube_meta.ConditionFalse),
+			}),
+		)
+		Expect(conditions).To(
+			MatchAllKeys(Keys{
+				gatewayapi.SectionName("https-passthrough"): detached,
+				gatewayapi.SectionName("tls-terminate"):     detached,
+			}),
+		)
+	})
 })
@@ -25,7 +25,7 @@ func (r *GatewayReconciler) updateStatus(
 ) error {
 	updated := gateway.DeepCopy()
 
-	attachedListeners, err := attachedRoutesForListeners(ctx, gateway, r.Client)
+	attachedListeners, err := attachedRoutesForListeners(ctx, gateway, r.Client, r.routeKinds)
 	if err != nil {
 		return err
 	}
@@ -91,30 +91,44 @@ func attachedRoutesForListeners(
 	ctx context.Context,
 	gateway *gatewayapi.Gateway,
 	client kube_client.Client,
+	kinds []routeKind,
 ) (AttachedRoutesForListeners, error) {
-	var routes gatewayapi.HTTPRouteList
-	if err := client.List(ctx, &routes, kube_client.MatchingFields{
-		gatewayIndexField: kube_client.ObjectKeyFromObject(gateway).String(),
-	}); err != nil {
-		return nil, errors.Wrap(err, "unexpected error listing HTTPRoutes")
+	var routes []kube_client.Object
+
+	for _, rk := range kinds {
+		list := rk.newList()
+		if err := client.List(ctx, list, kube_client.MatchingFields{
+			gatewayIndexField: kube_client.ObjectKeyFromObject(gateway).String(),
+		}); err != nil {
+			return nil, errors.Wrapf(err, "unexpected error listing %ss", rk.kind)
+		}
+
+		items, err := kube_apimeta.ExtractList(list)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected error extracting %ss", rk.kind)
+		}
+
+		for _, item := range items {
+			routes = append(routes, item.(kube_client.Object))
+		}
 	}
 
 	attachedRoutes := AttachedRoutesForListeners{}
 
-	for _, route := range routes.Items {
-		for _, parentRef := range route.Spec.ParentRefs {
+	for _, route := range routes {
+		for _, parentRef := range routeParentRefs(route) {
 			sectionName := everyListener
 			if parentRef.SectionName != nil {
 				sectionName = *parentRef.SectionName
 			}
 
-			for _, refStatus := range route.Status.Parents {
+			for _, refStatus := range routeStatus(route).Parents {
 				if reflect.DeepEqual(refStatus.ParentRef, parentRef) {
 					attached := attachedRoutes[sectionName]
 					attached.num++
 
 					if kube_apimeta.IsStatusConditionFalse(refStatus.Conditions, string(gatewayapi.ConditionRouteResolvedRefs)) {
-						attached.invalidRoutes = append(attached.invalidRoutes, kube_client.ObjectKeyFromObject(&route).String())
+						attached.invalidRoutes = append(attached.invalidRoutes, kube_client.ObjectKeyFromObject(route).String())
 					}
 
 					attachedRoutes[sectionName] = attached
@@ -126,6 +140,22 @@ func attachedRoutesForListeners(
 	return attachedRoutes, nil
 }
 
+// supportedKindsForListener returns the route kinds that can be attached
+// to the listener, i.e. the kinds allowed by the listener that are
+// supported for its protocol.
+func supportedKindsForListener(listener gatewayapi.Listener) []gatewayapi.RouteGroupKind {
+	var kinds []gatewayapi.RouteGroupKind
+
+	for _, kind := range common.SupportedRouteKinds(listener.Protocol) {
+		if common.ListenerAllowsRouteKind(listener, kind) {
+			group := gatewayapi.Group(gatewayapi.GroupName)
+			kinds = append(kinds, gatewayapi.RouteGroupKind{Group: &group, Kind: kind})
+		}
+	}
+
+	return kinds
+}
+
 // mergeGatewayListenerStatuses takes the statuses of the attached Routes and
 // the other calculated conditions for this listener and returns a
 // ListenerStatus.
@@ -135,6 +165,11 @@ func mergeGatewayListenerStatuses(
 	attachedRouteStatuses AttachedRoutesForListeners,
 ) []gatewayapi.ListenerStatus {
 	previousStatuses := map[gatewayapi.SectionName]gatewayapi.ListenerStatus{}
+	listeners := map[gatewayapi.SectionName]gatewayapi.Listener{}
+
+	for _, listener := range gateway.Spec.Listeners {
+		listeners[listener.Name] = listener
+	}
 
 	for _, status := range gateway.Status.Listeners {
 		previousStatuses[status.Name] = status
@@ -148,15 +183,19 @@ func mergeGatewayListenerStatuses(
 		previousStatus := gatewayapi.ListenerStatus{
 			Name:           name,
 			AttachedRoutes: 0,
-			// TODO this should be Listener.AllowedRoutes with invalid kinds
-			// removed, i.e. it may be empty
-			SupportedKinds: []gatewayapi.RouteGroupKind{{Kind: common.HTTPRouteKind}},
 		}
 
 		if prev, ok := previousStatuses[name]; ok {
 			previousStatus = prev
 		}
 
+		// SupportedKinds is required, but may be empty if no kinds
+		// allowed by the listener are supported.
+		previousStatus.SupportedKinds = supportedKindsForListener(listeners[name])
+		if previousStatus.SupportedKinds == nil {
+			previousStatus.SupportedKinds = []gatewayapi.RouteGroupKind{}
+		}
+
 		for _, condition := range conditions {
 			condition.ObservedGeneration = gateway.GetGeneration()
 			kube_apimeta.SetStatusCondition(&previousStatus.Conditions, condition)
@@ -173,7 +212,7 @@ func mergeGatewayListenerStatuses(
 		if len(invalidRoutes) > 0 &&
 			kube_apimeta.IsStatusConditionTrue(previousStatus.Conditions, string(gatewayapi.ListenerConditionResolvedRefs)) {
 			// We only set the ResolvedRefs condition and don't set ready false
-			message := fmt.Sprintf("Attached HTTPRoutes %s have unresolved BackendRefs", strings.Join(invalidRoutes, ", "))
+			message := fmt.Sprintf("Attached routes %s have unresolved BackendRefs", strings.Join(invalidRoutes, ", "))
 			kube_apimeta.SetStatusCondition(&previousStatus.Conditions, kube_meta.Condition{
 				Type:               string(gatewayapi.ListenerConditionResolvedRefs),
 				Status:             kube_meta.ConditionFalse,
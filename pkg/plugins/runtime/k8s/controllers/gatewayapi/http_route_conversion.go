@@ -5,38 +5,27 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
-	kube_core "k8s.io/api/core/v1"
-	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
-	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
-	"github.com/kumahq/kuma/pkg/core/resources/store"
-	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/policy"
-	k8s_util "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/util"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 func (r *HTTPRouteReconciler) gapiToKumaRule(
 	ctx context.Context, mesh string, route *gatewayapi.HTTPRoute, rule gatewayapi.HTTPRouteRule,
 ) (mesh_proto.MeshGatewayRoute_HttpRoute_Rule, *kube_meta.Condition, error) {
-	var backends []*mesh_proto.MeshGatewayRoute_Backend
-
+	var backendRefs []gatewayapi.BackendRef
 	for _, backend := range rule.BackendRefs {
-		ref := backend.BackendObjectReference
-
-		destination, condition, err := r.gapiToKumaRef(ctx, mesh, route.Namespace, ref)
-		if err != nil || condition != nil {
-			return mesh_proto.MeshGatewayRoute_HttpRoute_Rule{}, condition, err
-		}
+		backendRefs = append(backendRefs, backend.BackendRef)
+	}
 
-		backends = append(backends, &mesh_proto.MeshGatewayRoute_Backend{
-			// Weight has a default of 1
-			Weight:      uint32(*backend.Weight),
-			Destination: destination,
-		})
+	backends, condition, err := gapiToKumaBackends(
+		ctx, r.Client, r.ResourceManager, mesh, policy.FromHTTPRouteIn(route.Namespace), backendRefs,
+	)
+	if err != nil || condition != nil {
+		return mesh_proto.MeshGatewayRoute_HttpRoute_Rule{}, condition, err
 	}
 
 	var matches []*mesh_proto.MeshGatewayRoute_HttpRoute_Match
@@ -105,22 +94,7 @@ func (r *HTTPRouteReconciler) gapiToKumaRouteConf(
 		},
 	}
 
-	conditions := []kube_meta.Condition{
-		{
-			Type:   string(gatewayapi.ConditionRouteResolvedRefs),
-			Status: kube_meta.ConditionTrue,
-			Reason: string(gatewayapi.ConditionRouteResolvedRefs),
-		},
-		// TODO: reflect the true state from the actual gateway of this
-		// route
-		{
-			Type:   string(gatewayapi.ConditionRouteAccepted),
-			Status: kube_meta.ConditionTrue,
-			Reason: string(gatewayapi.ConditionRouteAccepted),
-		},
-	}
-
-	return &routeConf, conditions, nil
+	return &routeConf, routeConfConditions(), nil
 }
 
 func k8sToKumaHeader(header gatewayapi.HTTPHeader) *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header {
@@ -130,96 +104,6 @@ func k8sToKumaHeader(header gatewayapi.HTTPHeader) *mesh_proto.MeshGatewayRoute_
 	}
 }
 
-// gapiToKumaRef checks a reference and tries to resolve if it's supported by
-// Kuma. It returns a condition with Reason/Message if it fails or an error for
-// unexpected errors.
-func (r *HTTPRouteReconciler) gapiToKumaRef(
-	ctx context.Context, mesh string, objectNamespace string, ref gatewayapi.BackendObjectReference,
-) (map[string]string, *kube_meta.Condition, error) {
-	policyRef := policy.PolicyReferenceBackend(policy.FromHTTPRouteIn(objectNamespace), ref)
-
-	gk := policyRef.GroupKindReferredTo()
-	namespacedName := policyRef.NamespacedNameReferredTo()
-
-	if permitted, err := policy.IsReferencePermitted(ctx, r.Client, policyRef); err != nil {
-		return nil, nil, errors.Wrap(err, "couldn't determine if backend reference is permitted")
-	} else if !permitted {
-		return nil,
-			&kube_meta.Condition{
-				Type:    string(gatewayapi.ConditionRouteResolvedRefs),
-				Status:  kube_meta.ConditionFalse,
-				Reason:  RefNotPermitted,
-				Message: fmt.Sprintf("reference to %s %q not permitted by any ReferencePolicy", gk, namespacedName),
-			},
-			nil
-	}
-
-	switch {
-	case gk.Kind == "Service" && gk.Group == "":
-		// References to Services are required by GAPI to include a port
-		// TODO remove when https://github.com/kubernetes-sigs/gateway-api/pull/944
-		// is released
-		if ref.Port == nil {
-			return nil,
-				&kube_meta.Condition{
-					Type:    string(gatewayapi.ConditionRouteResolvedRefs),
-					Status:  kube_meta.ConditionFalse,
-					Reason:  RefInvalid,
-					Message: "backend reference must include port",
-				},
-				nil
-		}
-		port := int32(*ref.Port)
-
-		svc := &kube_core.Service{}
-		if err := r.Client.Get(ctx, namespacedName, svc); err != nil {
-			if kube_apierrs.IsNotFound(err) {
-				return nil,
-					&kube_meta.Condition{
-						Type:    string(gatewayapi.ConditionRouteResolvedRefs),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  ObjectNotFound,
-						Message: fmt.Sprintf("backend reference references a non-existent Service %q", namespacedName.String()),
-					},
-					nil
-			}
-			return nil, nil, err
-		}
-
-		return map[string]string{
-			mesh_proto.ServiceTag: k8s_util.ServiceTagFor(svc, &port),
-		}, nil, nil
-	case gk.Kind == "ExternalService" && gk.Group == mesh_k8s.GroupVersion.Group:
-		resource := core_mesh.NewExternalServiceResource()
-		if err := r.ResourceManager.Get(ctx, resource, store.GetByKey(namespacedName.Name, mesh)); err != nil {
-			if store.IsResourceNotFound(err) {
-				return nil,
-					&kube_meta.Condition{
-						Type:    string(gatewayapi.ConditionRouteResolvedRefs),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  ObjectNotFound,
-						Message: fmt.Sprintf("backend reference references a non-existent ExternalService %q", namespacedName.Name),
-					},
-					nil
-			}
-			return nil, nil, err
-		}
-
-		return map[string]string{
-			mesh_proto.ServiceTag: resource.Spec.GetService(),
-		}, nil, nil
-	}
-
-	return nil,
-		&kube_meta.Condition{
-			Type:    string(gatewayapi.ConditionRouteResolvedRefs),
-			Status:  kube_meta.ConditionFalse,
-			Reason:  ObjectTypeUnknownOrInvalid,
-			Message: "backend reference must be Service or externalservice.kuma.io",
-		},
-		nil
-}
-
 func gapiToKumaMatch(match gatewayapi.HTTPRouteMatch) (*mesh_proto.MeshGatewayRoute_HttpRoute_Match, error) {
 	kumaMatch := &mesh_proto.MeshGatewayRoute_HttpRoute_Match{}
 
@@ -310,7 +194,7 @@ func (r *HTTPRouteReconciler) gapiToKumaFilter(
 	case gatewayapi.HTTPRouteFilterRequestMirror:
 		filter := filter.RequestMirror
 
-		destinationRef, condition, err := r.gapiToKumaRef(ctx, mesh, namespace, filter.BackendRef)
+		destinationRef, condition, err := gapiToKumaRef(ctx, r.Client, r.ResourceManager, mesh, policy.FromHTTPRouteIn(namespace), filter.BackendRef)
 		if err != nil || condition != nil {
 			return nil, condition, err
 		}
@@ -349,6 +233,35 @@ func (r *HTTPRouteReconciler) gapiToKumaFilter(
 		kumaFilter.Filter = &mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Redirect_{
 			Redirect: &redirect,
 		}
+	case gatewayapi.HTTPRouteFilterURLRewrite:
+		filter := filter.URLRewrite
+
+		rewrite := mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite{}
+
+		if h := filter.Hostname; h != nil {
+			rewrite.Host = &mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_Hostname{
+				Hostname: string(*h),
+			}
+		}
+
+		if p := filter.Path; p != nil {
+			switch p.Type {
+			case gatewayapi.AbsoluteHTTPPathModifier:
+				rewrite.Path = &mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull{
+					ReplaceFull: p.Substitution,
+				}
+			case gatewayapi.PrefixMatchHTTPPathModifier:
+				rewrite.Path = &mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch{
+					ReplacePrefixMatch: p.Substitution,
+				}
+			default:
+				return nil, nil, fmt.Errorf("unsupported path modifier type %q", p.Type)
+			}
+		}
+
+		kumaFilter.Filter = &mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_{
+			Rewrite: &rewrite,
+		}
 	default:
 		return nil, nil, fmt.Errorf("unsupported filter type %q", filter.Type)
 	}
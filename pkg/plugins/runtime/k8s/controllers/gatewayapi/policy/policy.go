@@ -5,6 +5,9 @@ import (
 	"reflect"
 
 	"github.com/pkg/errors"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
 	kube_schema "k8s.io/apimachinery/pkg/runtime/schema"
 	kube_types "k8s.io/apimachinery/pkg/types"
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,8 +38,12 @@ func FromGatewayIn(namespace string) gatewayapi.ReferencePolicyFrom {
 }
 
 func FromHTTPRouteIn(namespace string) gatewayapi.ReferencePolicyFrom {
+	return FromRouteIn(gatewayapi.Kind("HTTPRoute"), namespace)
+}
+
+func FromRouteIn(kind gatewayapi.Kind, namespace string) gatewayapi.ReferencePolicyFrom {
 	return gatewayapi.ReferencePolicyFrom{
-		Kind:      gatewayapi.Kind("HTTPRoute"),
+		Kind:      kind,
 		Group:     gatewayapi.Group(gatewayapi.GroupName),
 		Namespace: gatewayapi.Namespace(namespace),
 	}
@@ -74,8 +81,18 @@ func PolicyReferenceSecret(from gatewayapi.ReferencePolicyFrom, to gatewayapi.Se
 	}
 }
 
+// ReferenceGrantListGVK is the GroupVersionKind of ReferenceGrant lists.
+// ReferenceGrant replaces ReferencePolicy, but isn't available in the
+// version of the Gateway API types we use, so we read ReferenceGrants as
+// unstructured objects. Their spec is the same as ReferencePolicy's.
+var ReferenceGrantListGVK = kube_schema.GroupVersionKind{
+	Group:   gatewayapi.GroupName,
+	Version: gatewayapi.GroupVersion.Version,
+	Kind:    "ReferenceGrantList",
+}
+
 // IsReferencePermitted returns whether the given reference is permitted with respect
-// to ReferencePolicies.
+// to ReferenceGrants and ReferencePolicies.
 func IsReferencePermitted(
 	ctx context.Context,
 	client kube_client.Client,
@@ -85,17 +102,26 @@ func IsReferencePermitted(
 		return true, nil
 	}
 
+	specs, err := referenceGrantSpecs(ctx, client, string(reference.toNamespace))
+	if err != nil {
+		return false, err
+	}
+
 	policies := &gatewayapi.ReferencePolicyList{}
 	if err := client.List(ctx, policies, kube_client.InNamespace(reference.toNamespace)); err != nil {
 		return false, errors.Wrap(err, "failed to list ReferencePolicies")
 	}
 
 	for _, policy := range policies.Items {
-		if !someFromMatches(reference.from, policy.Spec.From) {
+		specs = append(specs, policy.Spec)
+	}
+
+	for _, spec := range specs {
+		if !someFromMatches(reference.from, spec.From) {
 			continue
 		}
 
-		if someToMatches(reference.to, policy.Spec.To) {
+		if someToMatches(reference.to, spec.To) {
 			return true, nil
 		}
 	}
@@ -103,6 +129,42 @@ func IsReferencePermitted(
 	return false, nil
 }
 
+// referenceGrantSpecs returns the specs of the ReferenceGrants in the given
+// namespace. If the ReferenceGrant CRD isn't installed, there are no grants.
+func referenceGrantSpecs(
+	ctx context.Context,
+	client kube_client.Client,
+	namespace string,
+) ([]gatewayapi.ReferencePolicySpec, error) {
+	grants := &kube_unstructured.UnstructuredList{}
+	grants.SetGroupVersionKind(ReferenceGrantListGVK)
+
+	if err := client.List(ctx, grants, kube_client.InNamespace(namespace)); err != nil {
+		if kube_apimeta.IsNoMatchError(err) || kube_runtime.IsNotRegisteredError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list ReferenceGrants")
+	}
+
+	var specs []gatewayapi.ReferencePolicySpec
+
+	for _, grant := range grants.Items {
+		spec, _, err := kube_unstructured.NestedMap(grant.Object, "spec")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ReferenceGrant %s", grant.GetName())
+		}
+
+		var policySpec gatewayapi.ReferencePolicySpec
+		if err := kube_runtime.DefaultUnstructuredConverter.FromUnstructured(spec, &policySpec); err != nil {
+			return nil, errors.Wrapf(err, "invalid ReferenceGrant %s", grant.GetName())
+		}
+
+		specs = append(specs, policySpec)
+	}
+
+	return specs, nil
+}
+
 func someFromMatches(from gatewayapi.ReferencePolicyFrom, permitted []gatewayapi.ReferencePolicyFrom) bool {
 	for _, permittedFrom := range permitted {
 		if reflect.DeepEqual(permittedFrom, from) {
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kube_runtime "k8s.io/apimachinery/pkg/runtime"
 	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -166,3 +167,76 @@ var _ = Describe("ReferencePolicy support", func() {
 		})
 	})
 })
+
+var _ = Describe("ReferenceGrant support", func() {
+	simpleGrant := func(toKind string) *kube_unstructured.Unstructured {
+		grant := &kube_unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "basic",
+					"namespace": otherNs,
+				},
+				"spec": map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"group":     gatewayapi.GroupName,
+							"kind":      "HTTPRoute",
+							"namespace": defaultNs,
+						},
+					},
+					"to": []interface{}{
+						map[string]interface{}{
+							"group": "",
+							"kind":  toKind,
+						},
+					},
+				},
+			},
+		}
+		grant.SetGroupVersionKind(gatewayapi.SchemeGroupVersion.WithKind("ReferenceGrant"))
+		return grant
+	}
+
+	It("permits references matching a ReferenceGrant", func() {
+		kubeClient := kube_client_fake.NewClientBuilder().WithScheme(k8sScheme).WithObjects(
+			simpleGrant("Service"),
+		).Build()
+
+		ref := policy.PolicyReferenceBackend(policy.FromHTTPRouteIn(defaultNs), toOtherSvc)
+		permitted, err := policy.IsReferencePermitted(
+			context.Background(),
+			kubeClient,
+			ref,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permitted).To(BeTrue())
+	})
+	It("denies references not matching any ReferenceGrant", func() {
+		kubeClient := kube_client_fake.NewClientBuilder().WithScheme(k8sScheme).WithObjects(
+			simpleGrant("Secret"),
+		).Build()
+
+		ref := policy.PolicyReferenceBackend(policy.FromHTTPRouteIn(defaultNs), toOtherSvc)
+		permitted, err := policy.IsReferencePermitted(
+			context.Background(),
+			kubeClient,
+			ref,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permitted).To(BeFalse())
+	})
+	It("denies references from other route kinds", func() {
+		kubeClient := kube_client_fake.NewClientBuilder().WithScheme(k8sScheme).WithObjects(
+			simpleGrant("Service"),
+		).Build()
+
+		ref := policy.PolicyReferenceBackend(policy.FromRouteIn(gatewayapi.Kind("TCPRoute"), defaultNs), toOtherSvc)
+		permitted, err := policy.IsReferencePermitted(
+			context.Background(),
+			kubeClient,
+			ref,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permitted).To(BeFalse())
+	})
+})
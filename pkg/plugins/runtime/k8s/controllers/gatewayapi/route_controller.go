@@ -0,0 +1,178 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	kube_reconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+	k8s_util "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/util"
+)
+
+// routeKind describes a kind of Gateway API route along with
+// constructors for its object and list types.
+type routeKind struct {
+	kind    gatewayapi.Kind
+	newObj  func() kube_client.Object
+	newList func() kube_client.ObjectList
+}
+
+// routeKinds lists the kinds of Gateway API routes that we convert.
+var routeKinds = []routeKind{
+	{
+		kind:    common.HTTPRouteKind,
+		newObj:  func() kube_client.Object { return &gatewayapi.HTTPRoute{} },
+		newList: func() kube_client.ObjectList { return &gatewayapi.HTTPRouteList{} },
+	},
+	{
+		kind:    common.TCPRouteKind,
+		newObj:  func() kube_client.Object { return &gatewayapi.TCPRoute{} },
+		newList: func() kube_client.ObjectList { return &gatewayapi.TCPRouteList{} },
+	},
+	{
+		kind:    common.TLSRouteKind,
+		newObj:  func() kube_client.Object { return &gatewayapi.TLSRoute{} },
+		newList: func() kube_client.ObjectList { return &gatewayapi.TLSRouteList{} },
+	},
+}
+
+// routeParentRefs returns the parent refs of a Gateway API route object.
+func routeParentRefs(obj kube_client.Object) []gatewayapi.ParentReference {
+	switch route := obj.(type) {
+	case *gatewayapi.HTTPRoute:
+		return route.Spec.ParentRefs
+	case *gatewayapi.TCPRoute:
+		return route.Spec.ParentRefs
+	case *gatewayapi.TLSRoute:
+		return route.Spec.ParentRefs
+	default:
+		return nil
+	}
+}
+
+// routeStatus returns the status of a Gateway API route object.
+func routeStatus(obj kube_client.Object) *gatewayapi.RouteStatus {
+	switch route := obj.(type) {
+	case *gatewayapi.HTTPRoute:
+		return &route.Status.RouteStatus
+	case *gatewayapi.TCPRoute:
+		return &route.Status.RouteStatus
+	case *gatewayapi.TLSRoute:
+		return &route.Status.RouteStatus
+	default:
+		return nil
+	}
+}
+
+// routeConfConverter converts a Gateway API route into a MeshGatewayRoute
+// configuration. See gapiToKumaRouteConf.
+type routeConfConverter func(
+	ctx context.Context, mesh string, route kube_client.Object,
+) (*mesh_proto.MeshGatewayRoute_Conf, []kube_meta.Condition, error)
+
+// reconcileRoute handles transforming a gateway-api route of the given
+// kind into a Kuma GatewayRoute and managing the status of the
+// gateway-api route.
+func reconcileRoute(
+	ctx context.Context,
+	client kube_client.Client,
+	registry k8s_registry.TypeRegistry,
+	req kube_ctrl.Request,
+	kind gatewayapi.Kind,
+	route kube_client.Object,
+	convert routeConfConverter,
+) (kube_ctrl.Result, error) {
+	if err := client.Get(ctx, req.NamespacedName, route); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			// We don't know the mesh, but we don't need it to delete our
+			// object.
+			err := common.ReconcileLabelledObject(ctx, registry, client, kind, req.NamespacedName, core_model.NoMesh, &mesh_proto.MeshGatewayRoute{}, nil)
+			return kube_ctrl.Result{}, errors.Wrap(err, "could not delete owned GatewayRoute.kuma.io")
+		}
+
+		return kube_ctrl.Result{}, err
+	}
+
+	ns := kube_core.Namespace{}
+	if err := client.Get(ctx, kube_types.NamespacedName{Name: route.GetNamespace()}, &ns); err != nil {
+		return kube_ctrl.Result{}, errors.Wrapf(err, "unable to get Namespace of %s", kind)
+	}
+
+	mesh := k8s_util.MeshOf(route, &ns)
+
+	spec, conditions, err := gapiToKumaRoutes(ctx, client, mesh, kind, route, convert)
+	if err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "error generating GatewayRoute.kuma.io")
+	}
+
+	if spec != nil {
+		if err := common.ReconcileLabelledObject(ctx, registry, client, kind, req.NamespacedName, mesh, &mesh_proto.MeshGatewayRoute{}, spec); err != nil {
+			return kube_ctrl.Result{}, errors.Wrap(err, "could not reconcile owned GatewayRoute.kuma.io")
+		}
+	}
+
+	if err := updateRouteStatus(ctx, client, route, conditions); err != nil {
+		return kube_ctrl.Result{}, errors.Wrapf(err, "unable to update %s status", kind)
+	}
+
+	return kube_ctrl.Result{}, nil
+}
+
+// routesForGateway returns a function that calculates which routes might
+// be affected by changes in a Gateway so they can be reconciled.
+func routesForGateway(l logr.Logger, client kube_client.Client, newList func() kube_client.ObjectList) kube_handler.MapFunc {
+	l = l.WithName("routesForGateway")
+
+	return func(obj kube_client.Object) []kube_reconcile.Request {
+		gateway, ok := obj.(*gatewayapi.Gateway)
+		if !ok {
+			l.Error(nil, "unexpected error converting to be mapped %T object to Gateway", obj)
+			return nil
+		}
+
+		routes := newList()
+		if err := client.List(context.Background(), routes); err != nil {
+			l.Error(err, "unexpected error listing routes in cluster", "type", fmt.Sprintf("%T", routes))
+			return nil
+		}
+
+		items, err := kube_apimeta.ExtractList(routes)
+		if err != nil {
+			l.Error(err, "unexpected error extracting routes from list")
+			return nil
+		}
+
+		var requests []kube_reconcile.Request
+		for _, item := range items {
+			route, ok := item.(kube_client.Object)
+			if !ok {
+				continue
+			}
+
+			for _, parentRef := range routeParentRefs(route) {
+				if common.ParentRefMatchesGateway(route.GetNamespace(), parentRef, gateway) {
+					requests = append(requests, kube_reconcile.Request{
+						NamespacedName: kube_client.ObjectKeyFromObject(route),
+					})
+				}
+			}
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,152 @@
+package gatewayapi_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kube_core "k8s.io/api/core/v1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/bootstrap/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+	k8s_gatewayapi "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+)
+
+var _ = Describe("Route reconcilers", func() {
+	var kubeClient kube_client.Client
+	var httpReconciler *k8s_gatewayapi.HTTPRouteReconciler
+	var tcpReconciler *k8s_gatewayapi.TCPRouteReconciler
+
+	same := gatewayapi.NamespacesFromSame
+	listenerReady := []kube_meta.Condition{{
+		Type:   string(gatewayapi.ListenerConditionReady),
+		Status: kube_meta.ConditionTrue,
+	}}
+
+	gatewayGroup := gatewayapi.Group(gatewayapi.GroupName)
+	gatewayKind := common.GatewayKind
+	parentRefs := []gatewayapi.ParentReference{{
+		Group: &gatewayGroup,
+		Kind:  &gatewayKind,
+		Name:  "gateway",
+	}}
+
+	routeName := kube_types.NamespacedName{Namespace: "default", Name: "route"}
+
+	ownedRoutes := func() []mesh_k8s.MeshGatewayRoute {
+		routes := mesh_k8s.MeshGatewayRouteList{}
+		Expect(kubeClient.List(context.Background(), &routes)).To(Succeed())
+		return routes.Items
+	}
+
+	BeforeEach(func() {
+		mesh_k8s.RegisterK8SGatewayTypes()
+
+		scheme, err := k8s.NewScheme()
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeClient = kube_client_fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kube_core.Namespace{
+				ObjectMeta: kube_meta.ObjectMeta{Name: "default"},
+			},
+			&gatewayapi.GatewayClass{
+				ObjectMeta: kube_meta.ObjectMeta{Name: "kuma"},
+				Spec:       gatewayapi.GatewayClassSpec{ControllerName: common.ControllerName},
+			},
+			&gatewayapi.Gateway{
+				ObjectMeta: kube_meta.ObjectMeta{Name: "gateway", Namespace: "default"},
+				Spec: gatewayapi.GatewaySpec{
+					GatewayClassName: "kuma",
+					Listeners: []gatewayapi.Listener{{
+						Name:     "http",
+						Port:     80,
+						Protocol: gatewayapi.HTTPProtocolType,
+						AllowedRoutes: &gatewayapi.AllowedRoutes{
+							Namespaces: &gatewayapi.RouteNamespaces{From: &same},
+						},
+					}, {
+						Name:     "tcp",
+						Port:     9000,
+						Protocol: gatewayapi.TCPProtocolType,
+						AllowedRoutes: &gatewayapi.AllowedRoutes{
+							Namespaces: &gatewayapi.RouteNamespaces{From: &same},
+						},
+					}},
+				},
+				Status: gatewayapi.GatewayStatus{
+					Listeners: []gatewayapi.ListenerStatus{
+						{Name: "http", Conditions: listenerReady},
+						{Name: "tcp", Conditions: listenerReady},
+					},
+				},
+			},
+			&gatewayapi.HTTPRoute{
+				ObjectMeta: kube_meta.ObjectMeta{Name: routeName.Name, Namespace: routeName.Namespace},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+				},
+			},
+			&gatewayapi.TCPRoute{
+				ObjectMeta: kube_meta.ObjectMeta{Name: routeName.Name, Namespace: routeName.Namespace},
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+				},
+			},
+		).Build()
+
+		httpReconciler = &k8s_gatewayapi.HTTPRouteReconciler{
+			Client:       kubeClient,
+			Log:          logr.Discard(),
+			Scheme:       scheme,
+			TypeRegistry: k8s_registry.Global(),
+		}
+
+		tcpReconciler = &k8s_gatewayapi.TCPRouteReconciler{
+			Client:       kubeClient,
+			Log:          logr.Discard(),
+			Scheme:       scheme,
+			TypeRegistry: k8s_registry.Global(),
+		}
+	})
+
+	It("should keep same-named routes of different kinds apart", func() {
+		req := kube_ctrl.Request{NamespacedName: routeName}
+
+		// when
+		_, err := httpReconciler.Reconcile(context.Background(), req)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tcpReconciler.Reconcile(context.Background(), req)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		routes := ownedRoutes()
+		Expect(routes).To(HaveLen(2))
+		Expect([]string{
+			routes[0].GetLabels()[common.OwnerLabel],
+			routes[1].GetLabels()[common.OwnerLabel],
+		}).To(ConsistOf("httproute-default-route", "tcproute-default-route"))
+
+		// when
+		Expect(kubeClient.Delete(context.Background(), &gatewayapi.TCPRoute{
+			ObjectMeta: kube_meta.ObjectMeta{Name: routeName.Name, Namespace: routeName.Namespace},
+		})).To(Succeed())
+		_, err = tcpReconciler.Reconcile(context.Background(), req)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		routes = ownedRoutes()
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].GetLabels()).To(HaveKeyWithValue(common.OwnerLabel, "httproute-default-route"))
+		Expect(routes[0].GetSpec().(*mesh_proto.MeshGatewayRoute).GetConf().GetHttp()).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,282 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/attachment"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/policy"
+	k8s_util "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/util"
+)
+
+const (
+	ObjectTypeUnknownOrInvalid = "ObjectTypeUnknownOrInvalid"
+	ObjectNotFound             = "ObjectNotFound"
+	RefInvalid                 = "RefInvalid"
+	RefNotPermitted            = "RefNotPermitted"
+)
+
+type ParentConditions map[gatewayapi.ParentReference][]kube_meta.Condition
+
+// gapiToKumaRoutes returns some number of GatewayRoutes that should be created
+// for this route along with any statuses to be set on the route.
+// Only unexpected errors are returned as error.
+func gapiToKumaRoutes(
+	ctx context.Context,
+	client kube_client.Client,
+	mesh string,
+	kind gatewayapi.Kind,
+	route kube_client.Object,
+	convert routeConfConverter,
+) (
+	*mesh_proto.MeshGatewayRoute,
+	ParentConditions,
+	error,
+) {
+	routeNs := kube_core.Namespace{}
+	if err := client.Get(ctx, kube_types.NamespacedName{Name: route.GetNamespace()}, &routeNs); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			return nil, nil, nil
+		} else {
+			return nil, nil, err
+		}
+	}
+
+	routeConf, routeConditions, err := convert(ctx, mesh, route)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The conditions we accumulate for each ParentRef
+	conditions := ParentConditions{}
+
+	var selectors []*mesh_proto.Selector
+
+	// Convert GAPI parent refs into selectors
+	for i, ref := range routeParentRefs(route) {
+		refAttachment, err := attachment.EvaluateParentRefAttachment(ctx, client, &routeNs, kind, ref)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unable to check parent ref %d", i)
+		}
+
+		switch refAttachment {
+		case attachment.NotPermitted, attachment.Invalid:
+			var message string
+			switch refAttachment {
+			case attachment.NotPermitted:
+				message = "attachment to parent not permitted by AllowedRoutes"
+			case attachment.Invalid:
+				// TODO missing a specific Reason for this?
+				message = "listener not found, reference to parent is invalid"
+			}
+
+			conditions[ref] = []kube_meta.Condition{
+				{
+					Type:    string(gatewayapi.ConditionRouteAccepted),
+					Status:  kube_meta.ConditionFalse,
+					Reason:  "Refused", // kubernetes-sigs/gateway-api#972
+					Message: message,
+				},
+			}
+		case attachment.Unknown:
+			// We don't care about this ref
+		case attachment.Allowed:
+			selectors = append(
+				selectors,
+				&mesh_proto.Selector{
+					Match: tagsForRef(route, ref),
+				},
+			)
+
+			conditions[ref] = routeConditions
+		}
+	}
+
+	var kumaRoute *mesh_proto.MeshGatewayRoute
+
+	if routeConf != nil && len(selectors) > 0 {
+		// We can only build MeshGatewayRoute if any attachment has matched, and we've got selectors
+		kumaRoute = &mesh_proto.MeshGatewayRoute{
+			Conf:      routeConf,
+			Selectors: selectors,
+		}
+	}
+
+	return kumaRoute, conditions, nil
+}
+
+func tagsForRef(referrer kube_client.Object, ref gatewayapi.ParentReference) map[string]string {
+	refNamespace := referrer.GetNamespace()
+	if ns := ref.Namespace; ns != nil {
+		refNamespace = string(*ns)
+	}
+
+	match := common.ServiceTagForGateway(kube_types.NamespacedName{Namespace: refNamespace, Name: string(ref.Name)})
+
+	if ref.SectionName != nil {
+		match[mesh_proto.ListenerTag] = string(*ref.SectionName)
+	}
+
+	return match
+}
+
+// gapiToKumaRef checks a reference and tries to resolve if it's supported by
+// Kuma. It returns a condition with Reason/Message if it fails or an error for
+// unexpected errors.
+func gapiToKumaRef(
+	ctx context.Context,
+	client kube_client.Client,
+	resourceManager manager.ResourceManager,
+	mesh string,
+	from gatewayapi.ReferencePolicyFrom,
+	ref gatewayapi.BackendObjectReference,
+) (map[string]string, *kube_meta.Condition, error) {
+	policyRef := policy.PolicyReferenceBackend(from, ref)
+
+	gk := policyRef.GroupKindReferredTo()
+	namespacedName := policyRef.NamespacedNameReferredTo()
+
+	if permitted, err := policy.IsReferencePermitted(ctx, client, policyRef); err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't determine if backend reference is permitted")
+	} else if !permitted {
+		return nil,
+			&kube_meta.Condition{
+				Type:    string(gatewayapi.ConditionRouteResolvedRefs),
+				Status:  kube_meta.ConditionFalse,
+				Reason:  RefNotPermitted,
+				Message: fmt.Sprintf("reference to %s %q not permitted by any ReferenceGrant or ReferencePolicy", gk, namespacedName),
+			},
+			nil
+	}
+
+	switch {
+	case gk.Kind == "Service" && gk.Group == "":
+		// References to Services are required by GAPI to include a port
+		// TODO remove when https://github.com/kubernetes-sigs/gateway-api/pull/944
+		// is released
+		if ref.Port == nil {
+			return nil,
+				&kube_meta.Condition{
+					Type:    string(gatewayapi.ConditionRouteResolvedRefs),
+					Status:  kube_meta.ConditionFalse,
+					Reason:  RefInvalid,
+					Message: "backend reference must include port",
+				},
+				nil
+		}
+		port := int32(*ref.Port)
+
+		svc := &kube_core.Service{}
+		if err := client.Get(ctx, namespacedName, svc); err != nil {
+			if kube_apierrs.IsNotFound(err) {
+				return nil,
+					&kube_meta.Condition{
+						Type:    string(gatewayapi.ConditionRouteResolvedRefs),
+						Status:  kube_meta.ConditionFalse,
+						Reason:  ObjectNotFound,
+						Message: fmt.Sprintf("backend reference references a non-existent Service %q", namespacedName.String()),
+					},
+					nil
+			}
+			return nil, nil, err
+		}
+
+		return map[string]string{
+			mesh_proto.ServiceTag: k8s_util.ServiceTagFor(svc, &port),
+		}, nil, nil
+	case gk.Kind == "ExternalService" && gk.Group == mesh_k8s.GroupVersion.Group:
+		resource := core_mesh.NewExternalServiceResource()
+		if err := resourceManager.Get(ctx, resource, store.GetByKey(namespacedName.Name, mesh)); err != nil {
+			if store.IsResourceNotFound(err) {
+				return nil,
+					&kube_meta.Condition{
+						Type:    string(gatewayapi.ConditionRouteResolvedRefs),
+						Status:  kube_meta.ConditionFalse,
+						Reason:  ObjectNotFound,
+						Message: fmt.Sprintf("backend reference references a non-existent ExternalService %q", namespacedName.Name),
+					},
+					nil
+			}
+			return nil, nil, err
+		}
+
+		return map[string]string{
+			mesh_proto.ServiceTag: resource.Spec.GetService(),
+		}, nil, nil
+	}
+
+	return nil,
+		&kube_meta.Condition{
+			Type:    string(gatewayapi.ConditionRouteResolvedRefs),
+			Status:  kube_meta.ConditionFalse,
+			Reason:  ObjectTypeUnknownOrInvalid,
+			Message: "backend reference must be Service or externalservice.kuma.io",
+		},
+		nil
+}
+
+// gapiToKumaBackends converts the backend refs of a route rule into Kuma
+// backends. It returns a condition if any backend can't be resolved.
+func gapiToKumaBackends(
+	ctx context.Context,
+	client kube_client.Client,
+	resourceManager manager.ResourceManager,
+	mesh string,
+	from gatewayapi.ReferencePolicyFrom,
+	backendRefs []gatewayapi.BackendRef,
+) ([]*mesh_proto.MeshGatewayRoute_Backend, *kube_meta.Condition, error) {
+	var backends []*mesh_proto.MeshGatewayRoute_Backend
+
+	for _, backend := range backendRefs {
+		destination, condition, err := gapiToKumaRef(ctx, client, resourceManager, mesh, from, backend.BackendObjectReference)
+		if err != nil || condition != nil {
+			return nil, condition, err
+		}
+
+		// Weight has a default of 1
+		weight := uint32(1)
+		if backend.Weight != nil {
+			weight = uint32(*backend.Weight)
+		}
+
+		backends = append(backends, &mesh_proto.MeshGatewayRoute_Backend{
+			Weight:      weight,
+			Destination: destination,
+		})
+	}
+
+	return backends, nil, nil
+}
+
+// routeConfConditions returns the conditions that are set on the parent
+// refs of a route that was successfully converted.
+func routeConfConditions() []kube_meta.Condition {
+	return []kube_meta.Condition{
+		{
+			Type:   string(gatewayapi.ConditionRouteResolvedRefs),
+			Status: kube_meta.ConditionTrue,
+			Reason: string(gatewayapi.ConditionRouteResolvedRefs),
+		},
+		// TODO: reflect the true state from the actual gateway of this
+		// route
+		{
+			Type:   string(gatewayapi.ConditionRouteAccepted),
+			Status: kube_meta.ConditionTrue,
+			Reason: string(gatewayapi.ConditionRouteAccepted),
+		},
+	}
+}
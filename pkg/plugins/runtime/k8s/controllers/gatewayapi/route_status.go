@@ -13,11 +13,11 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
 )
 
-func (r *HTTPRouteReconciler) updateStatus(ctx context.Context, route *gatewayapi.HTTPRoute, conditions ParentConditions) error {
-	updated := route.DeepCopy()
-	mergeHTTPRouteStatus(updated, conditions)
+func updateRouteStatus(ctx context.Context, client kube_client.Client, route kube_client.Object, conditions ParentConditions) error {
+	updated := route.DeepCopyObject().(kube_client.Object)
+	mergeRouteStatus(routeStatus(updated), route.GetGeneration(), conditions)
 
-	if err := r.Client.Status().Patch(ctx, updated, kube_client.MergeFrom(route)); err != nil {
+	if err := client.Status().Patch(ctx, updated, kube_client.MergeFrom(route)); err != nil {
 		if kube_apierrs.IsNotFound(err) {
 			return nil
 		}
@@ -27,14 +27,14 @@ func (r *HTTPRouteReconciler) updateStatus(ctx context.Context, route *gatewayap
 	return nil
 }
 
-// mergeHTTPRouteStatus updates the route status with the list of conditions for
-// each parent ref by mutating the given HTTPRoute.
-func mergeHTTPRouteStatus(route *gatewayapi.HTTPRoute, parentConditions ParentConditions) {
+// mergeRouteStatus updates the route status with the list of conditions for
+// each parent ref by mutating the given RouteStatus.
+func mergeRouteStatus(status *gatewayapi.RouteStatus, generation int64, parentConditions ParentConditions) {
 	var mergedStatuses []gatewayapi.RouteParentStatus
 	var previousStatuses []gatewayapi.RouteParentStatus
 
 	// partition statuses based on whether we control them
-	for _, status := range route.Status.Parents {
+	for _, status := range status.Parents {
 		if status.ControllerName != common.ControllerName {
 			mergedStatuses = append(mergedStatuses, status)
 		} else {
@@ -59,12 +59,12 @@ func mergeHTTPRouteStatus(route *gatewayapi.HTTPRoute, parentConditions ParentCo
 		}
 
 		for _, condition := range conditions {
-			condition.ObservedGeneration = route.GetGeneration()
+			condition.ObservedGeneration = generation
 			kube_apimeta.SetStatusCondition(&previousStatus.Conditions, condition)
 		}
 
 		mergedStatuses = append(mergedStatuses, previousStatus)
 	}
 
-	route.Status.Parents = mergedStatuses
+	status.Parents = mergedStatuses
 }
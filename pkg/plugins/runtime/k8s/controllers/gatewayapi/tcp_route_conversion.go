@@ -0,0 +1,46 @@
+package gatewayapi
+
+import (
+	"context"
+
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/policy"
+)
+
+// gapiToKumaRouteConf converts the TCPRoute into a route spec. See
+// HTTPRouteReconciler.gapiToKumaRouteConf.
+func (r *TCPRouteReconciler) gapiToKumaRouteConf(
+	ctx context.Context, mesh string, route *gatewayapi.TCPRoute,
+) (*mesh_proto.MeshGatewayRoute_Conf, []kube_meta.Condition, error) {
+	var rules []*mesh_proto.MeshGatewayRoute_TcpRoute_Rule
+
+	for _, rule := range route.Spec.Rules {
+		backends, condition, err := gapiToKumaBackends(
+			ctx, r.Client, r.ResourceManager, mesh, policy.FromRouteIn(common.TCPRouteKind, route.Namespace), rule.BackendRefs,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if condition != nil {
+			return nil, []kube_meta.Condition{*condition}, nil
+		}
+
+		rules = append(rules, &mesh_proto.MeshGatewayRoute_TcpRoute_Rule{
+			Backends: backends,
+		})
+	}
+
+	routeConf := mesh_proto.MeshGatewayRoute_Conf{
+		Route: &mesh_proto.MeshGatewayRoute_Conf_Tcp{
+			Tcp: &mesh_proto.MeshGatewayRoute_TcpRoute{
+				Rules: rules,
+			},
+		},
+	}
+
+	return &routeConf, routeConfConditions(), nil
+}
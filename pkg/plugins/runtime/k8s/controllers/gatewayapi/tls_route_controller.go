@@ -0,0 +1,54 @@
+package gatewayapi
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	kube_source "sigs.k8s.io/controller-runtime/pkg/source"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+)
+
+// TLSRouteReconciler reconciles a GatewayAPI TLSRoute into Kuma-native objects
+type TLSRouteReconciler struct {
+	kube_client.Client
+	Log logr.Logger
+
+	Scheme          *kube_runtime.Scheme
+	TypeRegistry    k8s_registry.TypeRegistry
+	SystemNamespace string
+	ResourceManager manager.ResourceManager
+}
+
+// Reconcile handles transforming a gateway-api TLSRoute into a Kuma
+// GatewayRoute and managing the status of the gateway-api objects.
+func (r *TLSRouteReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (kube_ctrl.Result, error) {
+	r.Log.V(1).Info("reconcile", "req", req)
+
+	convert := func(ctx context.Context, mesh string, route kube_client.Object) (*mesh_proto.MeshGatewayRoute_Conf, []kube_meta.Condition, error) {
+		return r.gapiToKumaRouteConf(ctx, mesh, route.(*gatewayapi.TLSRoute))
+	}
+
+	return reconcileRoute(ctx, r.Client, r.TypeRegistry, req, common.TLSRouteKind, &gatewayapi.TLSRoute{}, convert)
+}
+
+func (r *TLSRouteReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
+	return kube_ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayapi.TLSRoute{}).
+		Watches(
+			&kube_source.Kind{Type: &gatewayapi.Gateway{}},
+			kube_handler.EnqueueRequestsFromMapFunc(routesForGateway(r.Log, r.Client, func() kube_client.ObjectList {
+				return &gatewayapi.TLSRouteList{}
+			})),
+		).
+		Complete(r)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/containers"
 	controllers "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers"
 	gatewayapi_controllers "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi"
+	gatewayapi_common "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
 	k8s_webhooks "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks"
 )
 
@@ -133,6 +134,34 @@ func addGatewayAPIReconcillers(mgr kube_ctrl.Manager, rt core_runtime.Runtime, p
 		return errors.Wrap(err, "could not setup Gateway API HTTPRoute reconciler")
 	}
 
+	if gatewayapi_common.KindPresent(mgr.GetRESTMapper(), gatewayapi_common.TCPRouteKind) {
+		gatewayAPITCPRouteReconciler := &gatewayapi_controllers.TCPRouteReconciler{
+			Client:          mgr.GetClient(),
+			Log:             core.Log.WithName("controllers").WithName("gatewayapi").WithName("TCPRoute"),
+			Scheme:          mgr.GetScheme(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+			ResourceManager: rt.ResourceManager(),
+		}
+		if err := gatewayAPITCPRouteReconciler.SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "could not setup Gateway API TCPRoute reconciler")
+		}
+	}
+
+	if gatewayapi_common.KindPresent(mgr.GetRESTMapper(), gatewayapi_common.TLSRouteKind) {
+		gatewayAPITLSRouteReconciler := &gatewayapi_controllers.TLSRouteReconciler{
+			Client:          mgr.GetClient(),
+			Log:             core.Log.WithName("controllers").WithName("gatewayapi").WithName("TLSRoute"),
+			Scheme:          mgr.GetScheme(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+			ResourceManager: rt.ResourceManager(),
+		}
+		if err := gatewayAPITLSRouteReconciler.SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "could not setup Gateway API TLSRoute reconciler")
+		}
+	}
+
 	secretController := &gatewayapi_controllers.SecretController{
 		Log:             core.Log.WithName("controllers").WithName("secret"),
 		Client:          mgr.GetClient(),
@@ -108,6 +108,12 @@ var (
 	ServiceInsight = &mesh_proto.ServiceInsight{
 		Services: map[string]*mesh_proto.ServiceInsight_Service{},
 	}
+	TrafficRouteInsight = &mesh_proto.TrafficRouteInsight{
+		Canary: &mesh_proto.TrafficRouteInsight_Canary{
+			Step:         1,
+			CanaryWeight: 50,
+		},
+	}
 	ZoneIngress = &mesh_proto.ZoneIngress{
 		Networking: &mesh_proto.ZoneIngress_Networking{
 			Address:           "127.0.0.1",
@@ -163,6 +169,80 @@ var (
 			},
 		},
 	}
+	AdaptiveConcurrency = &mesh_proto.AdaptiveConcurrency{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag: "*",
+			},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag:  "*",
+				mesh_proto.ProtocolTag: "http",
+			},
+		}},
+		Conf: &mesh_proto.AdaptiveConcurrency_Conf{
+			GradientController: &mesh_proto.AdaptiveConcurrency_Conf_GradientController{
+				SampleAggregatePercentile: util_proto.Double(90),
+			},
+		},
+	}
+	Compression = &mesh_proto.Compression{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag: "*",
+			},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag:  "*",
+				mesh_proto.ProtocolTag: "http",
+			},
+		}},
+		Conf: &mesh_proto.Compression_Conf{
+			MinContentLength: util_proto.UInt32(100),
+		},
+	}
+	CorsPolicy = &mesh_proto.CorsPolicy{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag: "*",
+			},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag:  "*",
+				mesh_proto.ProtocolTag: "http",
+			},
+		}},
+		Conf: &mesh_proto.CorsPolicy_Conf{
+			AllowOrigins: []*mesh_proto.CorsPolicy_Conf_Origin{{
+				MatcherType: &mesh_proto.CorsPolicy_Conf_Origin_Exact{Exact: "https://example.com"},
+			}},
+		},
+	}
+	HeaderModification = &mesh_proto.HeaderModification{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag: "*",
+			},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				mesh_proto.ServiceTag: "*",
+			},
+		}},
+		Conf: &mesh_proto.HeaderModification_Conf{
+			Outbound: &mesh_proto.HeaderModification_Conf_Headers{
+				RequestHeaders: &mesh_proto.TrafficRoute_Http_Modify_Headers{
+					Add: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Add{{
+						Name:  "x-kuma-source",
+						Value: "%KUMA_SOURCE_SERVICE%",
+					}},
+				},
+			},
+		},
+	}
 	HealthCheck = &mesh_proto.HealthCheck{
 		Sources: []*mesh_proto.Selector{{
 			Match: map[string]string{
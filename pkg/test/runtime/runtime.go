@@ -6,6 +6,8 @@ import (
 	"net"
 	"time"
 
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
@@ -156,3 +158,38 @@ func (d *DummyEnvoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource)
 func (d *DummyEnvoyAdminClient) ConfigDump(proxy admin.ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
 	return []byte(fmt.Sprintf(`{"envoyAdminAddress": "%s"}`, proxy.AdminAddress(defaultAdminPort))), nil
 }
+
+func (d *DummyEnvoyAdminClient) Clusters(proxy admin.ResourceWithAddress, defaultAdminPort uint32) (*envoy_admin_v3.Clusters, error) {
+	hostStatus := func(address string, ejected bool) *envoy_admin_v3.HostStatus {
+		return &envoy_admin_v3.HostStatus{
+			Address: &envoy_core_v3.Address{
+				Address: &envoy_core_v3.Address_SocketAddress{
+					SocketAddress: &envoy_core_v3.SocketAddress{
+						Address:       address,
+						PortSpecifier: &envoy_core_v3.SocketAddress_PortValue{PortValue: 80},
+					},
+				},
+			},
+			HealthStatus: &envoy_admin_v3.HostHealthStatus{
+				FailedOutlierCheck: ejected,
+			},
+		}
+	}
+	return &envoy_admin_v3.Clusters{
+		ClusterStatuses: []*envoy_admin_v3.ClusterStatus{
+			{
+				Name: "backend",
+				HostStatuses: []*envoy_admin_v3.HostStatus{
+					hostStatus("192.168.0.1", false),
+					hostStatus("192.168.0.2", true),
+				},
+			},
+			{
+				Name: "httpbin",
+				HostStatuses: []*envoy_admin_v3.HostStatus{
+					hostStatus("10.0.0.1", true),
+				},
+			},
+		},
+	}, nil
+}
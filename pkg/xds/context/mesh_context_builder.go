@@ -168,12 +168,39 @@ func (m *meshContextBuilder) fetchResources(ctx context.Context, mesh *core_mesh
 			if err := m.rm.List(ctx, rlist, core_store.ListByMesh(mesh.Meta.GetName())); err != nil {
 				return nil, err
 			}
+			rlist, err = withoutExpired(rlist)
+			if err != nil {
+				return nil, err
+			}
 			resources[typ] = rlist
 		}
 	}
 	return resources, nil
 }
 
+// withoutExpired drops policies that are past their expiry time, so they are ignored
+// in policy matching before the garbage collector gets to delete them.
+func withoutExpired(rlist core_model.ResourceList) (core_model.ResourceList, error) {
+	items := rlist.GetItems()
+	if len(items) == 0 || !core_model.IsExpirable(items[0].Descriptor()) {
+		return rlist, nil
+	}
+	filtered, err := registry.Global().NewList(rlist.GetItemType())
+	if err != nil {
+		return nil, err
+	}
+	now := core.Now()
+	for _, item := range items {
+		if core_model.IsExpired(item, now) {
+			continue
+		}
+		if err := filtered.AddItem(item); err != nil {
+			return nil, err
+		}
+	}
+	return filtered, nil
+}
+
 func (m *meshContextBuilder) resolveAddresses(resources Resources) {
 	zoneIngresses := xds_topology.ResolveZoneIngressAddresses(logger, m.ipFunc, resources.ZoneIngresses().Items)
 	resources.ZoneIngresses().Items = zoneIngresses
@@ -61,6 +61,22 @@ func (r Resources) RateLimits() *core_mesh.RateLimitResourceList {
 	return r.ListOrEmpty(core_mesh.RateLimitType).(*core_mesh.RateLimitResourceList)
 }
 
+func (r Resources) AdaptiveConcurrencies() *core_mesh.AdaptiveConcurrencyResourceList {
+	return r.ListOrEmpty(core_mesh.AdaptiveConcurrencyType).(*core_mesh.AdaptiveConcurrencyResourceList)
+}
+
+func (r Resources) Compressions() *core_mesh.CompressionResourceList {
+	return r.ListOrEmpty(core_mesh.CompressionType).(*core_mesh.CompressionResourceList)
+}
+
+func (r Resources) CorsPolicies() *core_mesh.CorsPolicyResourceList {
+	return r.ListOrEmpty(core_mesh.CorsPolicyType).(*core_mesh.CorsPolicyResourceList)
+}
+
+func (r Resources) HeaderModifications() *core_mesh.HeaderModificationResourceList {
+	return r.ListOrEmpty(core_mesh.HeaderModificationType).(*core_mesh.HeaderModificationResourceList)
+}
+
 func (r Resources) CircuitBreakers() *core_mesh.CircuitBreakerResourceList {
 	return r.ListOrEmpty(core_mesh.CircuitBreakerType).(*core_mesh.CircuitBreakerResourceList)
 }
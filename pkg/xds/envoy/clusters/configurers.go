@@ -20,6 +20,12 @@ func CircuitBreaker(circuitBreaker *core_mesh.CircuitBreakerResource) ClusterBui
 	})
 }
 
+func RetryBudget(retry *core_mesh.RetryResource) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.RetryBudgetConfigurer{Retry: retry})
+	})
+}
+
 func ClientSideMTLS(mesh *core_mesh.MeshResource, upstreamService string, upstreamTLSReady bool, tags []envoy.Tags) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.ClientSideMTLSConfigurer{
@@ -141,6 +147,15 @@ func PassThroughCluster(name string) ClusterBuilderOpt {
 	})
 }
 
+func BlackHoleCluster(name string) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.BlackHoleClusterConfigurer{
+			Name: name,
+		})
+		config.AddV3(&v3.TimeoutConfigurer{})
+	})
+}
+
 func UpstreamBindConfig(address string, port uint32) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.UpstreamBindConfigConfigurer{
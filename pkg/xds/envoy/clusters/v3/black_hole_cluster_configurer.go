@@ -0,0 +1,23 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+)
+
+// BlackHoleClusterConfigurer configures a cluster without endpoints, so
+// Envoy closes the connections forwarded to it.
+type BlackHoleClusterConfigurer struct {
+	Name string
+}
+
+var _ ClusterConfigurer = &BlackHoleClusterConfigurer{}
+
+func (b *BlackHoleClusterConfigurer) Configure(c *envoy_cluster.Cluster) error {
+	c.Name = b.Name
+	c.ClusterDiscoveryType = &envoy_cluster.Cluster_Type{Type: envoy_cluster.Cluster_STATIC}
+	c.LoadAssignment = &envoy_endpoint.ClusterLoadAssignment{
+		ClusterName: b.Name,
+	}
+	return nil
+}
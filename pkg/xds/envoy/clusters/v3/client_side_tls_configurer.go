@@ -36,6 +36,7 @@ func (c *ClientSideTLSConfigurer) Configure(cluster *envoy_cluster.Cluster) erro
 				ep.ExternalService.AllowRenegotiation,
 				ep.Target,
 				sni,
+				ep.ExternalService.SystemCaPath,
 			)
 			if err != nil {
 				return err
@@ -104,6 +104,17 @@ func httpHealthCheck(
 	}
 }
 
+func grpcHealthCheck(
+	grpcConf *mesh_proto.HealthCheck_Conf_Grpc,
+) *envoy_core.HealthCheck_GrpcHealthCheck_ {
+	return &envoy_core.HealthCheck_GrpcHealthCheck_{
+		GrpcHealthCheck: &envoy_core.HealthCheck_GrpcHealthCheck{
+			ServiceName: grpcConf.ServiceName,
+			Authority:   grpcConf.Authority,
+		},
+	}
+}
+
 func healthPanicThreshold(cluster *envoy_cluster.Cluster, value *wrapperspb.FloatValue) {
 	if value == nil {
 		return
@@ -163,6 +174,8 @@ func addHealthChecker(healthCheck *envoy_core.HealthCheck, healthChecker interfa
 		healthCheck.HealthChecker = httpHc
 	} else if tcpHc, ok := healthChecker.(*envoy_core.HealthCheck_TcpHealthCheck_); ok {
 		healthCheck.HealthChecker = tcpHc
+	} else if grpcHc, ok := healthChecker.(*envoy_core.HealthCheck_GrpcHealthCheck_); ok {
+		healthCheck.HealthChecker = grpcHc
 	}
 
 	return healthCheck
@@ -180,8 +193,17 @@ func (e *HealthCheckConfigurer) Configure(cluster *envoy_cluster.Cluster) error
 
 	tcp := activeChecks.GetTcp()
 	http := activeChecks.GetHttp()
+	grpc := activeChecks.GetGrpc()
+
+	if grpc != nil && e.Protocol != core_mesh.ProtocolGRPC && e.Protocol != core_mesh.ProtocolHTTP2 {
+		// Envoy sends the gRPC health checks over HTTP/2, which only the clusters of the grpc and http2 services use
+		core.Log.WithName("health-check-configurer").Error(
+			errors.Errorf("gRPC health check requires grpc or http2 protocol, got %q", e.Protocol),
+			"unable to configure gRPC health check, TCP health check is used instead")
+		grpc = nil
+	}
 
-	if tcp == nil && http == nil {
+	if tcp == nil && http == nil && grpc == nil {
 		cluster.HealthChecks = append(cluster.HealthChecks, buildHealthCheck(activeChecks))
 
 		return nil
@@ -203,5 +225,13 @@ func (e *HealthCheckConfigurer) Configure(cluster *envoy_cluster.Cluster) error
 		cluster.HealthChecks = append(cluster.HealthChecks, healthCheck)
 	}
 
+	if grpc != nil {
+		defaultHealthCheck := buildHealthCheck(activeChecks)
+		healthChecker := grpcHealthCheck(grpc)
+		healthCheck := addHealthChecker(defaultHealthCheck, healthChecker)
+
+		cluster.HealthChecks = append(cluster.HealthChecks, healthCheck)
+	}
+
 	return nil
 }
@@ -18,16 +18,23 @@ var _ = Describe("HealthCheckConfigurer", func() {
 
 	type testCase struct {
 		clusterName string
+		protocol    core_mesh.Protocol
 		healthCheck *core_mesh.HealthCheckResource
 		expected    string
 	}
 
 	DescribeTable("should generate proper Envoy config",
 		func(given testCase) {
+			// given
+			protocol := given.protocol
+			if protocol == "" {
+				protocol = core_mesh.ProtocolHTTP
+			}
+
 			// when
 			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
 				Configure(clusters.EdsCluster(given.clusterName)).
-				Configure(clusters.HealthCheck(core_mesh.ProtocolHTTP, given.healthCheck)).
+				Configure(clusters.HealthCheck(protocol, given.healthCheck)).
 				Configure(clusters.Timeout(core_mesh.ProtocolTCP, DefaultTimeout())).
 				Build()
 
@@ -230,6 +237,84 @@ var _ = Describe("HealthCheckConfigurer", func() {
               timeout: 4s
               unhealthyThreshold: 3
             name: testCluster
+            type: EDS`,
+		}),
+		Entry("HealthCheck with provided gRPC configuration", testCase{
+			clusterName: "testCluster",
+			protocol:    core_mesh.ProtocolGRPC,
+			healthCheck: &core_mesh.HealthCheckResource{
+				Spec: &mesh_proto.HealthCheck{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "frontend"}},
+					},
+					Conf: &mesh_proto.HealthCheck_Conf{
+						Interval:           util_proto.Duration(5 * time.Second),
+						Timeout:            util_proto.Duration(4 * time.Second),
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   2,
+						Grpc: &mesh_proto.HealthCheck_Conf_Grpc{
+							ServiceName: "backend.Health",
+							Authority:   "backend.mesh",
+						},
+					},
+				},
+			},
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            healthChecks:
+            - grpcHealthCheck:
+                authority: backend.mesh
+                serviceName: backend.Health
+              healthyThreshold: 2
+              interval: 5s
+              timeout: 4s
+              unhealthyThreshold: 3
+            name: testCluster
+            type: EDS`,
+		}),
+		Entry("HealthCheck with provided gRPC configuration for a service without HTTP/2", testCase{
+			clusterName: "testCluster",
+			protocol:    core_mesh.ProtocolTCP,
+			healthCheck: &core_mesh.HealthCheckResource{
+				Spec: &mesh_proto.HealthCheck{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "frontend"}},
+					},
+					Conf: &mesh_proto.HealthCheck_Conf{
+						Interval:           util_proto.Duration(5 * time.Second),
+						Timeout:            util_proto.Duration(4 * time.Second),
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   2,
+						Grpc: &mesh_proto.HealthCheck_Conf_Grpc{
+							ServiceName: "backend.Health",
+							Authority:   "backend.mesh",
+						},
+					},
+				},
+			},
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            healthChecks:
+            - healthyThreshold: 2
+              interval: 5s
+              timeout: 4s
+              tcpHealthCheck: {}
+              unhealthyThreshold: 3
+            name: testCluster
             type: EDS`,
 		}),
 		Entry("HealthCheck with provided both, TCP and HTTP configurations", testCase{
@@ -0,0 +1,51 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+type RetryBudgetConfigurer struct {
+	Retry *core_mesh.RetryResource
+}
+
+var _ ClusterConfigurer = &RetryBudgetConfigurer{}
+
+func (c *RetryBudgetConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.Retry == nil {
+		return nil
+	}
+	budget := c.Retry.Spec.GetConf().GetBudget()
+	if budget == nil {
+		return nil
+	}
+
+	retryBudget := &envoy_cluster.CircuitBreakers_Thresholds_RetryBudget{
+		MinRetryConcurrency: budget.GetMinRetryConcurrency(),
+	}
+	if budget.GetBudgetPercent() != nil {
+		retryBudget.BudgetPercent = &envoy_type.Percent{
+			Value: budget.GetBudgetPercent().GetValue(),
+		}
+	}
+
+	// The retry budget is a part of the circuit breaker thresholds, so it has
+	// to be merged with the thresholds set by the CircuitBreaker policy.
+	if cluster.CircuitBreakers == nil {
+		cluster.CircuitBreakers = &envoy_cluster.CircuitBreakers{}
+	}
+	for _, thresholds := range cluster.CircuitBreakers.Thresholds {
+		if thresholds.Priority == envoy_config_core_v3.RoutingPriority_DEFAULT {
+			thresholds.RetryBudget = retryBudget
+			return nil
+		}
+	}
+	cluster.CircuitBreakers.Thresholds = append(cluster.CircuitBreakers.Thresholds, &envoy_cluster.CircuitBreakers_Thresholds{
+		Priority:    envoy_config_core_v3.RoutingPriority_DEFAULT,
+		RetryBudget: retryBudget,
+	})
+	return nil
+}
@@ -0,0 +1,100 @@
+package clusters_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("RetryBudgetConfigurer", func() {
+
+	type testCase struct {
+		circuitBreaker *core_mesh.CircuitBreakerResource
+		retry          *core_mesh.RetryResource
+		expected       string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.CircuitBreaker(given.circuitBreaker)).
+				Configure(clusters.RetryBudget(given.retry)).
+				Configure(clusters.Timeout(core_mesh.ProtocolTCP, DefaultTimeout())).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("retry budget without circuit breaker", testCase{
+			retry: &core_mesh.RetryResource{
+				Spec: &mesh_proto.Retry{
+					Conf: &mesh_proto.Retry_Conf{
+						Budget: &mesh_proto.Retry_Conf_Budget{
+							BudgetPercent:       util_proto.Double(25),
+							MinRetryConcurrency: util_proto.UInt32(5),
+						},
+					},
+				},
+			},
+			expected: `
+        circuitBreakers:
+          thresholds:
+          - retryBudget:
+              budgetPercent:
+                value: 25
+              minRetryConcurrency: 5
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+		Entry("retry budget merged with circuit breaker thresholds", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Thresholds: &mesh_proto.CircuitBreaker_Conf_Thresholds{
+							MaxConnections: util_proto.UInt32(2),
+						},
+					},
+				},
+			},
+			retry: &core_mesh.RetryResource{
+				Spec: &mesh_proto.Retry{
+					Conf: &mesh_proto.Retry_Conf{
+						Budget: &mesh_proto.Retry_Conf_Budget{
+							BudgetPercent: util_proto.Double(20),
+						},
+					},
+				},
+			},
+			expected: `
+        circuitBreakers:
+          thresholds:
+          - maxConnections: 2
+            retryBudget:
+              budgetPercent:
+                value: 20
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+	)
+})
@@ -1,6 +1,7 @@
 package endpoints
 
 import (
+	"math"
 	"sort"
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -45,8 +46,15 @@ func CreateClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoi
 				}},
 		}
 		if ep.Weight > 0 {
+			weight := uint64(ep.Weight)
+			if ep.HasLocality() && ep.Locality.Weight > 0 {
+				weight *= uint64(ep.Locality.Weight)
+			}
+			if weight > math.MaxUint32 {
+				weight = math.MaxUint32
+			}
 			lbEndpoint.LoadBalancingWeight = &proto_wrappers.UInt32Value{
-				Value: ep.Weight,
+				Value: uint32(weight),
 			}
 		}
 		localityLbEndpoints.append(ep, lbEndpoint)
@@ -72,7 +80,9 @@ func (l LocalityLbEndpointsMap) append(ep core_xds.Endpoint, endpoint *envoy_end
 		priority := uint32(0)
 		if ep.HasLocality() {
 			locality = &envoy_core.Locality{
-				Zone: ep.Locality.Zone,
+				Region:  ep.Locality.Region,
+				Zone:    ep.Locality.Zone,
+				SubZone: ep.Locality.SubZone,
 			}
 			priority = ep.Locality.Priority
 		}
@@ -101,9 +111,35 @@ func (l LocalityLbEndpointsMap) asSlice() []*envoy_endpoint.LocalityLbEndpoints
 			(right.Locality.Region + right.Locality.Zone + right.Locality.SubZone)
 	})
 
+	compactPriorities(slice)
+
 	return slice
 }
 
+// compactPriorities renumbers the priorities of the localities, so they start
+// from 0 and have no gaps, which Envoy requires. Priority levels lose their
+// endpoints when the failover rules list zones without endpoints of the service.
+func compactPriorities(localities []*envoy_endpoint.LocalityLbEndpoints) {
+	var priorities []uint32
+	for _, locality := range localities {
+		priorities = append(priorities, locality.Priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] < priorities[j]
+	})
+
+	compacted := map[uint32]uint32{}
+	for _, priority := range priorities {
+		if _, ok := compacted[priority]; !ok {
+			compacted[priority] = uint32(len(compacted))
+		}
+	}
+
+	for _, locality := range localities {
+		locality.Priority = compacted[locality.Priority]
+	}
+}
+
 func sortLbEndpoints(lbEndpoints []*envoy_endpoint.LbEndpoint) {
 	sort.Slice(lbEndpoints, func(i, j int) bool {
 		left, right := lbEndpoints[i], lbEndpoints[j]
@@ -186,6 +186,136 @@ var _ = Describe("Endpoints", func() {
                           region: eu
                           kuma.io/zone: west
                     loadBalancingWeight: 2
+`,
+			}),
+			Entry("with weighted localities", testCase{
+				cluster: "127.0.0.1:8080",
+				endpoints: []core_xds.Endpoint{
+					{
+						Target:   "192.168.0.1",
+						Port:     8081,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   2,
+						Locality: &core_xds.Locality{Zone: "east", Priority: 1, Weight: 3},
+					},
+					{
+						Target:   "192.168.0.2",
+						Port:     8082,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   2,
+						Locality: &core_xds.Locality{Zone: "west", Priority: 2},
+					},
+				},
+				expected: `
+                clusterName: 127.0.0.1:8080
+                endpoints:
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.2
+                          portValue: 8082
+                    loadBalancingWeight: 2
+                  locality:
+                    zone: west
+                  priority: 1
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+                    loadBalancingWeight: 6
+                  locality:
+                    zone: east
+`,
+			}),
+			Entry("with an empty middle priority and sub-zones", testCase{
+				cluster: "127.0.0.1:8080",
+				endpoints: []core_xds.Endpoint{
+					{
+						Target:   "192.168.0.1",
+						Port:     8081,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   1,
+						Locality: &core_xds.Locality{Zone: "east", Priority: 0},
+					},
+					{
+						Target:   "192.168.0.2",
+						Port:     8082,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   1,
+						Locality: &core_xds.Locality{Region: "eu", Zone: "west", SubZone: "west-1", Priority: 1},
+					},
+					{
+						Target:   "192.168.0.3",
+						Port:     8083,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   1,
+						Locality: &core_xds.Locality{Region: "eu", Zone: "west", SubZone: "west-2", Priority: 3},
+					},
+				},
+				expected: `
+                clusterName: 127.0.0.1:8080
+                endpoints:
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.3
+                          portValue: 8083
+                    loadBalancingWeight: 1
+                  locality:
+                    region: eu
+                    zone: west
+                    subZone: west-2
+                  priority: 2
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.2
+                          portValue: 8082
+                    loadBalancingWeight: 1
+                  locality:
+                    region: eu
+                    zone: west
+                    subZone: west-1
+                  priority: 1
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+                    loadBalancingWeight: 1
+                  locality:
+                    zone: east
+`,
+			}),
+			Entry("with large locality weights", testCase{
+				cluster: "127.0.0.1:8080",
+				endpoints: []core_xds.Endpoint{
+					{
+						Target:   "192.168.0.1",
+						Port:     8081,
+						Tags:     map[string]string{"kuma.io/service": "backend"},
+						Weight:   1 << 20,
+						Locality: &core_xds.Locality{Zone: "east", Priority: 1, Weight: 1 << 20},
+					},
+				},
+				expected: `
+                clusterName: 127.0.0.1:8080
+                endpoints:
+                - lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+                    loadBalancingWeight: 4294967295
+                  locality:
+                    zone: east
 `,
 			}),
 		)
@@ -92,12 +92,89 @@ func FaultInjection(faultInjections ...*core_mesh.FaultInjectionResource) Filter
 	})
 }
 
+func TcpFaultInjection(faultInjection *core_mesh.FaultInjectionResource) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.TcpFaultInjectionConfigurer{
+		FaultInjection: faultInjection,
+	})
+}
+
 func RateLimit(rateLimits []*core_mesh.RateLimitResource) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.RateLimitConfigurer{
 		RateLimits: rateLimits,
 	})
 }
 
+func AdaptiveConcurrency(adaptiveConcurrency *core_mesh.AdaptiveConcurrencyResource) FilterChainBuilderOpt {
+	if adaptiveConcurrency == nil {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.AdaptiveConcurrencyConfigurer{
+		Conf: adaptiveConcurrency.Spec.GetConf(),
+	})
+}
+
+func Compression(compression *core_mesh.CompressionResource) FilterChainBuilderOpt {
+	if compression == nil {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.CompressionConfigurer{
+		Conf: compression.Spec.GetConf(),
+	})
+}
+
+// Cors adds the CORS filter and applies the given CORS policy to the
+// virtual hosts of the static route configuration. It has to be
+// configured after the routes.
+func Cors(cors *core_mesh.CorsPolicyResource) FilterChainBuilderOpt {
+	if cors == nil {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.CorsConfigurer{
+		Conf: cors.Spec.GetConf(),
+	})
+}
+
+// CorsFilter adds the CORS filter that enforces the CORS policies set on
+// the virtual hosts of a dynamic route configuration.
+func CorsFilter() FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.CorsConfigurer{})
+}
+
+// JwtAuthn adds the JWT authentication filter. Jwks maps the name of
+// each provider to its JSON Web Key Set. Requests with a path in
+// bypassPathPrefixes are not authenticated.
+func JwtAuthn(conf *mesh_proto.MeshGateway_Authentication_JWT, jwks map[string]string, bypassPathPrefixes ...string) FilterChainBuilderOpt {
+	if conf == nil {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.JwtAuthnConfigurer{
+		Conf:               conf,
+		Jwks:               jwks,
+		BypassPathPrefixes: bypassPathPrefixes,
+	})
+}
+
+// OAuth2 adds the OAuth2 filter that runs the OIDC login flow against
+// the token endpoint reachable through the given cluster. Requests with
+// a path in bypassPathPrefixes are not authenticated.
+func OAuth2(conf *mesh_proto.MeshGateway_Authentication_OIDC, tokenCluster, tokenSecret, hmacSecret string, bypassPathPrefixes ...string) FilterChainBuilderOpt {
+	if conf == nil {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.OAuth2Configurer{
+		Conf:               conf,
+		TokenCluster:       tokenCluster,
+		TokenSecret:        tokenSecret,
+		HmacSecret:         hmacSecret,
+		BypassPathPrefixes: bypassPathPrefixes,
+	})
+}
+
 func NetworkAccessLog(
 	mesh string,
 	trafficDirection envoy_common.TrafficDirection,
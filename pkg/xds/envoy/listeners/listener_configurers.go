@@ -69,6 +69,15 @@ func DNS(vips map[string][]string, emptyDnsPort uint32, envoyVersion *mesh_proto
 	})
 }
 
+// UDPProxy forwards the datagrams received by a UDP listener to the
+// given cluster.
+func UDPProxy(statsName string, cluster string) ListenerBuilderOpt {
+	return AddListenerConfigurer(&v3.UDPProxyConfigurer{
+		StatsName: statsName,
+		Cluster:   cluster,
+	})
+}
+
 func ConnectionBufferLimit(bytes uint32) ListenerBuilderOpt {
 	return AddListenerConfigurer(
 		v3.ListenerMustConfigureFunc(func(l *envoy_listener.Listener) {
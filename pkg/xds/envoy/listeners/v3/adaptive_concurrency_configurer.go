@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"time"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_adaptive_concurrency "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	defaultConcurrencyUpdateInterval = 100 * time.Millisecond
+	defaultMinRttInterval            = 60 * time.Second
+)
+
+type AdaptiveConcurrencyConfigurer struct {
+	Conf *mesh_proto.AdaptiveConcurrency_Conf
+}
+
+var _ FilterChainConfigurer = &AdaptiveConcurrencyConfigurer{}
+
+func (c *AdaptiveConcurrencyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	gradientController := c.Conf.GetGradientController()
+	concurrencyLimit := gradientController.GetConcurrencyLimit()
+	minRtt := gradientController.GetMinRtt()
+
+	config := &envoy_adaptive_concurrency.AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &envoy_adaptive_concurrency.AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: &envoy_adaptive_concurrency.GradientControllerConfig{
+				SampleAggregatePercentile: percent(gradientController.GetSampleAggregatePercentile()),
+				ConcurrencyLimitParams: &envoy_adaptive_concurrency.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+					MaxConcurrencyLimit:       concurrencyLimit.GetMax(),
+					ConcurrencyUpdateInterval: durationOrDefault(concurrencyLimit.GetUpdateInterval(), defaultConcurrencyUpdateInterval),
+				},
+				MinRttCalcParams: &envoy_adaptive_concurrency.GradientControllerConfig_MinimumRTTCalculationParams{
+					Interval:       durationOrDefault(minRtt.GetInterval(), defaultMinRttInterval),
+					RequestCount:   minRtt.GetRequestCount(),
+					Jitter:         percent(minRtt.GetJitter()),
+					MinConcurrency: minRtt.GetMinConcurrency(),
+					Buffer:         percent(minRtt.GetBuffer()),
+				},
+			},
+		},
+	}
+
+	pbst, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters,
+			&envoy_hcm.HttpFilter{
+				Name: "envoy.filters.http.adaptive_concurrency",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: pbst,
+				},
+			})
+		return nil
+	})
+}
+
+func percent(value *wrapperspb.DoubleValue) *envoy_type.Percent {
+	if value == nil {
+		return nil
+	}
+	return &envoy_type.Percent{Value: value.GetValue()}
+}
+
+func durationOrDefault(duration *durationpb.Duration, def time.Duration) *durationpb.Duration {
+	if duration == nil {
+		return util_proto.Duration(def)
+	}
+	return duration
+}
@@ -0,0 +1,118 @@
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("AdaptiveConcurrencyConfigurer", func() {
+	type testCase struct {
+		input    *core_mesh.AdaptiveConcurrencyResource
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(AdaptiveConcurrency(given.input)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("no adaptive concurrency", testCase{
+			input: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("defaults", testCase{
+			input: &core_mesh.AdaptiveConcurrencyResource{
+				Spec: &mesh_proto.AdaptiveConcurrency{
+					Conf: &mesh_proto.AdaptiveConcurrency_Conf{},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.adaptive_concurrency
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.adaptive_concurrency.v3.AdaptiveConcurrency
+                    gradientControllerConfig:
+                      concurrencyLimitParams:
+                        concurrencyUpdateInterval: 0.100s
+                      minRttCalcParams:
+                        interval: 60s
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("full configuration", testCase{
+			input: &core_mesh.AdaptiveConcurrencyResource{
+				Spec: &mesh_proto.AdaptiveConcurrency{
+					Conf: &mesh_proto.AdaptiveConcurrency_Conf{
+						GradientController: &mesh_proto.AdaptiveConcurrency_Conf_GradientController{
+							SampleAggregatePercentile: util_proto.Double(90),
+							ConcurrencyLimit: &mesh_proto.AdaptiveConcurrency_Conf_GradientController_ConcurrencyLimit{
+								Max:            util_proto.UInt32(500),
+								UpdateInterval: util_proto.Duration(200 * time.Millisecond),
+							},
+							MinRtt: &mesh_proto.AdaptiveConcurrency_Conf_GradientController_MinRtt{
+								Interval:       util_proto.Duration(30 * time.Second),
+								RequestCount:   util_proto.UInt32(100),
+								Jitter:         util_proto.Double(10),
+								MinConcurrency: util_proto.UInt32(5),
+								Buffer:         util_proto.Double(20),
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.adaptive_concurrency
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.adaptive_concurrency.v3.AdaptiveConcurrency
+                    gradientControllerConfig:
+                      sampleAggregatePercentile:
+                        value: 90
+                      concurrencyLimitParams:
+                        maxConcurrencyLimit: 500
+                        concurrencyUpdateInterval: 0.200s
+                      minRttCalcParams:
+                        interval: 30s
+                        requestCount: 100
+                        jitter:
+                          value: 10
+                        minConcurrency: 5
+                        buffer:
+                          value: 20
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
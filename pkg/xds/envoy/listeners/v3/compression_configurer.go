@@ -0,0 +1,92 @@
+package v3
+
+import (
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_brotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoy_gzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoy_compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type CompressionConfigurer struct {
+	Conf *mesh_proto.Compression_Conf
+}
+
+var _ FilterChainConfigurer = &CompressionConfigurer{}
+
+func (c *CompressionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	algorithms := c.Conf.GetAlgorithms()
+	if len(algorithms) == 0 {
+		algorithms = []mesh_proto.Compression_Conf_Algorithm{mesh_proto.Compression_Conf_gzip}
+	}
+
+	var httpFilters []*envoy_hcm.HttpFilter
+
+	// Envoy applies the first compressor in the chain that supports one of
+	// the encodings accepted by the client, so the filters are added in the
+	// order of preference.
+	for _, algorithm := range algorithms {
+		library, err := compressorLibrary(algorithm)
+		if err != nil {
+			return err
+		}
+
+		config := &envoy_compressor.Compressor{
+			CompressorLibrary: library,
+			ResponseDirectionConfig: &envoy_compressor.Compressor_ResponseDirectionConfig{
+				CommonConfig: &envoy_compressor.Compressor_CommonDirectionConfig{
+					MinContentLength: c.Conf.GetMinContentLength(),
+					ContentType:      c.Conf.GetContentTypes(),
+				},
+				DisableOnEtagHeader: c.Conf.GetDisableOnEtagHeader(),
+			},
+		}
+
+		pbst, err := util_proto.MarshalAnyDeterministic(config)
+		if err != nil {
+			return err
+		}
+		httpFilters = append(httpFilters, &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.compressor",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters, httpFilters...)
+		return nil
+	})
+}
+
+func compressorLibrary(algorithm mesh_proto.Compression_Conf_Algorithm) (*envoy_config_core.TypedExtensionConfig, error) {
+	switch algorithm {
+	case mesh_proto.Compression_Conf_brotli:
+		pbst, err := util_proto.MarshalAnyDeterministic(&envoy_brotli.Brotli{})
+		if err != nil {
+			return nil, err
+		}
+		return &envoy_config_core.TypedExtensionConfig{
+			Name:        "envoy.compression.brotli.compressor",
+			TypedConfig: pbst,
+		}, nil
+	default:
+		pbst, err := util_proto.MarshalAnyDeterministic(&envoy_gzip.Gzip{})
+		if err != nil {
+			return nil, err
+		}
+		return &envoy_config_core.TypedExtensionConfig{
+			Name:        "envoy.compression.gzip.compressor",
+			TypedConfig: pbst,
+		}, nil
+	}
+}
@@ -0,0 +1,119 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("CompressionConfigurer", func() {
+	type testCase struct {
+		input    *core_mesh.CompressionResource
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(Compression(given.input)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("no compression", testCase{
+			input: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("default algorithm", testCase{
+			input: &core_mesh.CompressionResource{
+				Spec: &mesh_proto.Compression{
+					Conf: &mesh_proto.Compression_Conf{},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.compressor
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: envoy.compression.gzip.compressor
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.gzip.compressor.v3.Gzip
+                    responseDirectionConfig:
+                      commonConfig: {}
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("full configuration", testCase{
+			input: &core_mesh.CompressionResource{
+				Spec: &mesh_proto.Compression{
+					Conf: &mesh_proto.Compression_Conf{
+						MinContentLength: util_proto.UInt32(100),
+						ContentTypes:     []string{"application/json"},
+						Algorithms: []mesh_proto.Compression_Conf_Algorithm{
+							mesh_proto.Compression_Conf_brotli,
+							mesh_proto.Compression_Conf_gzip,
+						},
+						DisableOnEtagHeader: true,
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.compressor
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: envoy.compression.brotli.compressor
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.brotli.compressor.v3.Brotli
+                    responseDirectionConfig:
+                      commonConfig:
+                        contentType:
+                        - application/json
+                        minContentLength: 100
+                      disableOnEtagHeader: true
+                - name: envoy.filters.http.compressor
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: envoy.compression.gzip.compressor
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.gzip.compressor.v3.Gzip
+                    responseDirectionConfig:
+                      commonConfig:
+                        contentType:
+                        - application/json
+                        minContentLength: 100
+                      disableOnEtagHeader: true
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
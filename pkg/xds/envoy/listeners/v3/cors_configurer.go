@@ -0,0 +1,48 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+// CorsConfigurer adds the CORS filter to the HTTP connection manager. If
+// Conf is given, the CORS policy is also set on every virtual host of the
+// static route configuration, so it has to be applied after the routes
+// are configured.
+type CorsConfigurer struct {
+	Conf *mesh_proto.CorsPolicy_Conf
+}
+
+var _ FilterChainConfigurer = &CorsConfigurer{}
+
+func (c *CorsConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := util_proto.MarshalAnyDeterministic(&envoy_cors.Cors{})
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		// The CORS filter goes first, so preflight requests are answered
+		// before any fault injection or rate limiting takes place.
+		cors := &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.cors",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		}
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{cors}, manager.HttpFilters...)
+
+		if c.Conf != nil {
+			for _, virtualHost := range manager.GetRouteConfig().GetVirtualHosts() {
+				virtualHost.Cors = envoy_routes.NewCorsPolicy(c.Conf)
+			}
+		}
+
+		return nil
+	})
+}
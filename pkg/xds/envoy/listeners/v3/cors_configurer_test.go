@@ -0,0 +1,125 @@
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("CorsConfigurer", func() {
+	type testCase struct {
+		input    *core_mesh.CorsPolicyResource
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			routes := envoy_common.Routes{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(
+					envoy_common.WithService("localhost:8080"),
+				)),
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(HttpInboundRoutes("backend", routes)).
+				Configure(Cors(given.input)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("no CORS policy", testCase{
+			input: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  validateClusters: false
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: stats`,
+		}),
+		Entry("CORS policy", testCase{
+			input: &core_mesh.CorsPolicyResource{
+				Spec: &mesh_proto.CorsPolicy{
+					Conf: &mesh_proto.CorsPolicy_Conf{
+						AllowOrigins: []*mesh_proto.CorsPolicy_Conf_Origin{
+							{MatcherType: &mesh_proto.CorsPolicy_Conf_Origin_Exact{Exact: "https://example.com"}},
+							{MatcherType: &mesh_proto.CorsPolicy_Conf_Origin_Regex{Regex: `https://.*\.example\.com`}},
+						},
+						AllowMethods:     []mesh_proto.HttpMethod{mesh_proto.HttpMethod_GET, mesh_proto.HttpMethod_PUT},
+						AllowHeaders:     []string{"x-custom-header", "x-other-header"},
+						ExposeHeaders:    []string{"x-request-id"},
+						MaxAge:           util_proto.Duration(10 * time.Minute),
+						AllowCredentials: true,
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.cors
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.cors.v3.Cors
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  validateClusters: false
+                  virtualHosts:
+                  - cors:
+                      allowCredentials: true
+                      allowHeaders: x-custom-header,x-other-header
+                      allowMethods: GET,PUT
+                      allowOriginStringMatch:
+                      - exact: https://example.com
+                      - safeRegex:
+                          googleRe2: {}
+                          regex: https://.*\.example\.com
+                      exposeHeaders: x-request-id
+                      maxAge: "600"
+                    domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"sort"
+
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_filter_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
@@ -29,9 +31,10 @@ func (f *FaultInjectionConfigurer) Configure(filterChain *envoy_listener.FilterC
 		config := &envoy_http_fault.HTTPFault{
 			Delay: convertDelay(fi.Spec.Conf.GetDelay()),
 			Abort: convertAbort(fi.Spec.Conf.GetAbort()),
-			Headers: []*envoy_route.HeaderMatcher{
-				createHeaders(fi.Spec.SourceTags()),
-			},
+			Headers: append(
+				[]*envoy_route.HeaderMatcher{createHeaders(fi.Spec.SourceTags())},
+				convertHeaders(fi.Spec.Conf.GetHeaders())...,
+			),
 		}
 
 		rrl, err := convertResponseRateLimit(fi.Spec.Conf.GetResponseBandwidth())
@@ -96,10 +99,28 @@ func convertAbort(abort *mesh_proto.FaultInjection_Conf_Abort) *envoy_http_fault
 	if abort == nil {
 		return nil
 	}
-	return &envoy_http_fault.FaultAbort{
+	faultAbort := &envoy_http_fault.FaultAbort{
 		ErrorType:  &envoy_http_fault.FaultAbort_HttpStatus{HttpStatus: abort.HttpStatus.GetValue()},
 		Percentage: ConvertPercentage(abort.GetPercentage()),
 	}
+	if abort.GetGrpcStatus() != nil {
+		faultAbort.ErrorType = &envoy_http_fault.FaultAbort_GrpcStatus{GrpcStatus: abort.GetGrpcStatus().GetValue()}
+	}
+	return faultAbort
+}
+
+func convertHeaders(headers map[string]*mesh_proto.TrafficRoute_Http_Match_StringMatcher) []*envoy_route.HeaderMatcher {
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names) // sort for stability of Envoy config
+
+	var matchers []*envoy_route.HeaderMatcher
+	for _, name := range names {
+		matchers = append(matchers, envoy_routes.NewHeaderMatcher(name, headers[name]))
+	}
+	return matchers
 }
 
 func convertResponseRateLimit(responseBandwidth *mesh_proto.FaultInjection_Conf_ResponseBandwidth) (*envoy_filter_fault.FaultRateLimit, error) {
@@ -74,6 +74,62 @@ var _ = Describe("FaultInjectionConfigurer", func() {
                 - name: envoy.filters.http.router
                 statPrefix: stats`,
 		}),
+		Entry("grpc abort triggered by headers", testCase{
+			input: []*core_mesh.FaultInjectionResource{{
+				Spec: &mesh_proto.FaultInjection{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"tag1": "value1",
+							},
+						},
+					},
+					Conf: &mesh_proto.FaultInjection_Conf{
+						Abort: &mesh_proto.FaultInjection_Conf_Abort{
+							Percentage: util_proto.Double(100),
+							GrpcStatus: util_proto.UInt32(14),
+						},
+						Headers: map[string]*mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							"x-chaos": {
+								MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+									Exact: "true",
+								},
+							},
+							"x-chaos-target": {
+								MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+									Prefix: "backend",
+								},
+							},
+						},
+					},
+				},
+			}},
+
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.fault
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.fault.v3.HTTPFault
+                    abort:
+                      grpcStatus: 14
+                      percentage:
+                        numerator: 100
+                    headers:
+                    - name: x-kuma-tags
+                      safeRegexMatch:
+                        googleRe2: {}
+                        regex: '.*&tag1=[^&]*value1[,&].*'
+                    - exactMatch: "true"
+                      name: x-chaos
+                    - name: x-chaos-target
+                      prefixMatch: backend
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
 		Entry("2 policy selectors", testCase{
 			input: []*core_mesh.FaultInjectionResource{{
 				Spec: &mesh_proto.FaultInjection{
@@ -0,0 +1,117 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// JwtAuthnConfigurer prepends the JWT authentication filter to the HTTP
+// connection manager. Every request has to carry a token that is verified
+// by one of the configured providers. Jwks maps each provider name to its
+// JSON Web Key Set. Requests with a path in BypassPathPrefixes are not
+// authenticated.
+type JwtAuthnConfigurer struct {
+	Conf               *mesh_proto.MeshGateway_Authentication_JWT
+	Jwks               map[string]string
+	BypassPathPrefixes []string
+}
+
+var _ FilterChainConfigurer = &JwtAuthnConfigurer{}
+
+func (c *JwtAuthnConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	config := &envoy_jwt.JwtAuthentication{
+		Providers: map[string]*envoy_jwt.JwtProvider{},
+		// Let the CORS filter answer preflight requests, since browsers
+		// never send credentials with them.
+		BypassCorsPreflight: true,
+	}
+
+	var requirements []*envoy_jwt.JwtRequirement
+
+	for _, p := range c.Conf.GetProviders() {
+		config.Providers[p.GetName()] = &envoy_jwt.JwtProvider{
+			Issuer:    p.GetIssuer(),
+			Audiences: p.GetAudiences(),
+			JwksSourceSpecifier: &envoy_jwt.JwtProvider_LocalJwks{
+				LocalJwks: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineString{
+						InlineString: c.Jwks[p.GetName()],
+					},
+				},
+			},
+			Forward:              p.GetForward(),
+			ForwardPayloadHeader: p.GetForwardPayloadHeader(),
+		}
+
+		requirements = append(requirements, &envoy_jwt.JwtRequirement{
+			RequiresType: &envoy_jwt.JwtRequirement_ProviderName{
+				ProviderName: p.GetName(),
+			},
+		})
+	}
+
+	if c.Conf.GetAllowMissing() {
+		requirements = append(requirements, &envoy_jwt.JwtRequirement{
+			RequiresType: &envoy_jwt.JwtRequirement_AllowMissing{
+				AllowMissing: &emptypb.Empty{},
+			},
+		})
+	}
+
+	requires := requirements[0]
+	if len(requirements) > 1 {
+		requires = &envoy_jwt.JwtRequirement{
+			RequiresType: &envoy_jwt.JwtRequirement_RequiresAny{
+				RequiresAny: &envoy_jwt.JwtRequirementOrList{
+					Requirements: requirements,
+				},
+			},
+		}
+	}
+
+	// The first matching rule applies, and a rule without requirements
+	// lets the request through unverified.
+	for _, prefix := range c.BypassPathPrefixes {
+		config.Rules = append(config.Rules, &envoy_jwt.RequirementRule{
+			Match: &envoy_route.RouteMatch{
+				PathSpecifier: &envoy_route.RouteMatch_Prefix{
+					Prefix: prefix,
+				},
+			},
+		})
+	}
+
+	config.Rules = append(config.Rules, &envoy_jwt.RequirementRule{
+		Match: &envoy_route.RouteMatch{
+			PathSpecifier: &envoy_route.RouteMatch_Prefix{
+				Prefix: "/",
+			},
+		},
+		RequirementType: &envoy_jwt.RequirementRule_Requires{
+			Requires: requires,
+		},
+	})
+
+	pbst, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		filter := &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.jwt_authn",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		}
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{filter}, manager.HttpFilters...)
+		return nil
+	})
+}
@@ -0,0 +1,152 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("JwtAuthnConfigurer", func() {
+	type testCase struct {
+		conf     *mesh_proto.MeshGateway_Authentication_JWT
+		jwks     map[string]string
+		bypass   []string
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(JwtAuthn(given.conf, given.jwks, given.bypass...)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("single provider", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_JWT{
+				Providers: []*mesh_proto.MeshGateway_Authentication_JWT_Provider{{
+					Name:      "example",
+					Issuer:    "https://auth.example.com",
+					Audiences: []string{"api"},
+					Forward:   true,
+				}},
+			},
+			jwks: map[string]string{
+				"example": `{"keys":[]}`,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.jwt_authn
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                    bypassCorsPreflight: true
+                    providers:
+                      example:
+                        audiences:
+                        - api
+                        forward: true
+                        issuer: https://auth.example.com
+                        localJwks:
+                          inlineString: '{"keys":[]}'
+                    rules:
+                    - match:
+                        prefix: /
+                      requires:
+                        providerName: example
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("bypassed path prefixes", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_JWT{
+				Providers: []*mesh_proto.MeshGateway_Authentication_JWT_Provider{{
+					Name: "example",
+				}},
+			},
+			jwks: map[string]string{
+				"example": `{"keys":[]}`,
+			},
+			bypass: []string{"/.well-known/acme-challenge/"},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.jwt_authn
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                    bypassCorsPreflight: true
+                    providers:
+                      example:
+                        localJwks:
+                          inlineString: '{"keys":[]}'
+                    rules:
+                    - match:
+                        prefix: /.well-known/acme-challenge/
+                    - match:
+                        prefix: /
+                      requires:
+                        providerName: example
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("multiple providers allowing missing tokens", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_JWT{
+				Providers: []*mesh_proto.MeshGateway_Authentication_JWT_Provider{{
+					Name: "example",
+				}, {
+					Name:                 "other",
+					ForwardPayloadHeader: "x-jwt-payload",
+				}},
+				AllowMissing: true,
+			},
+			jwks: map[string]string{
+				"example": `{"keys":[]}`,
+				"other":   `{"keys":[{}]}`,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.jwt_authn
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                    bypassCorsPreflight: true
+                    providers:
+                      example:
+                        localJwks:
+                          inlineString: '{"keys":[]}'
+                      other:
+                        forwardPayloadHeader: x-jwt-payload
+                        localJwks:
+                          inlineString: '{"keys":[{}]}'
+                    rules:
+                    - match:
+                        prefix: /
+                      requires:
+                        requiresAny:
+                          requirements:
+                          - providerName: example
+                          - providerName: other
+                          - allowMissing: {}
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -0,0 +1,117 @@
+package v3
+
+import (
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_oauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	tls "github.com/kumahq/kuma/pkg/xds/envoy/tls/v3"
+)
+
+const (
+	defaultOAuth2RedirectPath = "/oauth2/callback"
+	defaultOAuth2Scope        = "openid"
+	defaultOAuth2TokenTimeout = 5 * time.Second
+)
+
+// OAuth2Configurer prepends the OAuth2 filter to the HTTP connection
+// manager, so that users without a valid session are sent through the
+// OIDC authorization code flow. TokenCluster is the name of the cluster
+// that reaches the token endpoint, and TokenSecret and HmacSecret are
+// the names of the SDS secrets holding the client and cookie secrets.
+// Requests with a path in BypassPathPrefixes are passed through without
+// a session.
+type OAuth2Configurer struct {
+	Conf               *mesh_proto.MeshGateway_Authentication_OIDC
+	TokenCluster       string
+	TokenSecret        string
+	HmacSecret         string
+	BypassPathPrefixes []string
+}
+
+var _ FilterChainConfigurer = &OAuth2Configurer{}
+
+func (c *OAuth2Configurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	redirectPath := c.Conf.GetRedirectPath()
+	if redirectPath == "" {
+		redirectPath = defaultOAuth2RedirectPath
+	}
+
+	scopes := c.Conf.GetScopes()
+	if len(scopes) == 0 {
+		scopes = []string{defaultOAuth2Scope}
+	}
+
+	config := &envoy_oauth2.OAuth2Config{
+		TokenEndpoint: &envoy_core.HttpUri{
+			Uri: c.Conf.GetTokenEndpoint(),
+			HttpUpstreamType: &envoy_core.HttpUri_Cluster{
+				Cluster: c.TokenCluster,
+			},
+			Timeout: util_proto.Duration(defaultOAuth2TokenTimeout),
+		},
+		AuthorizationEndpoint: c.Conf.GetAuthorizationEndpoint(),
+		Credentials: &envoy_oauth2.OAuth2Credentials{
+			ClientId:    c.Conf.GetClientId(),
+			TokenSecret: tls.NewSecretConfigSource(c.TokenSecret),
+			TokenFormation: &envoy_oauth2.OAuth2Credentials_HmacSecret{
+				HmacSecret: tls.NewSecretConfigSource(c.HmacSecret),
+			},
+		},
+		// The gateway can be behind a proxy that terminates TLS,
+		// so take the scheme from the forwarded protocol header
+		// that the connection manager always sets.
+		RedirectUri:         "%REQ(x-forwarded-proto)%://%REQ(:authority)%" + redirectPath,
+		RedirectPathMatcher: exactPathMatcher(redirectPath),
+		ForwardBearerToken:  c.Conf.GetForwardBearerToken(),
+		AuthScopes:          scopes,
+	}
+
+	if p := c.Conf.GetSignoutPath(); p != "" {
+		config.SignoutPath = exactPathMatcher(p)
+	}
+
+	for _, prefix := range c.BypassPathPrefixes {
+		config.PassThroughMatcher = append(config.PassThroughMatcher, &envoy_route.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PrefixMatch{
+				PrefixMatch: prefix,
+			},
+		})
+	}
+
+	pbst, err := util_proto.MarshalAnyDeterministic(&envoy_oauth2.OAuth2{Config: config})
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		filter := &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.oauth2",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		}
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{filter}, manager.HttpFilters...)
+		return nil
+	})
+}
+
+func exactPathMatcher(path string) *envoy_type_matcher.PathMatcher {
+	return &envoy_type_matcher.PathMatcher{
+		Rule: &envoy_type_matcher.PathMatcher_Path{
+			Path: &envoy_type_matcher.StringMatcher{
+				MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+					Exact: path,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("OAuth2Configurer", func() {
+	type testCase struct {
+		conf     *mesh_proto.MeshGateway_Authentication_OIDC
+		bypass   []string
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(OAuth2(given.conf, "oidc-token", "oidc-client-secret", "oidc-hmac-secret", given.bypass...)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("defaults", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_OIDC{
+				AuthorizationEndpoint: "https://auth.example.com/authorize",
+				TokenEndpoint:         "https://auth.example.com/token",
+				ClientId:              "gateway",
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.oauth2
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.oauth2.v3.OAuth2
+                    config:
+                      authScopes:
+                      - openid
+                      authorizationEndpoint: https://auth.example.com/authorize
+                      credentials:
+                        clientId: gateway
+                        hmacSecret:
+                          name: oidc-hmac-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                        tokenSecret:
+                          name: oidc-client-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                      redirectPathMatcher:
+                        path:
+                          exact: /oauth2/callback
+                      redirectUri: '%REQ(x-forwarded-proto)%://%REQ(:authority)%/oauth2/callback'
+                      tokenEndpoint:
+                        cluster: oidc-token
+                        timeout: 5s
+                        uri: https://auth.example.com/token
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("bypassed path prefixes", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_OIDC{
+				AuthorizationEndpoint: "https://auth.example.com/authorize",
+				TokenEndpoint:         "https://auth.example.com/token",
+				ClientId:              "gateway",
+			},
+			bypass: []string{"/.well-known/acme-challenge/"},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.oauth2
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.oauth2.v3.OAuth2
+                    config:
+                      authScopes:
+                      - openid
+                      authorizationEndpoint: https://auth.example.com/authorize
+                      credentials:
+                        clientId: gateway
+                        hmacSecret:
+                          name: oidc-hmac-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                        tokenSecret:
+                          name: oidc-client-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                      passThroughMatcher:
+                      - name: :path
+                        prefixMatch: /.well-known/acme-challenge/
+                      redirectPathMatcher:
+                        path:
+                          exact: /oauth2/callback
+                      redirectUri: '%REQ(x-forwarded-proto)%://%REQ(:authority)%/oauth2/callback'
+                      tokenEndpoint:
+                        cluster: oidc-token
+                        timeout: 5s
+                        uri: https://auth.example.com/token
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("full configuration", testCase{
+			conf: &mesh_proto.MeshGateway_Authentication_OIDC{
+				AuthorizationEndpoint: "https://auth.example.com/authorize",
+				TokenEndpoint:         "https://auth.example.com/token",
+				ClientId:              "gateway",
+				RedirectPath:          "/callback",
+				SignoutPath:           "/logout",
+				Scopes:                []string{"openid", "email"},
+				ForwardBearerToken:    true,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.oauth2
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.oauth2.v3.OAuth2
+                    config:
+                      authScopes:
+                      - openid
+                      - email
+                      authorizationEndpoint: https://auth.example.com/authorize
+                      credentials:
+                        clientId: gateway
+                        hmacSecret:
+                          name: oidc-hmac-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                        tokenSecret:
+                          name: oidc-client-secret
+                          sdsConfig:
+                            ads: {}
+                            resourceApiVersion: V3
+                      forwardBearerToken: true
+                      redirectPathMatcher:
+                        path:
+                          exact: /callback
+                      redirectUri: '%REQ(x-forwarded-proto)%://%REQ(:authority)%/callback'
+                      signoutPath:
+                        path:
+                          exact: /logout
+                      tokenEndpoint:
+                        cluster: oidc-token
+                        timeout: 5s
+                        uri: https://auth.example.com/token
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -346,6 +346,106 @@ var _ = Describe("RetryConfigurer", func() {
                           timeout: 0s
                   statPrefix: "127_0_0_1_18080"
             name: outbound:127.0.0.1:18080
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with an outbound route"+
+			" and http retry policy with conditions, rate limited back off"+
+			" and hedging", testCase{
+			listenerName:    "outbound:127.0.0.1:18080",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    18080,
+			statsName:       "127.0.0.1:18080",
+			service:         "backend",
+			routes: envoy_common.Routes{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+						envoy_common.WithService("backend"),
+						envoy_common.WithWeight(100),
+					)},
+				},
+			},
+			dpTags: map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+			},
+			protocol: "http",
+			retry: &core_mesh.RetryResource{
+				Spec: &mesh_proto.Retry{
+					Conf: &mesh_proto.Retry_Conf{
+						Http: &mesh_proto.Retry_Conf_Http{
+							PerTryTimeout: util_proto.Duration(time.Millisecond * 300),
+							RetryOn: []mesh_proto.Retry_Conf_HttpRetryOn{
+								mesh_proto.Retry_Conf_all_5xx,
+								mesh_proto.Retry_Conf_reset,
+								mesh_proto.Retry_Conf_connect_failure,
+							},
+							RetriableStatusCodes: []uint32{429},
+							RetriableHeaders:     []string{"x-retry"},
+							RateLimitedBackOff: &mesh_proto.Retry_Conf_RateLimitedBackOff{
+								ResetHeaders: []*mesh_proto.Retry_Conf_RateLimitedBackOff_ResetHeader{
+									{
+										Name: "Retry-After",
+									},
+									{
+										Name:   "X-RateLimit-Reset",
+										Format: mesh_proto.Retry_Conf_RateLimitedBackOff_ResetHeader_unix_timestamp,
+									},
+								},
+								MaxInterval: util_proto.Duration(time.Second * 10),
+							},
+							HedgeOnPerTryTimeout: true,
+						},
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 18080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  routeConfig:
+                    name: outbound:backend
+                    validateClusters: false
+                    requestHeadersToAdd:
+                    - header:
+                        key: x-kuma-tags
+                        value: '&kuma.io/service=web&'
+                    virtualHosts:
+                    - domains:
+                      - '*'
+                      name: backend
+                      hedgePolicy:
+                        hedgeOnPerTryTimeout: true
+                      retryPolicy:
+                        perTryTimeout: 0.300s
+                        rateLimitedRetryBackOff:
+                          maxInterval: 10s
+                          resetHeaders:
+                          - name: Retry-After
+                          - format: UNIX_TIMESTAMP
+                            name: X-RateLimit-Reset
+                        retriableHeaders:
+                        - name: x-retry
+                          presentMatch: true
+                        retriableStatusCodes:
+                        - 429
+                        retryOn: 5xx,reset,connect-failure,retriable-status-codes,retriable-headers
+                      routes:
+                      - match:
+                          prefix: /
+                        route:
+                          cluster: backend
+                          timeout: 0s
+                  statPrefix: "127_0_0_1_18080"
+            name: outbound:127.0.0.1:18080
             trafficDirection: OUTBOUND`,
 		}),
 	)
@@ -0,0 +1,86 @@
+package v3
+
+import (
+	"math"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// tcpFaultTotalWeight is the sum of the weights of the clusters when the
+// connections are aborted, so the percentage has a precision of 4 decimal places.
+const tcpFaultTotalWeight = 1000000
+
+// TcpFaultInjectionConfigurer aborts the given percentage of the connections
+// by forwarding them to a cluster without endpoints.
+type TcpFaultInjectionConfigurer struct {
+	FaultInjection *core_mesh.FaultInjectionResource
+}
+
+var _ FilterChainConfigurer = &TcpFaultInjectionConfigurer{}
+
+// TcpFaultAbortWeight returns the weight of the abort cluster for the given
+// FaultInjection. Zero means that no connection is aborted.
+func TcpFaultAbortWeight(faultInjection *core_mesh.FaultInjectionResource) uint32 {
+	if faultInjection == nil || faultInjection.Spec.GetConf().GetTcp().GetAbort() == nil {
+		return 0
+	}
+	percentage := faultInjection.Spec.GetConf().GetTcp().GetAbort().GetPercentage().GetValue()
+	return uint32(math.Round(percentage * tcpFaultTotalWeight / 100))
+}
+
+func (c *TcpFaultInjectionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	abortWeight := TcpFaultAbortWeight(c.FaultInjection)
+	if abortWeight == 0 {
+		return nil
+	}
+
+	return UpdateTCPProxy(filterChain, func(proxy *envoy_tcp.TcpProxy) error {
+		var clusters []*envoy_tcp.TcpProxy_WeightedCluster_ClusterWeight
+		switch specifier := proxy.ClusterSpecifier.(type) {
+		case *envoy_tcp.TcpProxy_Cluster:
+			clusters = append(clusters, &envoy_tcp.TcpProxy_WeightedCluster_ClusterWeight{
+				Name:          specifier.Cluster,
+				Weight:        1,
+				MetadataMatch: proxy.MetadataMatch,
+			})
+			proxy.MetadataMatch = nil
+		case *envoy_tcp.TcpProxy_WeightedClusters:
+			clusters = specifier.WeightedClusters.GetClusters()
+		}
+
+		var totalWeight uint64
+		for _, cluster := range clusters {
+			totalWeight += uint64(cluster.Weight)
+		}
+
+		// The weights of the destinations are scaled, so they keep their
+		// proportions in the remaining part of the connections.
+		weightedClusters := []*envoy_tcp.TcpProxy_WeightedCluster_ClusterWeight{}
+		for _, cluster := range clusters {
+			if totalWeight == 0 {
+				break
+			}
+			weight := uint64(cluster.Weight) * uint64(tcpFaultTotalWeight-abortWeight) / totalWeight
+			if weight == 0 {
+				continue
+			}
+			cluster.Weight = uint32(weight)
+			weightedClusters = append(weightedClusters, cluster)
+		}
+		weightedClusters = append(weightedClusters, &envoy_tcp.TcpProxy_WeightedCluster_ClusterWeight{
+			Name:   envoy_names.GetFaultInjectionAbortClusterName(),
+			Weight: abortWeight,
+		})
+
+		proxy.ClusterSpecifier = &envoy_tcp.TcpProxy_WeightedClusters{
+			WeightedClusters: &envoy_tcp.TcpProxy_WeightedCluster{
+				Clusters: weightedClusters,
+			},
+		}
+		return nil
+	})
+}
@@ -0,0 +1,142 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("TcpFaultInjectionConfigurer", func() {
+	type testCase struct {
+		clusters   []envoy_common.Cluster
+		percentage float64
+		expected   string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			faultInjection := &core_mesh.FaultInjectionResource{
+				Spec: &mesh_proto.FaultInjection{
+					Conf: &mesh_proto.FaultInjection_Conf{
+						Tcp: &mesh_proto.FaultInjection_Conf_Tcp{
+							Abort: &mesh_proto.FaultInjection_Conf_Tcp_ConnectionAbort{
+								Percentage: util_proto.Double(given.percentage),
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			listener, err := NewListenerBuilder(envoy_common.APIV3).
+				Configure(OutboundListener("outbound:127.0.0.1:5432", "127.0.0.1", 5432, core_xds.SocketAddressProtocolTCP)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
+					Configure(TcpProxy("db", given.clusters...)).
+					Configure(TcpFaultInjection(faultInjection)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("single cluster", testCase{
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("db"),
+				envoy_common.WithWeight(100),
+			)},
+			percentage: 12.5,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  statPrefix: db
+                  weightedClusters:
+                    clusters:
+                    - name: db
+                      weight: 875000
+                    - name: kuma:fault_injection:abort
+                      weight: 125000
+`,
+		}),
+		Entry("weighted clusters", testCase{
+			clusters: []envoy_common.Cluster{
+				envoy_common.NewCluster(
+					envoy_common.WithName("db-_0_"),
+					envoy_common.WithService("db"),
+					envoy_common.WithWeight(30),
+				),
+				envoy_common.NewCluster(
+					envoy_common.WithName("db-_1_"),
+					envoy_common.WithService("db"),
+					envoy_common.WithWeight(70),
+				),
+			},
+			percentage: 50,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  statPrefix: db
+                  weightedClusters:
+                    clusters:
+                    - name: db-_0_
+                      weight: 150000
+                    - name: db-_1_
+                      weight: 350000
+                    - name: kuma:fault_injection:abort
+                      weight: 500000
+`,
+		}),
+		Entry("all connections aborted", testCase{
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("db"),
+			)},
+			percentage: 100,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  statPrefix: db
+                  weightedClusters:
+                    clusters:
+                    - name: kuma:fault_injection:abort
+                      weight: 1000000
+`,
+		}),
+	)
+})
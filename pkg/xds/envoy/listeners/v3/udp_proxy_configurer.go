@@ -0,0 +1,38 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_udp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// UDPProxyConfigurer adds the UDP proxy listener filter, which forwards
+// the datagrams received by a UDP listener to the given cluster.
+type UDPProxyConfigurer struct {
+	StatsName string
+	Cluster   string
+}
+
+var _ ListenerConfigurer = &UDPProxyConfigurer{}
+
+func (c *UDPProxyConfigurer) Configure(l *envoy_listener.Listener) error {
+	any, err := proto.MarshalAnyDeterministic(&envoy_udp.UdpProxyConfig{
+		StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+		RouteSpecifier: &envoy_udp.UdpProxyConfig_Cluster{
+			Cluster: c.Cluster,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	l.ListenerFilters = append(l.ListenerFilters, &envoy_listener.ListenerFilter{
+		Name: "envoy.filters.udp_listener.udp_proxy",
+		ConfigType: &envoy_listener.ListenerFilter_TypedConfig{
+			TypedConfig: any,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,58 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("UDPProxyConfigurer", func() {
+
+	type testCase struct {
+		statsName string
+		cluster   string
+		expected  string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder(envoy.APIV3).
+				Configure(InboundListener("inbound:192.168.0.1:53", "192.168.0.1", 53, xds.SocketAddressProtocolUDP)).
+				Configure(UDPProxy(given.statsName, given.cluster)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("basic UDP proxy", testCase{
+			statsName: "dns.udp",
+			cluster:   "dns",
+			expected: `
+            name: inbound:192.168.0.1:53
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 53
+                protocol: UDP
+            enableReusePort: true
+            listenerFilters:
+            - name: envoy.filters.udp_listener.udp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.udp.udp_proxy.v3.UdpProxyConfig
+                cluster: dns
+                statPrefix: dns_udp
+`,
+		}),
+	)
+})
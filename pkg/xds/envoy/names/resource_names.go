@@ -63,6 +63,10 @@ func GetEnvoyAdminClusterName() string {
 	return Join("kuma", "envoy", "admin")
 }
 
+func GetFaultInjectionAbortClusterName() string {
+	return Join("kuma", "fault_injection", "abort")
+}
+
 func GetMetricsHijackerClusterName() string {
 	return Join("kuma", "metrics", "hijacker")
 }
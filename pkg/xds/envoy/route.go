@@ -1,6 +1,8 @@
 package envoy
 
 import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 )
 
@@ -8,7 +10,21 @@ type Route struct {
 	Match     *mesh_proto.TrafficRoute_Http_Match
 	Modify    *mesh_proto.TrafficRoute_Http_Modify
 	RateLimit *mesh_proto.RateLimit
+	Mirror    *Mirror
 	Clusters  []Cluster
+	// HeaderModification holds the modifications of the HeaderModification
+	// policy. They are applied in addition to the ones of the TrafficRoute.
+	HeaderModification *mesh_proto.HeaderModification_Conf_Headers
+	// Timeout and Retry hold the overrides of the TrafficRoute HTTP rule.
+	// They take precedence over the Timeout and Retry policies.
+	Timeout *mesh_proto.TrafficRoute_Http_Timeout
+	Retry   *mesh_proto.Retry_Conf_Http
+}
+
+// Mirror specifies a cluster to which the traffic matched by the route is mirrored.
+type Mirror struct {
+	Cluster    Cluster
+	Percentage *wrapperspb.DoubleValue
 }
 
 func NewRouteFromCluster(cluster Cluster) Route {
@@ -28,6 +44,16 @@ func (r Routes) Clusters() (clusters []Cluster) {
 	return
 }
 
+// MirrorClusters returns clusters to which the traffic of the routes is mirrored.
+func (r Routes) MirrorClusters() (clusters []Cluster) {
+	for _, route := range r {
+		if route.Mirror != nil {
+			clusters = append(clusters, route.Mirror.Cluster)
+		}
+	}
+	return
+}
+
 type NewRouteOpt interface {
 	apply(route *Route)
 }
@@ -73,3 +99,18 @@ func WithRateLimit(rl *mesh_proto.RateLimit) NewRouteOpt {
 		route.RateLimit = rl
 	})
 }
+
+func WithHeaderModification(headers *mesh_proto.HeaderModification_Conf_Headers) NewRouteOpt {
+	return newRouteOptFunc(func(route *Route) {
+		route.HeaderModification = headers
+	})
+}
+
+func WithMirror(cluster Cluster, percentage *wrapperspb.DoubleValue) NewRouteOpt {
+	return newRouteOptFunc(func(route *Route) {
+		route.Mirror = &Mirror{
+			Cluster:    cluster,
+			Percentage: percentage,
+		}
+	})
+}
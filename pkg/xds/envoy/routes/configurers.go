@@ -38,6 +38,17 @@ func Routes(routes envoy_common.Routes) VirtualHostBuilderOpt {
 		})
 }
 
+// Cors sets the CORS policy of the virtual host.
+func Cors(conf *mesh_proto.CorsPolicy_Conf) VirtualHostBuilderOpt {
+	if conf == nil {
+		return VirtualHostBuilderOptFunc(nil)
+	}
+
+	return AddVirtualHostConfigurer(&v3.CorsConfigurer{
+		Conf: conf,
+	})
+}
+
 // Redirect for paths that match to matchPath returns 301 status code with new port and path
 func Redirect(matchPath, newPath string, allowGetOnly bool, port uint32) VirtualHostBuilderOpt {
 	return AddVirtualHostConfigurer(&v3.RedirectConfigurer{
@@ -0,0 +1,74 @@
+package v3
+
+import (
+	"strconv"
+	"strings"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// CorsConfigurer sets the CORS policy of the virtual host. The policy is
+// only enforced when the CORS filter is present in the HTTP filter chain.
+type CorsConfigurer struct {
+	Conf *mesh_proto.CorsPolicy_Conf
+}
+
+func (c *CorsConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	virtualHost.Cors = NewCorsPolicy(c.Conf)
+	return nil
+}
+
+// NewCorsPolicy converts the given Kuma CORS configuration to the Envoy
+// CORS policy.
+func NewCorsPolicy(conf *mesh_proto.CorsPolicy_Conf) *envoy_route.CorsPolicy {
+	policy := &envoy_route.CorsPolicy{
+		AllowHeaders:  strings.Join(conf.GetAllowHeaders(), ","),
+		ExposeHeaders: strings.Join(conf.GetExposeHeaders(), ","),
+	}
+
+	for _, origin := range conf.GetAllowOrigins() {
+		switch origin.GetMatcherType().(type) {
+		case *mesh_proto.CorsPolicy_Conf_Origin_Exact:
+			policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_type_matcher.StringMatcher{
+				MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+					Exact: origin.GetExact(),
+				},
+			})
+		case *mesh_proto.CorsPolicy_Conf_Origin_Regex:
+			policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_type_matcher.StringMatcher{
+				MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+					SafeRegex: &envoy_type_matcher.RegexMatcher{
+						EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+							GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+						},
+						Regex: origin.GetRegex(),
+					},
+				},
+			})
+		}
+	}
+
+	var methods []string
+	for _, method := range conf.GetAllowMethods() {
+		methods = append(methods, method.String())
+	}
+	policy.AllowMethods = strings.Join(methods, ",")
+
+	if maxAge := conf.GetMaxAge(); maxAge != nil {
+		policy.MaxAge = strconv.FormatInt(int64(maxAge.AsDuration().Seconds()), 10)
+	}
+
+	if conf.GetAllowCredentials() {
+		policy.AllowCredentials = util_proto.Bool(true)
+	}
+
+	return policy
+}
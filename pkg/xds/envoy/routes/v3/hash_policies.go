@@ -0,0 +1,54 @@
+package v3
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// NewHashPolicies converts the hash policies of the load balancer to the
+// hash policies of the Envoy route action.
+func NewHashPolicies(hashPolicies []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy) []*envoy_route.RouteAction_HashPolicy {
+	var result []*envoy_route.RouteAction_HashPolicy
+
+	for _, hashPolicy := range hashPolicies {
+		policy := &envoy_route.RouteAction_HashPolicy{
+			Terminal: hashPolicy.GetTerminal(),
+		}
+
+		switch hashPolicy.GetType().(type) {
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+			policy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Header_{
+				Header: &envoy_route.RouteAction_HashPolicy_Header{
+					HeaderName: hashPolicy.GetHeader().GetName(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+			policy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoy_route.RouteAction_HashPolicy_Cookie{
+					Name: hashPolicy.GetCookie().GetName(),
+					Ttl:  hashPolicy.GetCookie().GetTtl(),
+					Path: hashPolicy.GetCookie().GetPath(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+			policy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_:
+			policy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &envoy_route.RouteAction_HashPolicy_QueryParameter{
+					Name: hashPolicy.GetQueryParameter().GetName(),
+				},
+			}
+		default:
+			continue
+		}
+
+		result = append(result, policy)
+	}
+
+	return result
+}
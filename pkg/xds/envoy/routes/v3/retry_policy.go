@@ -0,0 +1,186 @@
+package v3
+
+import (
+	"strings"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	HttpRetryOnDefault = "gateway-error,connect-failure," +
+		"refused-stream"
+	HttpRetryOnRetriableStatusCodes = "connect-failure,refused-stream," +
+		"retriable-status-codes"
+	GrpcRetryOnDefault = "cancelled,connect-failure," +
+		"gateway-error,refused-stream,reset,resource-exhausted,unavailable"
+)
+
+// NewGrpcRetryPolicy converts the gRPC configuration of the Retry policy
+// to the Envoy retry policy.
+func NewGrpcRetryPolicy(
+	conf *mesh_proto.Retry_Conf_Grpc,
+) *envoy_route.RetryPolicy {
+	if conf == nil {
+		return nil
+	}
+
+	policy := envoy_route.RetryPolicy{
+		RetryOn:       GrpcRetryOnDefault,
+		PerTryTimeout: conf.PerTryTimeout,
+	}
+
+	if conf.NumRetries != nil {
+		policy.NumRetries = util_proto.UInt32(conf.NumRetries.Value)
+	}
+
+	if conf.BackOff != nil {
+		policy.RetryBackOff = &envoy_route.RetryPolicy_RetryBackOff{
+			BaseInterval: conf.BackOff.BaseInterval,
+			MaxInterval:  conf.BackOff.MaxInterval,
+		}
+	}
+
+	if conf.RetryOn != nil {
+		var retryOn []string
+
+		for _, item := range conf.RetryOn {
+			// As `retryOn` is an enum value, and as in protobuf we can't use
+			// hyphens we are using underscores instead, but as envoy expect
+			// values with hyphens it's being changed here
+			retryOn = append(retryOn, strings.ReplaceAll(item.String(), "_", "-"))
+		}
+
+		policy.RetryOn = strings.Join(retryOn, ",")
+	}
+
+	policy.RateLimitedRetryBackOff = RateLimitedRetryBackOff(conf.RateLimitedBackOff)
+
+	return &policy
+}
+
+// HttpRetryOnConditions returns the Envoy conditions that trigger the HTTP
+// retries. The conditions required by the retriable status codes and the
+// retriable headers are added if they are missing.
+func HttpRetryOnConditions(conf *mesh_proto.Retry_Conf_Http) []string {
+	if len(conf.GetRetryOn()) == 0 {
+		conditions := strings.Split(HttpRetryOnDefault, ",")
+		if conf.GetRetriableStatusCodes() != nil {
+			conditions = strings.Split(HttpRetryOnRetriableStatusCodes, ",")
+		}
+		if len(conf.GetRetriableHeaders()) > 0 {
+			conditions = append(conditions, "retriable-headers")
+		}
+		return conditions
+	}
+
+	var conditions []string
+	present := map[string]bool{}
+	for _, item := range conf.GetRetryOn() {
+		condition := strings.ReplaceAll(item.String(), "_", "-")
+		if item == mesh_proto.Retry_Conf_all_5xx {
+			condition = "5xx"
+		}
+		if !present[condition] {
+			conditions = append(conditions, condition)
+			present[condition] = true
+		}
+	}
+	if conf.GetRetriableStatusCodes() != nil && !present["retriable-status-codes"] {
+		conditions = append(conditions, "retriable-status-codes")
+	}
+	if len(conf.GetRetriableHeaders()) > 0 && !present["retriable-headers"] {
+		conditions = append(conditions, "retriable-headers")
+	}
+	return conditions
+}
+
+// RateLimitedRetryBackOff converts the back off of the rate limited retries
+// to the Envoy configuration.
+func RateLimitedRetryBackOff(conf *mesh_proto.Retry_Conf_RateLimitedBackOff) *envoy_route.RetryPolicy_RateLimitedRetryBackOff {
+	if conf == nil {
+		return nil
+	}
+
+	backOff := &envoy_route.RetryPolicy_RateLimitedRetryBackOff{
+		MaxInterval: conf.GetMaxInterval(),
+	}
+
+	for _, header := range conf.GetResetHeaders() {
+		format := envoy_route.RetryPolicy_SECONDS
+		if header.GetFormat() == mesh_proto.Retry_Conf_RateLimitedBackOff_ResetHeader_unix_timestamp {
+			format = envoy_route.RetryPolicy_UNIX_TIMESTAMP
+		}
+
+		backOff.ResetHeaders = append(backOff.ResetHeaders, &envoy_route.RetryPolicy_ResetHeader{
+			Name:   header.GetName(),
+			Format: format,
+		})
+	}
+
+	return backOff
+}
+
+// RetriableHeaders returns the matchers of the response headers that
+// trigger the retry when present.
+func RetriableHeaders(names []string) []*envoy_route.HeaderMatcher {
+	var matchers []*envoy_route.HeaderMatcher
+
+	for _, name := range names {
+		matchers = append(matchers, &envoy_route.HeaderMatcher{
+			Name:                 name,
+			HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PresentMatch{PresentMatch: true},
+		})
+	}
+
+	return matchers
+}
+
+// NewHttpRetryPolicy converts the HTTP configuration of the Retry policy
+// to the Envoy retry policy.
+func NewHttpRetryPolicy(
+	conf *mesh_proto.Retry_Conf_Http,
+) *envoy_route.RetryPolicy {
+	if conf == nil {
+		return nil
+	}
+
+	policy := envoy_route.RetryPolicy{
+		RetryOn:                 strings.Join(HttpRetryOnConditions(conf), ","),
+		PerTryTimeout:           conf.PerTryTimeout,
+		RetriableHeaders:        RetriableHeaders(conf.RetriableHeaders),
+		RateLimitedRetryBackOff: RateLimitedRetryBackOff(conf.RateLimitedBackOff),
+	}
+
+	if conf.NumRetries != nil {
+		policy.NumRetries = util_proto.UInt32(conf.NumRetries.Value)
+	}
+
+	if conf.BackOff != nil {
+		policy.RetryBackOff = &envoy_route.RetryPolicy_RetryBackOff{
+			BaseInterval: conf.BackOff.BaseInterval,
+			MaxInterval:  conf.BackOff.MaxInterval,
+		}
+	}
+
+	if conf.RetriableStatusCodes != nil {
+		policy.RetriableStatusCodes = conf.RetriableStatusCodes
+	}
+
+	for _, method := range conf.GetRetriableMethods() {
+		if method == mesh_proto.HttpMethod_NONE {
+			continue
+		}
+
+		policy.RetriableRequestHeaders = append(policy.RetriableRequestHeaders,
+			&envoy_route.HeaderMatcher{
+				Name:                 ":method",
+				HeaderMatchSpecifier: &envoy_route.HeaderMatcher_ExactMatch{ExactMatch: method.String()},
+				InvertMatch:          false,
+			})
+	}
+
+	return &policy
+}
@@ -1,11 +1,13 @@
 package v3
 
 import (
+	"math"
 	"sort"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -23,9 +25,10 @@ func (c RoutesConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error
 		envoyRoute := &envoy_route.Route{
 			Match: c.routeMatch(route.Match),
 			Action: &envoy_route.Route_Route{
-				Route: c.routeAction(route.Clusters, route.Modify),
+				Route: c.routeAction(route.Clusters, route.Modify, route.Mirror),
 			},
 		}
+		c.setRouteOverrides(envoyRoute.GetRoute(), route.Timeout, route.Retry)
 
 		typedPerFilterConfig, err := c.typedPerFilterConfig(&route)
 		if err != nil {
@@ -33,15 +36,22 @@ func (c RoutesConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error
 		}
 		envoyRoute.TypedPerFilterConfig = typedPerFilterConfig
 
-		c.setHeadersModifications(envoyRoute, route.Modify)
+		// Modifications of the TrafficRoute are applied last, so they take
+		// precedence over the ones of the HeaderModification policy.
+		c.setHeadersModifications(envoyRoute, route.HeaderModification.GetRequestHeaders(), route.HeaderModification.GetResponseHeaders())
+		c.setHeadersModifications(envoyRoute, route.Modify.GetRequestHeaders(), route.Modify.GetResponseHeaders())
 
 		virtualHost.Routes = append(virtualHost.Routes, envoyRoute)
 	}
 	return nil
 }
 
-func (c RoutesConfigurer) setHeadersModifications(route *envoy_route.Route, modify *mesh_proto.TrafficRoute_Http_Modify) {
-	for _, add := range modify.GetRequestHeaders().GetAdd() {
+func (c RoutesConfigurer) setHeadersModifications(
+	route *envoy_route.Route,
+	requestHeaders *mesh_proto.TrafficRoute_Http_Modify_Headers,
+	responseHeaders *mesh_proto.TrafficRoute_Http_Modify_Headers,
+) {
+	for _, add := range requestHeaders.GetAdd() {
 		route.RequestHeadersToAdd = append(route.RequestHeadersToAdd, &envoy_config_core_v3.HeaderValueOption{
 			Header: &envoy_config_core_v3.HeaderValue{
 				Key:   add.Name,
@@ -50,11 +60,11 @@ func (c RoutesConfigurer) setHeadersModifications(route *envoy_route.Route, modi
 			Append: util_proto.Bool(add.Append),
 		})
 	}
-	for _, remove := range modify.GetRequestHeaders().GetRemove() {
+	for _, remove := range requestHeaders.GetRemove() {
 		route.RequestHeadersToRemove = append(route.RequestHeadersToRemove, remove.Name)
 	}
 
-	for _, add := range modify.GetResponseHeaders().GetAdd() {
+	for _, add := range responseHeaders.GetAdd() {
 		route.ResponseHeadersToAdd = append(route.ResponseHeadersToAdd, &envoy_config_core_v3.HeaderValueOption{
 			Header: &envoy_config_core_v3.HeaderValue{
 				Key:   add.Name,
@@ -63,7 +73,7 @@ func (c RoutesConfigurer) setHeadersModifications(route *envoy_route.Route, modi
 			Append: util_proto.Bool(add.Append),
 		})
 	}
-	for _, remove := range modify.GetResponseHeaders().GetRemove() {
+	for _, remove := range responseHeaders.GetRemove() {
 		route.ResponseHeadersToRemove = append(route.ResponseHeadersToRemove, remove.Name)
 	}
 }
@@ -87,16 +97,18 @@ func (c RoutesConfigurer) routeMatch(match *mesh_proto.TrafficRoute_Http_Match)
 	}
 	sort.Strings(headers) // sort for stability of Envoy config
 	for _, headerName := range headers {
-		envoyMatch.Headers = append(envoyMatch.Headers, c.headerMatcher(headerName, match.Headers[headerName]))
+		envoyMatch.Headers = append(envoyMatch.Headers, NewHeaderMatcher(headerName, match.Headers[headerName]))
 	}
 	if match.GetMethod() != nil {
-		envoyMatch.Headers = append(envoyMatch.Headers, c.headerMatcher(":method", match.GetMethod()))
+		envoyMatch.Headers = append(envoyMatch.Headers, NewHeaderMatcher(":method", match.GetMethod()))
 	}
 
 	return envoyMatch
 }
 
-func (c RoutesConfigurer) headerMatcher(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
+// NewHeaderMatcher converts the string matcher of the header with the given name
+// to the Envoy header matcher.
+func NewHeaderMatcher(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
 	headerMatcher := &envoy_route.HeaderMatcher{
 		Name: name,
 	}
@@ -156,7 +168,7 @@ func (c RoutesConfigurer) hasExternal(clusters []envoy_common.Cluster) bool {
 	return false
 }
 
-func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *mesh_proto.TrafficRoute_Http_Modify) *envoy_route.RouteAction {
+func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *mesh_proto.TrafficRoute_Http_Modify, mirror *envoy_common.Mirror) *envoy_route.RouteAction {
 	routeAction := &envoy_route.RouteAction{}
 	if len(clusters) != 0 {
 		if timeout := clusters[0].Timeout(); timeout != nil {
@@ -164,6 +176,9 @@ func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *m
 		} else {
 			routeAction.Timeout = durationpb.New(0)
 		}
+		// All the clusters of the route come from the same TrafficRoute,
+		// so they share the load balancer configuration.
+		routeAction.HashPolicy = NewHashPolicies(clusters[0].LB().GetHashPolicies())
 	}
 	if len(clusters) == 1 {
 		routeAction.ClusterSpecifier = &envoy_route.RouteAction_Cluster{
@@ -192,9 +207,52 @@ func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *m
 		}
 	}
 	c.setModifications(routeAction, modify)
+	if mirror != nil {
+		routeAction.RequestMirrorPolicies = append(routeAction.RequestMirrorPolicies, c.requestMirrorPolicy(mirror))
+	}
 	return routeAction
 }
 
+// setRouteOverrides applies the timeouts and the retry policy of the
+// TrafficRoute HTTP rule. The route retry and hedge policies replace the ones
+// of the virtual host generated from the Retry policy.
+func (c RoutesConfigurer) setRouteOverrides(
+	routeAction *envoy_route.RouteAction,
+	timeout *mesh_proto.TrafficRoute_Http_Timeout,
+	retry *mesh_proto.Retry_Conf_Http,
+) {
+	if timeout.GetRequestTimeout() != nil {
+		routeAction.Timeout = timeout.GetRequestTimeout()
+	}
+	if timeout.GetStreamIdleTimeout() != nil {
+		routeAction.IdleTimeout = timeout.GetStreamIdleTimeout()
+	}
+	if retry != nil {
+		routeAction.RetryPolicy = NewHttpRetryPolicy(retry)
+		// The hedge policy of the route replaces the one of the virtual host,
+		// so it is always set to not hedge when the route retry doesn't.
+		routeAction.HedgePolicy = &envoy_route.HedgePolicy{
+			HedgeOnPerTryTimeout: retry.GetHedgeOnPerTryTimeout(),
+		}
+	}
+}
+
+func (c RoutesConfigurer) requestMirrorPolicy(mirror *envoy_common.Mirror) *envoy_route.RouteAction_RequestMirrorPolicy {
+	policy := &envoy_route.RouteAction_RequestMirrorPolicy{
+		Cluster: mirror.Cluster.Name(),
+	}
+	// When percentage is not specified, Envoy mirrors all the requests.
+	if mirror.Percentage != nil {
+		policy.RuntimeFraction = &envoy_config_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type.FractionalPercent{
+				Numerator:   uint32(math.Round(mirror.Percentage.GetValue() * 10000)),
+				Denominator: envoy_type.FractionalPercent_MILLION,
+			},
+		}
+	}
+	return policy
+}
+
 func (c RoutesConfigurer) setModifications(routeAction *envoy_route.RouteAction, modify *mesh_proto.TrafficRoute_Http_Modify) {
 	if modify.GetPath() != nil {
 		switch modify.GetPath().Type.(type) {
@@ -1,10 +1,14 @@
 package v3_test
 
 import (
+	"time"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
@@ -44,5 +48,220 @@ routes:
       timeout: "0s"
       cluster: backend`,
 		}),
+		Entry("route with mirror", testCase{
+			routes: []envoy_common.Route{
+				envoy_common.NewRoute(
+					envoy_common.WithCluster(envoy_common.NewCluster(envoy_common.WithName("backend"))),
+					envoy_common.WithMirror(envoy_common.NewCluster(envoy_common.WithName("backend-v2")), util_proto.Double(12.5)),
+				),
+				envoy_common.NewRoute(
+					envoy_common.WithCluster(envoy_common.NewCluster(envoy_common.WithName("backend"))),
+					envoy_common.WithMirror(envoy_common.NewCluster(envoy_common.WithName("backend-v3")), nil),
+				),
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      requestMirrorPolicies:
+      - cluster: backend-v2
+        runtimeFraction:
+          defaultValue:
+            numerator: 125000
+            denominator: MILLION
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      requestMirrorPolicies:
+      - cluster: backend-v3`,
+		}),
+		Entry("route with hash policies", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+						envoy_common.WithName("backend"),
+						envoy_common.WithLB(&mesh_proto.TrafficRoute_LoadBalancer{
+							LbType: &mesh_proto.TrafficRoute_LoadBalancer_RingHash_{
+								RingHash: &mesh_proto.TrafficRoute_LoadBalancer_RingHash{},
+							},
+							HashPolicies: []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy{
+								{
+									Type: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_{
+										Cookie: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie{
+											Name: "session",
+											Ttl:  util_proto.Duration(time.Hour),
+											Path: "/",
+										},
+									},
+									Terminal: true,
+								},
+								{
+									Type: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_{
+										Header: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header{
+											Name: "x-user-id",
+										},
+									},
+								},
+								{
+									Type: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_QueryParameter_{
+										QueryParameter: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_QueryParameter{
+											Name: "user",
+										},
+									},
+								},
+								{
+									Type: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_{
+										SourceIp: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp{},
+									},
+								},
+							},
+						}),
+					)},
+				},
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      hashPolicy:
+      - cookie:
+          name: session
+          ttl: 3600s
+          path: /
+        terminal: true
+      - header:
+          headerName: x-user-id
+      - queryParameter:
+          name: user
+      - connectionProperties:
+          sourceIp: true`,
+		}),
+		Entry("route with header modifications", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+					Modify: &mesh_proto.TrafficRoute_Http_Modify{
+						RequestHeaders: &mesh_proto.TrafficRoute_Http_Modify_Headers{
+							Add: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Add{
+								{Name: "x-route", Value: "route"},
+							},
+						},
+					},
+					HeaderModification: &mesh_proto.HeaderModification_Conf_Headers{
+						RequestHeaders: &mesh_proto.TrafficRoute_Http_Modify_Headers{
+							Add: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Add{
+								{Name: "x-route", Value: "policy"},
+								{Name: "x-source", Value: "web", Append: true},
+							},
+							Remove: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Remove{
+								{Name: "x-internal"},
+							},
+						},
+						ResponseHeaders: &mesh_proto.TrafficRoute_Http_Modify_Headers{
+							Remove: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Remove{
+								{Name: "server"},
+							},
+						},
+					},
+				},
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+    requestHeadersToAdd:
+    - append: false
+      header:
+        key: x-route
+        value: policy
+    - append: true
+      header:
+        key: x-source
+        value: web
+    - append: false
+      header:
+        key: x-route
+        value: route
+    requestHeadersToRemove:
+    - x-internal
+    responseHeadersToRemove:
+    - server`,
+		}),
+		Entry("route with timeout and retry overrides", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+						envoy_common.WithName("backend"),
+						envoy_common.WithTimeout(&core_mesh.TimeoutResource{
+							Spec: &mesh_proto.Timeout{
+								Conf: &mesh_proto.Timeout_Conf{
+									Http: &mesh_proto.Timeout_Conf_Http{
+										RequestTimeout: util_proto.Duration(5 * time.Second),
+									},
+								},
+							},
+						}),
+					)},
+					Timeout: &mesh_proto.TrafficRoute_Http_Timeout{
+						RequestTimeout:    util_proto.Duration(30 * time.Second),
+						StreamIdleTimeout: util_proto.Duration(10 * time.Second),
+					},
+					Retry: &mesh_proto.Retry_Conf_Http{
+						NumRetries:           util_proto.UInt32(2),
+						PerTryTimeout:        util_proto.Duration(15 * time.Second),
+						HedgeOnPerTryTimeout: true,
+					},
+				},
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      cluster: backend
+      hedgePolicy:
+        hedgeOnPerTryTimeout: true
+      idleTimeout: 10s
+      retryPolicy:
+        numRetries: 2
+        perTryTimeout: 15s
+        retryOn: gateway-error,connect-failure,refused-stream
+      timeout: 30s`,
+		}),
+		Entry("route with retry override that doesn't hedge", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+						envoy_common.WithName("backend"),
+					)},
+					Retry: &mesh_proto.Retry_Conf_Http{
+						NumRetries: util_proto.UInt32(2),
+					},
+				},
+			},
+			// the empty hedge policy disables the hedging of the virtual host
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      cluster: backend
+      hedgePolicy: {}
+      retryPolicy:
+        numRetries: 2
+        retryOn: gateway-error,connect-failure,refused-stream
+      timeout: 0s`,
+		}),
 	)
 })
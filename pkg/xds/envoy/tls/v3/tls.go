@@ -83,7 +83,7 @@ func NewSecretConfigSource(secretName string) *envoy_tls.SdsSecretConfig {
 	}
 }
 
-func UpstreamTlsContextOutsideMesh(ca, cert, key []byte, allowRenegotiation bool, hostname string, sni string) (*envoy_tls.UpstreamTlsContext, error) {
+func UpstreamTlsContextOutsideMesh(ca, cert, key []byte, allowRenegotiation bool, hostname string, sni string, systemCaPath string) (*envoy_tls.UpstreamTlsContext, error) {
 	tlsContext := &envoy_tls.UpstreamTlsContext{
 		AllowRenegotiation: allowRenegotiation,
 		Sni:                sni,
@@ -99,13 +99,25 @@ func UpstreamTlsContextOutsideMesh(ca, cert, key []byte, allowRenegotiation bool
 		}
 	}
 
-	if ca != nil {
+	var trustedCa *envoy_core.DataSource
+	switch {
+	case ca != nil:
+		trustedCa = dataSourceFromBytes(ca)
+	case systemCaPath != "":
+		trustedCa = &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_Filename{
+				Filename: systemCaPath,
+			},
+		}
+	}
+
+	if trustedCa != nil {
 		if tlsContext.CommonTlsContext == nil {
 			tlsContext.CommonTlsContext = &envoy_tls.CommonTlsContext{}
 		}
 		tlsContext.CommonTlsContext.ValidationContextType = &envoy_tls.CommonTlsContext_ValidationContext{
 			ValidationContext: &envoy_tls.CertificateValidationContext{
-				TrustedCa: dataSourceFromBytes(ca),
+				TrustedCa: trustedCa,
 				MatchSubjectAltNames: []*envoy_type_matcher.StringMatcher{
 					{
 						MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
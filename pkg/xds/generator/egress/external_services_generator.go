@@ -45,6 +45,7 @@ func (g *ExternalServicesGenerator) Generate(
 		apiVersion,
 		services,
 		endpointMap,
+		meshResources.ExternalServiceCircuitBreakers,
 		proxy.ZoneEgressProxy.ZoneEgressResource.IsIPv6(),
 	)
 	if err != nil {
@@ -60,6 +61,7 @@ func (*ExternalServicesGenerator) generateCDS(
 	apiVersion envoy_common.APIVersion,
 	services map[string]bool,
 	endpointMap core_xds.EndpointMap,
+	circuitBreakers core_xds.CircuitBreakerMap,
 	isIPV6 bool,
 ) ([]*core_xds.Resource, error) {
 	var resources []*core_xds.Resource
@@ -84,7 +86,9 @@ func (*ExternalServicesGenerator) generateCDS(
 				isIPV6,
 				endpoints...,
 			)).
-			Configure(envoy_clusters.ClientSideTLS(endpoints))
+			Configure(envoy_clusters.ClientSideTLS(endpoints)).
+			Configure(envoy_clusters.CircuitBreaker(circuitBreakers[serviceName])).
+			Configure(envoy_clusters.OutlierDetection(circuitBreakers[serviceName]))
 
 		switch endpoints[0].Tags[mesh_proto.ProtocolTag] {
 		case core_mesh.ProtocolHTTP:
@@ -118,6 +118,11 @@ func buildDestinations(
 				service := split.Destination[mesh_proto.ServiceTag]
 				destinations[service] = append(destinations[service], split.Destination)
 			}
+
+			if mirror := http.GetMirror(); mirror != nil {
+				service := mirror.Destination[mesh_proto.ServiceTag]
+				destinations[service] = append(destinations[service], mirror.Destination)
+			}
 		}
 	}
 
@@ -48,6 +48,7 @@ var _ = Describe("EgressGenerator", func() {
 			var zoneEgress *core_mesh.ZoneEgressResource
 			var zoneIngresses []*core_mesh.ZoneIngressResource
 			var trafficPermissions []*core_mesh.TrafficPermissionResource
+			var circuitBreakers []*core_mesh.CircuitBreakerResource
 
 			meshResourcesMap := map[string]*core_xds.MeshResources{}
 
@@ -76,6 +77,8 @@ var _ = Describe("EgressGenerator", func() {
 					zoneIngresses = append(zoneIngresses, res.(*core_mesh.ZoneIngressResource))
 				case core_mesh.TrafficPermissionType:
 					trafficPermissions = append(trafficPermissions, res.(*core_mesh.TrafficPermissionResource))
+				case core_mesh.CircuitBreakerType:
+					circuitBreakers = append(circuitBreakers, res.(*core_mesh.CircuitBreakerResource))
 				case core_mesh.MeshType:
 					meshName := res.GetMeta().GetName()
 
@@ -122,6 +125,11 @@ var _ = Describe("EgressGenerator", func() {
 					meshResources.ExternalServices,
 					trafficPermissions,
 				)
+
+				meshResources.ExternalServiceCircuitBreakers = xds_topology.BuildExternalServiceCircuitBreakerMapForZoneEgress(
+					meshResources.ExternalServices,
+					circuitBreakers,
+				)
 			}
 
 			gen := egress.Generator{
@@ -189,5 +197,9 @@ var _ = Describe("EgressGenerator", func() {
 			fileWithResourcesName: "06.mixed-services-with-external-in-other-zone.yaml",
 			expected:              "06.mixed-services-with-external-in-other-zone.golden.yaml",
 		}),
+		Entry("07. externalservice with circuitbreaker", testCase{
+			fileWithResourcesName: "07.externalservice-with-circuitbreaker.yaml",
+			expected:              "07.externalservice-with-circuitbreaker.golden.yaml",
+		}),
 	)
 })
@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// interpolateHeaderModification returns a copy of the given header
+// modifications with the placeholders in the header values replaced.
+// The source service is only known for the outbound traffic, so it is
+// left empty for the inbound one.
+func interpolateHeaderModification(
+	headers *mesh_proto.HeaderModification_Conf_Headers,
+	mesh string,
+	sourceService string,
+	destinationService string,
+) *mesh_proto.HeaderModification_Conf_Headers {
+	if headers == nil {
+		return nil
+	}
+
+	placeholders := []string{
+		"%KUMA_MESH%", mesh,
+		"%KUMA_DESTINATION_SERVICE%", destinationService,
+	}
+	if sourceService != "" {
+		placeholders = append(placeholders, "%KUMA_SOURCE_SERVICE%", sourceService)
+	}
+	replacer := strings.NewReplacer(placeholders...)
+
+	result := proto.Clone(headers).(*mesh_proto.HeaderModification_Conf_Headers)
+	for _, add := range result.GetRequestHeaders().GetAdd() {
+		add.Value = replacer.Replace(add.Value)
+	}
+	for _, add := range result.GetResponseHeaders().GetAdd() {
+		add.Value = replacer.Replace(add.Value)
+	}
+	return result
+}
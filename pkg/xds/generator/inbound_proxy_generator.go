@@ -55,6 +55,16 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 		cluster := envoy_common.NewCluster(envoy_common.WithService(localClusterName))
 		routes := envoy_common.Routes{}
 
+		var headerModification *mesh_proto.HeaderModification_Conf_Headers
+		if policy := proxy.Policies.HeaderModificationsInbound[endpoint]; policy != nil {
+			headerModification = interpolateHeaderModification(
+				policy.Spec.GetConf().GetInbound(),
+				proxy.Dataplane.Meta.GetMesh(),
+				"",
+				iface.GetService(),
+			)
+		}
+
 		// Iterate over that RateLimits and generate the relevant Routes.
 		// We do assume that the rateLimits resource is sorted, so the most
 		// specific source matches come first.
@@ -67,11 +77,15 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 				envoy_common.WithCluster(cluster),
 				envoy_common.WithMatchHeaderRegex(envoy_routes.TagsHeaderName, tags.MatchSourceRegex(rl)),
 				envoy_common.WithRateLimit(rl.Spec),
+				envoy_common.WithHeaderModification(headerModification),
 			))
 		}
 
 		// Add the default fall-back route
-		routes = append(routes, envoy_common.NewRoute(envoy_common.WithCluster(cluster)))
+		routes = append(routes, envoy_common.NewRoute(
+			envoy_common.WithCluster(cluster),
+			envoy_common.WithHeaderModification(headerModification),
+		))
 
 		// generate LDS resource
 		service := iface.GetService()
@@ -85,16 +99,22 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimitsInbound[endpoint])).
+					Configure(envoy_listeners.AdaptiveConcurrency(proxy.Policies.AdaptiveConcurrencies[endpoint])).
+					Configure(envoy_listeners.Compression(proxy.Policies.Compressions[endpoint])).
 					Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.Cors(proxy.Policies.CorsPolicies[endpoint]))
 			case core_mesh.ProtocolGRPC:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
 					Configure(envoy_listeners.GrpcStats()).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimitsInbound[endpoint])).
+					Configure(envoy_listeners.AdaptiveConcurrency(proxy.Policies.AdaptiveConcurrencies[endpoint])).
+					Configure(envoy_listeners.Compression(proxy.Policies.Compressions[endpoint])).
 					Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.Cors(proxy.Policies.CorsPolicies[endpoint]))
 			case core_mesh.ProtocolKafka:
 				filterChainBuilder.
 					Configure(envoy_listeners.Kafka(localClusterName)).
@@ -123,6 +123,10 @@ func (_ IngressGenerator) destinations(trs *core_mesh.TrafficRouteResourceList,
 				service := split.Destination[mesh_proto.ServiceTag]
 				destinations[service] = append(destinations[service], split.Destination)
 			}
+			if mirror := http.GetMirror(); mirror != nil {
+				service := mirror.Destination[mesh_proto.ServiceTag]
+				destinations[service] = append(destinations[service], mirror.Destination)
+			}
 		}
 	}
 
@@ -13,6 +13,7 @@ import (
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_clusters "github.com/kumahq/kuma/pkg/xds/envoy/clusters"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	envoy_listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
@@ -51,6 +52,8 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	// If we have same split in many HTTP matches we can use the same cluster with different weight
 	clusterCache := map[string]string{}
 	splitCounter := &splitCounter{}
+	// The cluster which aborts the connections is only needed when a TCP fault aborts some of them.
+	tcpFaultAbortInjected := false
 
 	for _, outbound := range outbounds {
 		// Determine the list of destination subsets
@@ -58,8 +61,17 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		routes := g.determineRoutes(proxy, outbound, clusterCache, splitCounter, ctx.Mesh.Resource.ZoneEgressEnabled())
 		clusters := routes.Clusters()
 		servicesAcc.Add(clusters...)
+		servicesAcc.Add(routes.MirrorClusters()...)
 
 		protocol := g.inferProtocol(proxy, clusters)
+		switch protocol {
+		case core_mesh.ProtocolGRPC, core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
+		default:
+			oface := proxy.Dataplane.Spec.Networking.ToOutboundInterface(outbound)
+			if envoy_listeners_v3.TcpFaultAbortWeight(proxy.Policies.TcpFaultInjections[oface]) > 0 {
+				tcpFaultAbortInjected = true
+			}
+		}
 
 		// Generate listener
 		listener, err := g.generateLDS(ctx, proxy, routes, outbound, protocol)
@@ -88,6 +100,20 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	}
 	resources.AddSet(edsResources)
 
+	if tcpFaultAbortInjected {
+		abortCluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(envoy_clusters.BlackHoleCluster(envoy_names.GetFaultInjectionAbortClusterName())).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&model.Resource{
+			Name:     abortCluster.GetName(),
+			Origin:   OriginOutbound,
+			Resource: abortCluster,
+		})
+	}
+
 	return resources, nil
 }
 
@@ -140,6 +166,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 			filterChainBuilder.
 				Configure(envoy_listeners.Kafka(serviceName)).
 				Configure(envoy_listeners.TcpProxy(serviceName, routes.Clusters()...)).
+				Configure(envoy_listeners.TcpFaultInjection(proxy.Policies.TcpFaultInjections[oface])).
 				Configure(envoy_listeners.NetworkAccessLog(
 					meshName,
 					envoy_common.TrafficDirectionOutbound,
@@ -156,6 +183,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 			// configuration for non-HTTP cases
 			filterChainBuilder.
 				Configure(envoy_listeners.TcpProxy(serviceName, routes.Clusters()...)).
+				Configure(envoy_listeners.TcpFaultInjection(proxy.Policies.TcpFaultInjections[oface])).
 				Configure(envoy_listeners.NetworkAccessLog(
 					meshName,
 					envoy_common.TrafficDirectionOutbound,
@@ -190,6 +218,7 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 		service := services[serviceName]
 		healthCheck := proxy.Policies.HealthChecks[serviceName]
 		circuitBreaker := proxy.Policies.CircuitBreakers[serviceName]
+		retry := proxy.Policies.Retries[serviceName]
 		protocol := g.inferProtocol(proxy, service.Clusters())
 		tlsReady := service.TLSReady()
 
@@ -197,6 +226,7 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 			edsClusterBuilder := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
 				Configure(envoy_clusters.Timeout(protocol, cluster.Timeout())).
 				Configure(envoy_clusters.CircuitBreaker(circuitBreaker)).
+				Configure(envoy_clusters.RetryBudget(retry)).
 				Configure(envoy_clusters.OutlierDetection(circuitBreaker)).
 				Configure(envoy_clusters.HealthCheck(protocol, healthCheck))
 
@@ -222,6 +252,8 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 						Configure(envoy_clusters.ClientSideTLS(endpoints))
 				}
 
+				edsClusterBuilder.Configure(envoy_clusters.LB(cluster.LB()))
+
 				switch protocol {
 				case core_mesh.ProtocolHTTP:
 					edsClusterBuilder.Configure(envoy_clusters.Http())
@@ -312,45 +344,61 @@ func (OutboundProxyGenerator) determineRoutes(
 
 	timeoutConf := proxy.Policies.Timeouts[oface]
 
+	var headerModification *mesh_proto.HeaderModification_Conf_Headers
+	if policy := proxy.Policies.HeaderModificationsOutbound[oface]; policy != nil {
+		headerModification = interpolateHeaderModification(
+			policy.Spec.GetConf().GetOutbound(),
+			proxy.Dataplane.Meta.GetMesh(),
+			proxy.Dataplane.Spec.GetIdentifyingService(),
+			outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag],
+		)
+	}
+
+	clusterFromDestination := func(destination map[string]string, weight uint32) (envoy_common.Cluster, bool) {
+		service := destination[mesh_proto.ServiceTag]
+
+		name := service
+		if len(destination) > 1 {
+			name = envoy_names.GetSplitClusterName(service, splitCounter.getAndIncrement())
+		}
+
+		// We assume that all the targets are either ExternalServices or not
+		// therefore we check only the first one
+		var isExternalService bool
+		if endpoints := proxy.Routing.OutboundTargets[service]; len(endpoints) > 0 {
+			isExternalService = endpoints[0].IsExternalService()
+		}
+
+		cluster := envoy_common.NewCluster(
+			envoy_common.WithService(service),
+			envoy_common.WithName(name),
+			envoy_common.WithWeight(weight),
+			envoy_common.WithTags(destination),
+			envoy_common.WithTimeout(timeoutConf),
+			envoy_common.WithLB(route.Spec.GetConf().GetLoadBalancer()),
+			envoy_common.WithExternalService(isExternalService),
+		)
+
+		if name, ok := clusterCache[cluster.Tags().String()]; ok {
+			cluster.SetName(name)
+		} else {
+			clusterCache[cluster.Tags().String()] = cluster.Name()
+		}
+
+		return cluster, isExternalService
+	}
+
 	// Return internal, external
 	clustersFromSplit := func(splits []*mesh_proto.TrafficRoute_Split) ([]envoy_common.Cluster, []envoy_common.Cluster) {
 		var clustersInternal []envoy_common.Cluster
 		var clustersExternal []envoy_common.Cluster
 		for _, destination := range splits {
-			service := destination.Destination[mesh_proto.ServiceTag]
 			if destination.GetWeight().GetValue() == 0 {
 				// 0 assumes no traffic is passed there. Envoy doesn't support 0 weight, so instead of passing it to Envoy we just skip such cluster.
 				continue
 			}
 
-			name := service
-			if len(destination.GetDestination()) > 1 {
-				name = envoy_names.GetSplitClusterName(service, splitCounter.getAndIncrement())
-			}
-
-			// We assume that all the targets are either ExternalServices or not
-			// therefore we check only the first one
-			var isExternalService bool
-			if endpoints := proxy.Routing.OutboundTargets[service]; len(endpoints) > 0 {
-				isExternalService = endpoints[0].IsExternalService()
-			}
-
-			cluster := envoy_common.NewCluster(
-				envoy_common.WithService(service),
-				envoy_common.WithName(name),
-				envoy_common.WithWeight(destination.GetWeight().GetValue()),
-				envoy_common.WithTags(destination.Destination),
-				envoy_common.WithTimeout(timeoutConf),
-				envoy_common.WithLB(route.Spec.GetConf().GetLoadBalancer()),
-				envoy_common.WithExternalService(isExternalService),
-			)
-
-			if name, ok := clusterCache[cluster.Tags().String()]; ok {
-				cluster.SetName(name)
-			} else {
-				clusterCache[cluster.Tags().String()] = cluster.Name()
-			}
-
+			cluster, isExternalService := clusterFromDestination(destination.Destination, destination.GetWeight().GetValue())
 			if isExternalService {
 				clustersExternal = append(clustersExternal, cluster)
 			} else {
@@ -360,8 +408,19 @@ func (OutboundProxyGenerator) determineRoutes(
 		return clustersInternal, clustersExternal
 	}
 
-	appendRoute := func(routes envoy_common.Routes, match *mesh_proto.TrafficRoute_Http_Match, modify *mesh_proto.TrafficRoute_Http_Modify,
-		clusters []envoy_common.Cluster, rateLimit *core_mesh.RateLimitResource) envoy_common.Routes {
+	mirrorFromHttp := func(mirror *mesh_proto.TrafficRoute_Http_Mirror) *envoy_common.Mirror {
+		if mirror == nil {
+			return nil
+		}
+		cluster, _ := clusterFromDestination(mirror.GetDestination(), 1)
+		return &envoy_common.Mirror{
+			Cluster:    cluster,
+			Percentage: mirror.GetPercentage(),
+		}
+	}
+
+	appendRoute := func(routes envoy_common.Routes, http *mesh_proto.TrafficRoute_Http,
+		mirror *envoy_common.Mirror, clusters []envoy_common.Cluster, rateLimit *core_mesh.RateLimitResource) envoy_common.Routes {
 		if len(clusters) == 0 {
 			return routes
 		}
@@ -369,9 +428,13 @@ func (OutboundProxyGenerator) determineRoutes(
 		// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 		if hasEgress {
 			return append(routes, envoy_common.Route{
-				Match:    match,
-				Modify:   modify,
-				Clusters: clusters,
+				Match:              http.GetMatch(),
+				Modify:             http.GetModify(),
+				Mirror:             mirror,
+				Clusters:           clusters,
+				HeaderModification: headerModification,
+				Timeout:            http.GetTimeout(),
+				Retry:              http.GetRetry(),
 			})
 		} else {
 			var rlSpec *mesh_proto.RateLimit
@@ -379,18 +442,23 @@ func (OutboundProxyGenerator) determineRoutes(
 				rlSpec = rateLimit.Spec
 			}
 			return append(routes, envoy_common.Route{
-				Match:     match,
-				Modify:    modify,
-				RateLimit: rlSpec,
-				Clusters:  clusters,
+				Match:              http.GetMatch(),
+				Modify:             http.GetModify(),
+				RateLimit:          rlSpec,
+				Mirror:             mirror,
+				Clusters:           clusters,
+				HeaderModification: headerModification,
+				Timeout:            http.GetTimeout(),
+				Retry:              http.GetRetry(),
 			})
 		}
 	}
 
 	for _, http := range route.Spec.GetConf().GetHttp() {
 		clustersInternal, clustersExternal := clustersFromSplit(http.GetSplitWithDestination())
-		routes = appendRoute(routes, http.Match, http.Modify, clustersInternal, nil)
-		routes = appendRoute(routes, http.Match, http.Modify, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
+		mirror := mirrorFromHttp(http.GetMirror())
+		routes = appendRoute(routes, http, mirror, clustersInternal, nil)
+		routes = appendRoute(routes, http, mirror, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
 	}
 
 	if defaultDestination := route.Spec.GetConf().GetSplitWithDestination(); len(defaultDestination) != 0 {
@@ -259,6 +259,42 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								},
 							},
 						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 40005,
+						}: &core_mesh.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Http: []*mesh_proto.TrafficRoute_Http{{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/v2",
+												},
+											},
+										},
+										Destination: mesh_proto.MatchService("api-http"),
+										Mirror: &mesh_proto.TrafficRoute_Http_Mirror{
+											Destination: mesh_proto.TagSelector{"kuma.io/service": "api-http", "region": "eu"},
+											Percentage:  util_proto.Double(12.5),
+										},
+									}, {
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/v3",
+												},
+											},
+										},
+										Destination: mesh_proto.MatchService("api-http"),
+										Mirror: &mesh_proto.TrafficRoute_Http_Mirror{
+											Destination: mesh_proto.MatchService("api-http2"),
+										},
+									}},
+									Destination: mesh_proto.MatchService("api-http"),
+								},
+							},
+						},
 						mesh_proto.OutboundInterface{
 							DataplaneIP:   "127.0.0.1",
 							DataplanePort: 18080,
@@ -309,6 +345,16 @@ var _ = Describe("OutboundProxyGenerator", func() {
 							Spec: &mesh_proto.TrafficRoute{
 								Conf: &mesh_proto.TrafficRoute_Conf{
 									Destination: mesh_proto.TagSelector{"kuma.io/service": "es2", "kuma.io/protocol": "http2"},
+									LoadBalancer: &mesh_proto.TrafficRoute_LoadBalancer{
+										LbType: &mesh_proto.TrafficRoute_LoadBalancer_Maglev_{
+											Maglev: &mesh_proto.TrafficRoute_LoadBalancer_Maglev{},
+										},
+										HashPolicies: []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy{{
+											Type: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_{
+												SourceIp: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp{},
+											},
+										}},
+									},
 								},
 							},
 						},
@@ -577,6 +623,24 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "08.envoy.golden.yaml",
 		}),
+		Entry("09. outbound with mirrored HTTP traffic", testCase{
+			ctx: mtlsCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40005
+                service: api-http
+              transparentProxying:
+                redirectPortOutbound: 15001
+                redirectPortInbound: 15006
+`,
+			expected: "09.envoy.golden.yaml",
+		}),
 	)
 
 	It("Add sanitized alternative cluster name for stats", func() {
@@ -665,4 +729,90 @@ var _ = Describe("OutboundProxyGenerator", func() {
 		// and output matches golden files
 		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "outbound-proxy", "cluster-dots.envoy.golden.yaml")))
 	})
+
+	DescribeTable("should generate the cluster which aborts the TCP connections only when needed",
+		func(percentage float64, expected bool) {
+			// setup
+			gen := &generator.OutboundProxyGenerator{}
+			dp := `
+            networking:
+              outbound:
+              - port: 54321
+                service: db`
+
+			dataplane := &mesh_proto.Dataplane{}
+			Expect(util_proto.FromYAML([]byte(dp), dataplane)).To(Succeed())
+
+			outboundTargets := model.EndpointMap{
+				"db": []model.Endpoint{
+					{
+						Target: "192.168.0.2",
+						Port:   5432,
+						Tags:   map[string]string{"kuma.io/service": "db", "kuma.io/protocol": "tcp"},
+						Weight: 1,
+					},
+				},
+			}
+			oface := mesh_proto.OutboundInterface{
+				DataplaneIP:   "127.0.0.1",
+				DataplanePort: 54321,
+			}
+			proxy := &model.Proxy{
+				Id: *model.BuildProxyId("default", "side-car"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Version: "1",
+					},
+					Spec: dataplane,
+				},
+				APIVersion: envoy_common.APIV3,
+				Routing: model.Routing{
+					TrafficRoutes: model.RouteMap{
+						oface: &core_mesh.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("db"),
+								},
+							},
+						},
+					},
+					OutboundTargets: outboundTargets,
+				},
+				Policies: model.MatchedPolicies{
+					TcpFaultInjections: model.TcpFaultInjectionMap{
+						oface: &core_mesh.FaultInjectionResource{
+							Spec: &mesh_proto.FaultInjection{
+								Conf: &mesh_proto.FaultInjection_Conf{
+									Tcp: &mesh_proto.FaultInjection_Conf_Tcp{
+										Abort: &mesh_proto.FaultInjection_Conf_Tcp_ConnectionAbort{
+											Percentage: util_proto.Double(percentage),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Metadata: &model.DataplaneMetadata{},
+			}
+
+			// when
+			plainCtx.ControlPlane.CLACache = &dummyCLACache{outboundTargets: outboundTargets}
+			rs, err := gen.Generate(plainCtx, proxy)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			var names []string
+			for _, resource := range rs.List() {
+				names = append(names, resource.Name)
+			}
+			if expected {
+				Expect(names).To(ContainElement("kuma:fault_injection:abort"))
+			} else {
+				Expect(names).ToNot(ContainElement("kuma:fault_injection:abort"))
+			}
+		},
+		Entry("when some connections are aborted", 50.0, true),
+		Entry("when no connection is aborted", 0.0, false),
+	)
 })
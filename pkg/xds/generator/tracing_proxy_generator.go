@@ -27,8 +27,20 @@ func (t TracingProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 	if tracingBackend == nil {
 		return nil, nil
 	}
+	res, err := t.GenerateCluster(proxy, tracingBackend)
+	if err != nil {
+		return nil, err
+	}
 	resources = core_xds.NewResourceSet()
+	resources.Add(res)
+	return resources, nil
+}
+
+// GenerateCluster generates the cluster that the proxy sends the traces
+// of the given tracing backend to.
+func (t TracingProxyGenerator) GenerateCluster(proxy *core_xds.Proxy, tracingBackend *mesh_proto.TracingBackend) (*core_xds.Resource, error) {
 	var endpoint *core_xds.Endpoint
+	var err error
 	switch tracingBackend.Type {
 	case mesh_proto.TracingZipkinType:
 		cfg := mesh_proto.ZipkinTracingBackendConfig{}
@@ -58,8 +70,7 @@ func (t TracingProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 	if err != nil {
 		return nil, err
 	}
-	resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginTracing, Resource: res})
-	return resources, nil
+	return &core_xds.Resource{Name: clusterName, Origin: OriginTracing, Resource: res}, nil
 }
 
 func (t TracingProxyGenerator) endpointForZipkin(cfg *mesh_proto.ZipkinTracingBackendConfig) (*core_xds.Endpoint, error) {
@@ -21,8 +21,9 @@ import (
 var (
 	// HashMeshExcludedResources defines Mesh-scoped resources that are not used in XDS therefore when counting hash mesh we can skip them
 	HashMeshExcludedResources = map[core_model.ResourceType]bool{
-		core_mesh.DataplaneInsightType:  true,
-		core_mesh.DataplaneOverviewType: true,
+		core_mesh.DataplaneInsightType:    true,
+		core_mesh.DataplaneOverviewType:   true,
+		core_mesh.TrafficRouteInsightType: true,
 	}
 	HashMeshIncludedGlobalResources = map[core_model.ResourceType]bool{
 		core_system.ConfigType:       true,
@@ -137,6 +137,7 @@ func (p *DataplaneProxyBuilder) matchPolicies(meshContext xds_context.MeshContex
 
 	resources := meshContext.Resources
 	ratelimits := ratelimits.BuildRateLimitMap(dataplane, inbounds, resources.RateLimits().Items)
+	headerModifications := xds_topology.BuildHeaderModificationMaps(dataplane, inbounds, resources.HeaderModifications().Items)
 	matchedPolicies := &xds.MatchedPolicies{
 		TrafficPermissions: permissions.BuildTrafficPermissionMap(dataplane, inbounds, resources.TrafficPermissions().Items),
 		TrafficLogs:        logs.BuildTrafficLogMap(dataplane, resources.TrafficLogs().Items),
@@ -148,7 +149,14 @@ func (p *DataplaneProxyBuilder) matchPolicies(meshContext xds_context.MeshContex
 		Timeouts:           xds_topology.BuildTimeoutMap(dataplane, resources.Timeouts().Items),
 		RateLimitsInbound:  ratelimits.Inbound,
 		RateLimitsOutbound: ratelimits.Outbound,
+		Compressions:       xds_topology.BuildCompressionMap(dataplane, inbounds, resources.Compressions().Items),
+		CorsPolicies:       xds_topology.BuildCorsPolicyMap(dataplane, inbounds, resources.CorsPolicies().Items),
 		ProxyTemplate:      template.SelectProxyTemplate(dataplane, resources.ProxyTemplates().Items),
+
+		HeaderModificationsInbound:  headerModifications.Inbound,
+		HeaderModificationsOutbound: headerModifications.Outbound,
+		TcpFaultInjections:          faultinjections.BuildTcpFaultInjectionMap(dataplane, resources.FaultInjections().Items),
+		AdaptiveConcurrencies:       xds_topology.BuildAdaptiveConcurrencyMap(dataplane, inbounds, resources.AdaptiveConcurrencies().Items),
 	}
 	return matchedPolicies, nil
 }
@@ -94,6 +94,7 @@ func (p *EgressProxyBuilder) Build(
 		externalServices := meshCtx.Resources.ExternalServices().Items
 		faultInjections := meshCtx.Resources.FaultInjections().Items
 		rateLimits := meshCtx.Resources.RateLimits().Items
+		circuitBreakers := meshCtx.Resources.CircuitBreakers().Items
 
 		// It's done for achieving stable xds config
 		sort.Slice(externalServices, func(a, b int) bool {
@@ -123,6 +124,10 @@ func (p *EgressProxyBuilder) Build(
 				externalServices,
 				rateLimits,
 			),
+			ExternalServiceCircuitBreakers: xds_topology.BuildExternalServiceCircuitBreakerMapForZoneEgress(
+				externalServices,
+				circuitBreakers,
+			),
 		}
 
 		meshResourcesList = append(meshResourcesList, meshResources)
@@ -0,0 +1,27 @@
+package topology
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildAdaptiveConcurrencyMap picks a single the most specific AdaptiveConcurrency for each inbound interface of a given Dataplane.
+func BuildAdaptiveConcurrencyMap(
+	dataplane *core_mesh.DataplaneResource,
+	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
+	adaptiveConcurrencies []*core_mesh.AdaptiveConcurrencyResource,
+) core_xds.AdaptiveConcurrencyMap {
+	policies := make([]core_policy.ConnectionPolicy, len(adaptiveConcurrencies))
+	for i, adaptiveConcurrency := range adaptiveConcurrencies {
+		policies[i] = adaptiveConcurrency
+	}
+	policyMap := core_policy.SelectInboundConnectionPolicies(dataplane, inbounds, policies)
+
+	adaptiveConcurrencyMap := core_xds.AdaptiveConcurrencyMap{}
+	for inbound, policy := range policyMap {
+		adaptiveConcurrencyMap[inbound] = policy.(*core_mesh.AdaptiveConcurrencyResource)
+	}
+	return adaptiveConcurrencyMap
+}
@@ -0,0 +1,107 @@
+package topology_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	. "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+var _ = Describe("BuildAdaptiveConcurrencyMap()", func() {
+
+	It("should pick the best matching AdaptiveConcurrency for each inbound interface", func() {
+		// given
+		dataplane := &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh: "demo",
+				Name: "backend",
+			},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Tags:        map[string]string{"kuma.io/service": "backend", "kuma.io/protocol": "http"},
+							Port:        8080,
+							ServicePort: 18080,
+						},
+						{
+							Tags:        map[string]string{"kuma.io/service": "frontend", "kuma.io/protocol": "http"},
+							Port:        7070,
+							ServicePort: 17070,
+						},
+						{
+							Tags:        map[string]string{"kuma.io/service": "redis", "kuma.io/protocol": "tcp"},
+							Port:        6379,
+							ServicePort: 16379,
+						},
+					},
+				},
+			},
+		}
+		wildcard := &core_mesh.AdaptiveConcurrencyResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh:         "demo",
+				Name:         "wildcard",
+				CreationTime: time.Unix(0, 0),
+			},
+			Spec: &mesh_proto.AdaptiveConcurrency{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchAnyService()},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": "*", "kuma.io/protocol": "http"}},
+				},
+				Conf: &mesh_proto.AdaptiveConcurrency_Conf{},
+			},
+		}
+		backend := &core_mesh.AdaptiveConcurrencyResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh:         "demo",
+				Name:         "backend",
+				CreationTime: time.Unix(1, 0),
+			},
+			Spec: &mesh_proto.AdaptiveConcurrency{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchAnyService()},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": "backend", "kuma.io/protocol": "http"}},
+				},
+				Conf: &mesh_proto.AdaptiveConcurrency_Conf{
+					GradientController: &mesh_proto.AdaptiveConcurrency_Conf_GradientController{
+						SampleAggregatePercentile: util_proto.Double(90),
+					},
+				},
+			},
+		}
+
+		// when
+		adaptiveConcurrencies := BuildAdaptiveConcurrencyMap(dataplane, dataplane.Spec.Networking.Inbound, []*core_mesh.AdaptiveConcurrencyResource{wildcard, backend})
+
+		// then
+		Expect(adaptiveConcurrencies).To(Equal(core_xds.AdaptiveConcurrencyMap{
+			mesh_proto.InboundInterface{
+				DataplaneAdvertisedIP: "192.168.0.1",
+				DataplaneIP:           "192.168.0.1",
+				DataplanePort:         8080,
+				WorkloadIP:            "127.0.0.1",
+				WorkloadPort:          18080,
+			}: backend,
+			mesh_proto.InboundInterface{
+				DataplaneAdvertisedIP: "192.168.0.1",
+				DataplaneIP:           "192.168.0.1",
+				DataplanePort:         7070,
+				WorkloadIP:            "127.0.0.1",
+				WorkloadPort:          17070,
+			}: wildcard,
+		}))
+	})
+})
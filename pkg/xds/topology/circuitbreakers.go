@@ -3,6 +3,7 @@ package topology
 import (
 	"context"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/policy"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -40,3 +41,29 @@ func BuildCircuitBreakerMap(dataplane *core_mesh.DataplaneResource, destinations
 	}
 	return circuitBreakerMap
 }
+
+// BuildExternalServiceCircuitBreakerMapForZoneEgress creates a map with circuit-breaking configuration
+// per External Service. Zone Egress cannot tell which data plane proxy the request is coming from,
+// so only the destination selectors of CircuitBreakers are taken into account.
+func BuildExternalServiceCircuitBreakerMapForZoneEgress(
+	externalServices []*core_mesh.ExternalServiceResource,
+	circuitBreakers []*core_mesh.CircuitBreakerResource,
+) core_xds.CircuitBreakerMap {
+	policies := make([]policy.ConnectionPolicy, len(circuitBreakers))
+	for i, circuitBreaker := range circuitBreakers {
+		policies[i] = circuitBreaker
+	}
+
+	result := core_xds.CircuitBreakerMap{}
+	for _, externalService := range externalServices {
+		tags := externalService.Spec.GetTags()
+		serviceName := tags[mesh_proto.ServiceTag]
+
+		matchedPolicy := policy.SelectInboundConnectionPolicy(tags, policies)
+		if matchedPolicy != nil {
+			result[serviceName] = matchedPolicy.(*core_mesh.CircuitBreakerResource)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package topology
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildCompressionMap picks a single the most specific compression for each inbound interface of a given Dataplane.
+func BuildCompressionMap(
+	dataplane *core_mesh.DataplaneResource,
+	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
+	compressions []*core_mesh.CompressionResource,
+) core_xds.CompressionMap {
+	policies := make([]core_policy.ConnectionPolicy, len(compressions))
+	for i, compression := range compressions {
+		policies[i] = compression
+	}
+	policyMap := core_policy.SelectInboundConnectionPolicies(dataplane, inbounds, policies)
+
+	compressionMap := core_xds.CompressionMap{}
+	for inbound, policy := range policyMap {
+		compressionMap[inbound] = policy.(*core_mesh.CompressionResource)
+	}
+	return compressionMap
+}
@@ -0,0 +1,27 @@
+package topology
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildCorsPolicyMap picks a single the most specific CORS policy for each inbound interface of a given Dataplane.
+func BuildCorsPolicyMap(
+	dataplane *core_mesh.DataplaneResource,
+	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
+	corsPolicies []*core_mesh.CorsPolicyResource,
+) core_xds.CorsPolicyMap {
+	policies := make([]core_policy.ConnectionPolicy, len(corsPolicies))
+	for i, corsPolicy := range corsPolicies {
+		policies[i] = corsPolicy
+	}
+	policyMap := core_policy.SelectInboundConnectionPolicies(dataplane, inbounds, policies)
+
+	corsPolicyMap := core_xds.CorsPolicyMap{}
+	for inbound, policy := range policyMap {
+		corsPolicyMap[inbound] = policy.(*core_mesh.CorsPolicyResource)
+	}
+	return corsPolicyMap
+}
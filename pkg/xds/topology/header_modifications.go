@@ -0,0 +1,47 @@
+package topology
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildHeaderModificationMaps picks a single the most specific header modification for each inbound and outbound
+// interface of a given Dataplane. Only the policies that define modifications for the given direction are taken
+// into account, so a policy without inbound modifications doesn't shadow a less specific one that has them.
+func BuildHeaderModificationMaps(
+	dataplane *core_mesh.DataplaneResource,
+	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
+	headerModifications []*core_mesh.HeaderModificationResource,
+) core_xds.HeaderModificationsMap {
+	var inboundPolicies, outboundPolicies []core_policy.ConnectionPolicy
+	for _, headerModification := range headerModifications {
+		if headerModification.Spec.GetConf().GetInbound() != nil {
+			inboundPolicies = append(inboundPolicies, headerModification)
+		}
+		if headerModification.Spec.GetConf().GetOutbound() != nil {
+			outboundPolicies = append(outboundPolicies, headerModification)
+		}
+	}
+
+	result := core_xds.HeaderModificationsMap{
+		Inbound:  core_xds.InboundHeaderModificationMap{},
+		Outbound: core_xds.OutboundHeaderModificationMap{},
+	}
+
+	for inbound, policy := range core_policy.SelectInboundConnectionPolicies(dataplane, inbounds, inboundPolicies) {
+		result.Inbound[inbound] = policy.(*core_mesh.HeaderModificationResource)
+	}
+
+	outboundMap := core_policy.SelectOutboundConnectionPolicies(dataplane, outboundPolicies)
+	for _, oface := range dataplane.Spec.Networking.GetOutbound() {
+		serviceName := oface.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]
+		if policy, exists := outboundMap[serviceName]; exists {
+			outbound := dataplane.Spec.Networking.ToOutboundInterface(oface)
+			result.Outbound[outbound] = policy.(*core_mesh.HeaderModificationResource)
+		}
+	}
+
+	return result
+}
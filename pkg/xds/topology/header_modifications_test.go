@@ -0,0 +1,124 @@
+package topology_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	. "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+var _ = Describe("BuildHeaderModificationMaps()", func() {
+
+	It("should pick the best matching HeaderModification for each direction", func() {
+		// given
+		dataplane := &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh: "demo",
+				Name: "backend",
+			},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Tags:        map[string]string{"kuma.io/service": "backend", "kuma.io/protocol": "http"},
+							Port:        8080,
+							ServicePort: 18080,
+						},
+					},
+					Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+						{
+							Port: 10001,
+							Tags: map[string]string{"kuma.io/service": "redis"},
+						},
+						{
+							Port: 10002,
+							Tags: map[string]string{"kuma.io/service": "web"},
+						},
+					},
+				},
+			},
+		}
+		headers := &mesh_proto.HeaderModification_Conf_Headers{
+			RequestHeaders: &mesh_proto.TrafficRoute_Http_Modify_Headers{
+				Add: []*mesh_proto.TrafficRoute_Http_Modify_Headers_Add{
+					{Name: "x-mesh", Value: "%KUMA_MESH%"},
+				},
+			},
+		}
+		wildcard := &core_mesh.HeaderModificationResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh:         "demo",
+				Name:         "wildcard",
+				CreationTime: time.Unix(0, 0),
+			},
+			Spec: &mesh_proto.HeaderModification{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchAnyService()},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchAnyService()},
+				},
+				Conf: &mesh_proto.HeaderModification_Conf{
+					Outbound: headers,
+					Inbound:  headers,
+				},
+			},
+		}
+		outboundOnly := &core_mesh.HeaderModificationResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh:         "demo",
+				Name:         "outbound-only",
+				CreationTime: time.Unix(1, 0),
+			},
+			Spec: &mesh_proto.HeaderModification{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchService("backend")},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchService("backend")},
+					{Match: mesh_proto.MatchService("redis")},
+				},
+				Conf: &mesh_proto.HeaderModification_Conf{
+					Outbound: headers,
+				},
+			},
+		}
+
+		// when
+		headerModifications := BuildHeaderModificationMaps(
+			dataplane,
+			dataplane.Spec.Networking.Inbound,
+			[]*core_mesh.HeaderModificationResource{wildcard, outboundOnly},
+		)
+
+		// then
+		Expect(headerModifications).To(Equal(core_xds.HeaderModificationsMap{
+			Inbound: core_xds.InboundHeaderModificationMap{
+				mesh_proto.InboundInterface{
+					DataplaneAdvertisedIP: "192.168.0.1",
+					DataplaneIP:           "192.168.0.1",
+					DataplanePort:         8080,
+					WorkloadIP:            "127.0.0.1",
+					WorkloadPort:          18080,
+				}: wildcard,
+			},
+			Outbound: core_xds.OutboundHeaderModificationMap{
+				mesh_proto.OutboundInterface{
+					DataplaneIP:   "127.0.0.1",
+					DataplanePort: 10001,
+				}: outboundOnly,
+				mesh_proto.OutboundInterface{
+					DataplaneIP:   "127.0.0.1",
+					DataplanePort: 10002,
+				}: wildcard,
+			},
+		}))
+	})
+})
@@ -18,6 +18,7 @@ const (
 	// Constants for Locality Aware load balancing
 	// The Highest priority 0 shall be assigned to all locally available services
 	// A priority of 1 is for ExternalServices and services exposed on neighboring ingress-es
+	// unless the zone failover rules of the mesh define more priority levels
 	priorityLocal  = 0
 	priorityRemote = 1
 )
@@ -95,10 +96,10 @@ func BuildEdsEndpointMap(
 	if ingressInstances > 0 {
 		endpointWeight = ingressInstances
 	}
-	fillDataplaneOutbounds(outbound, dataplanes, mesh, endpointWeight)
+	fillDataplaneOutbounds(outbound, dataplanes, mesh, endpointWeight, zone)
 
 	if mesh.ZoneEgressEnabled() {
-		fillExternalServicesOutboundsThroughEgress(outbound, externalServices, zoneEgresses, mesh)
+		fillExternalServicesOutboundsThroughEgress(outbound, externalServices, zoneEgresses, mesh, zone)
 	}
 
 	return outbound
@@ -129,6 +130,7 @@ func fillDataplaneOutbounds(
 	dataplanes []*core_mesh.DataplaneResource,
 	mesh *core_mesh.MeshResource,
 	endpointWeight uint32,
+	zone string,
 ) {
 	for _, dataplane := range dataplanes {
 		dpSpec := dataplane.Spec
@@ -148,7 +150,7 @@ func fillDataplaneOutbounds(
 				Port:     inboundPort,
 				Tags:     inboundTags,
 				Weight:   endpointWeight,
-				Locality: localityFromTags(mesh, priorityLocal, inboundTags),
+				Locality: localityFromTags(mesh, zone, priorityLocal, inboundTags),
 			})
 		}
 	}
@@ -213,7 +215,7 @@ func fillIngressOutbounds(
 			serviceTags := service.GetTags()
 			serviceName := serviceTags[mesh_proto.ServiceTag]
 			serviceInstances := service.GetInstances()
-			locality := localityFromTags(mesh, priorityRemote, serviceTags)
+			locality := localityFromTags(mesh, zone, priorityRemote, serviceTags)
 
 			// TODO (bartsmykla): We have to check if it will be ok in a situation
 			//  where we have few zone ingresses with the same services, as
@@ -310,11 +312,12 @@ func fillExternalServicesOutboundsThroughEgress(
 	externalServices []*core_mesh.ExternalServiceResource,
 	zoneEgresses []*core_mesh.ZoneEgressResource,
 	mesh *core_mesh.MeshResource,
+	zone string,
 ) {
 	for _, externalService := range externalServices {
 		serviceTags := externalService.Spec.GetTags()
 		serviceName := serviceTags[mesh_proto.ServiceTag]
-		locality := localityFromTags(mesh, priorityRemote, serviceTags)
+		locality := localityFromTags(mesh, zone, priorityRemote, serviceTags)
 
 		for _, ze := range zoneEgresses {
 			zeNetworking := ze.Spec.GetNetworking()
@@ -376,7 +379,7 @@ func NewExternalServiceEndpoint(
 		Tags:            tags,
 		Weight:          1,
 		ExternalService: es,
-		Locality:        localityFromTags(mesh, priority, tags),
+		Locality:        localityFromTags(mesh, zone, priority, tags),
 	}, nil
 }
 
@@ -394,7 +397,7 @@ func convertToEnvoy(ds *v1alpha1.DataSource, mesh string, loader datasource.Load
 	return data
 }
 
-func localityFromTags(mesh *core_mesh.MeshResource, priority uint32, tags map[string]string) *core_xds.Locality {
+func localityFromTags(mesh *core_mesh.MeshResource, localZone string, priority uint32, tags map[string]string) *core_xds.Locality {
 	zone, zonePresent := tags[mesh_proto.ZoneTag]
 
 	if !zonePresent {
@@ -412,8 +415,75 @@ func localityFromTags(mesh *core_mesh.MeshResource, priority uint32, tags map[st
 		priority = priorityLocal
 	}
 
+	var weight uint32
+	if priority == priorityRemote {
+		priority, weight = zoneFailover(mesh.Spec.GetRouting(), localZone, tags)
+	}
+
 	return &core_xds.Locality{
+		Region:   tags[mesh_proto.RegionTag],
 		Zone:     zone,
+		SubZone:  tags[mesh_proto.SubZoneTag],
 		Priority: priority,
+		Weight:   weight,
+	}
+}
+
+// zoneFailover returns the priority and the weight of the endpoints with the given tags
+// according to the first zone failover rule of the mesh that matches the local zone and the service.
+// The most specific entry of the rule wins: a sub-zone over a zone and a zone over a region.
+// Priorities that end up without endpoints are compacted when the ClusterLoadAssignment is built.
+func zoneFailover(routing *mesh_proto.Routing, localZone string, tags map[string]string) (uint32, uint32) {
+	for _, rule := range routing.GetZoneFailover() {
+		if !matchesAnyOf(rule.GetFromZones(), localZone) || !matchesAnyOf(rule.GetServices(), tags[mesh_proto.ServiceTag]) {
+			continue
+		}
+
+		// zones that are not listed in the rule are used as the last resort
+		priority, weight := priorityRemote+uint32(len(rule.GetPriorities())), uint32(0)
+		bestMatch := failoverNoMatch
+		for i, failoverPriority := range rule.GetPriorities() {
+			for _, failoverZone := range failoverPriority.GetZones() {
+				if match := matchFailoverZone(failoverZone, tags); match > bestMatch {
+					priority, weight, bestMatch = priorityRemote+uint32(i), failoverZone.GetWeight(), match
+				}
+			}
+			if bestMatch < failoverRegionMatch && tags[mesh_proto.RegionTag] != "" &&
+				matchesAnyOf(failoverPriority.GetRegions(), tags[mesh_proto.RegionTag]) {
+				priority, weight, bestMatch = priorityRemote+uint32(i), 0, failoverRegionMatch
+			}
+		}
+		return priority, weight
+	}
+
+	return priorityRemote, 0
+}
+
+const (
+	failoverNoMatch = iota
+	failoverRegionMatch
+	failoverZoneMatch
+	failoverSubZoneMatch
+)
+
+func matchFailoverZone(failoverZone *mesh_proto.ZoneFailover_Zone, tags map[string]string) int {
+	switch {
+	case failoverZone.GetName() != tags[mesh_proto.ZoneTag]:
+		return failoverNoMatch
+	case failoverZone.GetSubZone() == "":
+		return failoverZoneMatch
+	case failoverZone.GetSubZone() == tags[mesh_proto.SubZoneTag]:
+		return failoverSubZoneMatch
+	default:
+		return failoverNoMatch
+	}
+}
+
+func matchesAnyOf(values []string, value string) bool {
+	for _, v := range values {
+		if v == mesh_proto.MatchAllTag || v == value {
+			return true
+		}
 	}
+	return false
 }
@@ -74,6 +74,54 @@ var _ = Describe("TrafficRoute", func() {
 			},
 		},
 	}
+	defaultMeshWithZoneFailover := &core_mesh.MeshResource{
+		Meta: &test_model.ResourceMeta{
+			Name: defaultMeshName,
+		},
+		Spec: &mesh_proto.Mesh{
+			Routing: &mesh_proto.Routing{
+				LocalityAwareLoadBalancing: true,
+				ZoneFailover: []*mesh_proto.ZoneFailover{
+					{
+						FromZones: []string{"zone-2"},
+						Services:  []string{"*"},
+						Priorities: []*mesh_proto.ZoneFailover_Priority{
+							{Zones: []*mesh_proto.ZoneFailover_Zone{{Name: "zone-4"}}},
+						},
+					},
+					{
+						FromZones: []string{"*"},
+						Services:  []string{"redis"},
+						Priorities: []*mesh_proto.ZoneFailover_Priority{
+							{Zones: []*mesh_proto.ZoneFailover_Zone{{Name: "zone-3", Weight: 2}}},
+							{Zones: []*mesh_proto.ZoneFailover_Zone{{Name: "zone-2"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	defaultMeshWithLocalityFailover := &core_mesh.MeshResource{
+		Meta: &test_model.ResourceMeta{
+			Name: defaultMeshName,
+		},
+		Spec: &mesh_proto.Mesh{
+			Routing: &mesh_proto.Routing{
+				LocalityAwareLoadBalancing: true,
+				ZoneFailover: []*mesh_proto.ZoneFailover{
+					{
+						FromZones: []string{"*"},
+						Services:  []string{"*"},
+						Priorities: []*mesh_proto.ZoneFailover_Priority{
+							{Zones: []*mesh_proto.ZoneFailover_Zone{{Name: "zone-2", SubZone: "zone-2a"}}},
+							{Regions: []string{"europe"}},
+							{Zones: []*mesh_proto.ZoneFailover_Zone{{Name: "zone-2"}}},
+						},
+					},
+				},
+			},
+		},
+	}
 	const nonDefaultMesh = "non-default"
 
 	var dataSourceLoader datasource.Loader
@@ -694,6 +742,162 @@ var _ = Describe("TrafficRoute", func() {
 					},
 				},
 			}),
+			Entry("external services with Zones and zone failover", testCase{
+				dataplanes: []*core_mesh.DataplaneResource{},
+				externalServices: []*core_mesh.ExternalServiceResource{
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone1.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-1"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone2.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone3.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-3"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone4.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-4"},
+						},
+					},
+				},
+				mesh: defaultMeshWithZoneFailover,
+				expected: core_xds.EndpointMap{
+					"redis": []core_xds.Endpoint{
+						{
+							Target:          "zone1.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-1"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Zone: "zone-1", Priority: 0},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone2.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Zone: "zone-2", Priority: 2},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone3.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-3"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Zone: "zone-3", Priority: 1, Weight: 2},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone4.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-4"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Zone: "zone-4", Priority: 3},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+					},
+				},
+			}),
+			Entry("external services with regions, sub-zones and zone failover", testCase{
+				dataplanes: []*core_mesh.DataplaneResource{},
+				externalServices: []*core_mesh.ExternalServiceResource{
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone2a.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2", mesh_proto.RegionTag: "europe", mesh_proto.SubZoneTag: "zone-2a"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone2b.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2", mesh_proto.RegionTag: "europe", mesh_proto.SubZoneTag: "zone-2b"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone3.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-3", mesh_proto.RegionTag: "europe"},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+						Spec: &mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "zone4.httpbin.org:80",
+							},
+							Tags: map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-4", mesh_proto.RegionTag: "asia"},
+						},
+					},
+				},
+				mesh: defaultMeshWithLocalityFailover,
+				expected: core_xds.EndpointMap{
+					"redis": []core_xds.Endpoint{
+						{
+							Target:          "zone2a.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2", mesh_proto.RegionTag: "europe", mesh_proto.SubZoneTag: "zone-2a"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Region: "europe", Zone: "zone-2", SubZone: "zone-2a", Priority: 1},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone2b.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-2", mesh_proto.RegionTag: "europe", mesh_proto.SubZoneTag: "zone-2b"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Region: "europe", Zone: "zone-2", SubZone: "zone-2b", Priority: 3},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone3.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-3", mesh_proto.RegionTag: "europe"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Region: "europe", Zone: "zone-3", Priority: 2},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+						{
+							Target:          "zone4.httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "redis", mesh_proto.ZoneTag: "zone-4", mesh_proto.RegionTag: "asia"},
+							Weight:          1,
+							Locality:        &core_xds.Locality{Region: "asia", Zone: "zone-4", Priority: 4},
+							ExternalService: &core_xds.ExternalService{TLSEnabled: false},
+						},
+					},
+				},
+			}),
 			Entry("unhealthy dataplane", testCase{
 				dataplanes: []*core_mesh.DataplaneResource{
 					{